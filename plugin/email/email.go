@@ -0,0 +1,208 @@
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Security is how the connection to the SMTP server is secured.
+type Security string
+
+const (
+	// SecurityNone sends the email over a plain connection.
+	SecurityNone Security = "NONE"
+	// SecurityStartTLS upgrades the plain connection with the STARTTLS command.
+	SecurityStartTLS Security = "STARTTLS"
+	// SecurityTLS connects with implicit TLS, usually on port 465.
+	SecurityTLS Security = "TLS"
+)
+
+var (
+	// timeout is the timeout for the whole SMTP session. Default to 30 seconds.
+	timeout = 30 * time.Second
+)
+
+// Config is the configuration of the SMTP server to send emails with.
+type Config struct {
+	Host     string
+	Port     int
+	Security Security
+	// Username and Password are used for the PLAIN authentication if the username is set.
+	// The password is only sent over a secured connection, or to a server on localhost.
+	Username string
+	Password string
+
+	FromEmail string
+	FromName  string
+}
+
+// Message is an email with a plain text body, an HTML body or both.
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Send sends the message through the SMTP server.
+func Send(config *Config, message *Message) error {
+	if config.Host == "" || config.Port == 0 {
+		return errors.New("SMTP server is not configured")
+	}
+	if len(message.To) == 0 {
+		return errors.New("no recipient")
+	}
+	body, err := buildMessage(config, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	if config.Security == SecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: config.Host})
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to SMTP server %s", addr)
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to set deadline")
+	}
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrapf(err, "failed to create SMTP client for %s", addr)
+	}
+	defer client.Close()
+
+	if config.Security == SecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.Errorf("SMTP server %s doesn't support STARTTLS", addr)
+		}
+		if err := client.StartTLS(&tls.Config{ServerName: config.Host}); err != nil {
+			return errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.Errorf("SMTP server %s doesn't support authentication", addr)
+		}
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+	if err := client.Mail(config.FromEmail); err != nil {
+		return errors.Wrapf(err, "failed to set sender %s", config.FromEmail)
+	}
+	for _, to := range message.To {
+		if err := client.Rcpt(to); err != nil {
+			return errors.Wrapf(err, "failed to add recipient %s", to)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start data")
+	}
+	if _, err := writer.Write(body); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to send message")
+	}
+	return client.Quit()
+}
+
+// SendAsync sends the message through the SMTP server asynchronously.
+// It spawns a new goroutine to send the message and does not wait for the result.
+func SendAsync(config *Config, message *Message) {
+	go func() {
+		if err := Send(config, message); err != nil {
+			// Since we're in a goroutine, we can only log the error
+			slog.Warn("Failed to send email asynchronously",
+				slog.String("subject", message.Subject),
+				slog.Any("err", err))
+		}
+	}()
+}
+
+// buildMessage builds the MIME message with the text and the HTML bodies as alternatives.
+func buildMessage(config *Config, message *Message, now time.Time) ([]byte, error) {
+	from, err := mail.ParseAddress(config.FromEmail)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid sender %q", config.FromEmail)
+	}
+	from.Name = config.FromName
+	toList := []string{}
+	for _, to := range message.To {
+		address, err := mail.ParseAddress(to)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid recipient %q", to)
+		}
+		toList = append(toList, address.String())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", message.Text},
+		{"text/html; charset=utf-8", message.HTML},
+	}
+	for _, part := range parts {
+		if part.content == "" {
+			continue
+		}
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err := encoder.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	header := &bytes.Buffer{}
+	fmt.Fprintf(header, "From: %s\r\n", from.String())
+	for i, to := range toList {
+		if i == 0 {
+			fmt.Fprintf(header, "To: %s", to)
+		} else {
+			fmt.Fprintf(header, ", %s", to)
+		}
+	}
+	fmt.Fprint(header, "\r\n")
+	fmt.Fprintf(header, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(header, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprint(header, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(header, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", writer.Boundary())
+	return append(header.Bytes(), body.Bytes()...), nil
+}
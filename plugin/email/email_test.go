@@ -0,0 +1,171 @@
+package email
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// sinkMessage is a message received by the SMTP sink.
+type sinkMessage struct {
+	auth string
+	from string
+	to   []string
+	data []byte
+}
+
+// startSMTPSink starts a local SMTP server accepting a single session, and returns its port
+// and the channel to receive the message from.
+func startSMTPSink(t *testing.T) (int, <-chan *sinkMessage) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan *sinkMessage, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := textproto.NewReader(bufio.NewReader(conn))
+		reply := func(lines ...string) {
+			io.WriteString(conn, strings.Join(lines, "\r\n")+"\r\n")
+		}
+
+		message := &sinkMessage{}
+		reply("220 localhost ESMTP sink")
+		for {
+			line, err := reader.ReadLine()
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+			switch command {
+			case "EHLO":
+				reply("250-localhost", "250 AUTH PLAIN")
+			case "AUTH":
+				message.auth = strings.TrimPrefix(line, "AUTH PLAIN ")
+				reply("235 2.7.0 Authentication successful")
+			case "MAIL":
+				message.from = strings.TrimSuffix(strings.TrimPrefix(line, "MAIL FROM:<"), ">")
+				reply("250 OK")
+			case "RCPT":
+				message.to = append(message.to, strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">"))
+				reply("250 OK")
+			case "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				message.data, err = reader.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				reply("250 OK")
+			case "QUIT":
+				reply("221 Bye")
+				messages <- message
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, messages
+}
+
+func TestSend(t *testing.T) {
+	port, messages := startSMTPSink(t)
+	config := &Config{
+		Host:      "127.0.0.1",
+		Port:      port,
+		Security:  SecurityNone,
+		Username:  "memos",
+		Password:  "secret",
+		FromEmail: "memos@example.com",
+		FromName:  "Memos",
+	}
+	err := Send(config, &Message{
+		To:      []string{"steven@example.com"},
+		Subject: "Hello, 世界",
+		Text:    "Hello from memos.",
+		HTML:    "<p>Hello from memos.</p>",
+	})
+	require.NoError(t, err)
+
+	message := <-messages
+	auth, err := base64.StdEncoding.DecodeString(message.auth)
+	require.NoError(t, err)
+	require.Equal(t, "\x00memos\x00secret", string(auth))
+	require.Equal(t, "memos@example.com", message.from)
+	require.Equal(t, []string{"steven@example.com"}, message.to)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(message.data)))
+	require.NoError(t, err)
+	require.Equal(t, `"Memos" <memos@example.com>`, parsed.Header.Get("From"))
+	require.Equal(t, "<steven@example.com>", parsed.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Hello, 世界", subject)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	bodies := map[string]string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies[part.Header.Get("Content-Type")] = string(body)
+	}
+	require.Equal(t, map[string]string{
+		"text/plain; charset=utf-8": "Hello from memos.",
+		"text/html; charset=utf-8":  "<p>Hello from memos.</p>",
+	}, bodies)
+}
+
+func TestSendWithoutRecipient(t *testing.T) {
+	err := Send(&Config{
+		Host:      "127.0.0.1",
+		Port:      25,
+		FromEmail: "memos@example.com",
+	}, &Message{Subject: "Hello"})
+	require.Error(t, err)
+}
+
+func TestRenderTemplate(t *testing.T) {
+	message, err := NotificationTemplate.Render([]string{"steven@example.com"}, &NotificationData{
+		Title: "New comment\r\nBcc: someone@example.com",
+		Lines: []string{"<b>Hello</b>"},
+		URL:   "https://memos.example.com/memos/1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"steven@example.com"}, message.To)
+	require.Equal(t, "New comment Bcc: someone@example.com", message.Subject)
+	require.Contains(t, message.Text, "<b>Hello</b>")
+	require.Contains(t, message.Text, "Open in memos: https://memos.example.com/memos/1")
+	require.Contains(t, message.HTML, "&lt;b&gt;Hello&lt;/b&gt;")
+	require.Contains(t, message.HTML, `<a href="https://memos.example.com/memos/1">`)
+
+	message, err = PasswordResetTemplate.Render([]string{"steven@example.com"}, &PasswordResetData{
+		Username:  "steven",
+		Token:     "token",
+		ExpiresIn: "1 hour",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Reset your memos password", message.Subject)
+	require.Contains(t, message.Text, "Reset token: token")
+	require.NotContains(t, message.Text, "Reset your password:")
+}
@@ -0,0 +1,135 @@
+package email
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+)
+
+// Template renders the subject and the bodies of an email from the same data.
+type Template struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// NewTemplate parses the subject, the plain text and the HTML body templates.
+// The HTML template escapes the data as html/template does.
+func NewTemplate(name, subject, text, html string) (*Template, error) {
+	subjectTemplate, err := texttemplate.New(name + ".subject").Parse(subject)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse subject template")
+	}
+	textTemplate, err := texttemplate.New(name + ".text").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse text template")
+	}
+	htmlTemplate, err := htmltemplate.New(name + ".html").Parse(html)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse HTML template")
+	}
+	return &Template{
+		subject: subjectTemplate,
+		text:    textTemplate,
+		html:    htmlTemplate,
+	}, nil
+}
+
+// Render renders the message to the recipients with the data.
+func (t *Template) Render(to []string, data any) (*Message, error) {
+	subject := &bytes.Buffer{}
+	if err := t.subject.Execute(subject, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render subject")
+	}
+	text := &bytes.Buffer{}
+	if err := t.text.Execute(text, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render text")
+	}
+	html := &bytes.Buffer{}
+	if err := t.html.Execute(html, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render HTML")
+	}
+	return &Message{
+		To: to,
+		// The subject is a single line header.
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// NotificationData is the data of NotificationTemplate.
+type NotificationData struct {
+	// Title is the subject of the notification.
+	Title string
+	// Lines are the paragraphs of the notification, e.g. one line per item of a digest.
+	Lines []string
+	// URL is the link to open the notification in memos, it's optional.
+	URL string
+}
+
+// NotificationTemplate is the template of the inbox notifications and the digests.
+var NotificationTemplate = mustNewTemplate("notification",
+	`{{.Title}}`,
+	`{{.Title}}
+{{range .Lines}}
+{{.}}
+{{end}}{{if .URL}}
+Open in memos: {{.URL}}
+{{end}}`,
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; line-height: 1.5;">
+<h2>{{.Title}}</h2>
+{{range .Lines}}<p>{{.}}</p>
+{{end}}{{if .URL}}<p><a href="{{.URL}}">Open in memos</a></p>
+{{end}}</body>
+</html>
+`)
+
+// PasswordResetData is the data of PasswordResetTemplate.
+type PasswordResetData struct {
+	Username string
+	// Token is the password reset token, it's shown in case the URL can't be opened.
+	Token string
+	// URL is the link to reset the password, it's optional.
+	URL string
+	// ExpiresIn is the human readable duration the token is valid for, e.g. "1 hour".
+	ExpiresIn string
+}
+
+// PasswordResetTemplate is the template of the password reset emails.
+var PasswordResetTemplate = mustNewTemplate("password-reset",
+	`Reset your memos password`,
+	`Hi {{.Username}},
+
+A password reset was requested for your memos account. It expires in {{.ExpiresIn}}.
+{{if .URL}}
+Reset your password: {{.URL}}
+{{end}}
+Reset token: {{.Token}}
+
+If you didn't request it, you can ignore this email.
+`,
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; line-height: 1.5;">
+<p>Hi {{.Username}},</p>
+<p>A password reset was requested for your memos account. It expires in {{.ExpiresIn}}.</p>
+{{if .URL}}<p><a href="{{.URL}}">Reset your password</a></p>
+{{end}}<p>Reset token: <code>{{.Token}}</code></p>
+<p>If you didn't request it, you can ignore this email.</p>
+</body>
+</html>
+`)
+
+func mustNewTemplate(name, subject, text, html string) *Template {
+	template, err := NewTemplate(name, subject, text, html)
+	if err != nil {
+		panic(err)
+	}
+	return template
+}
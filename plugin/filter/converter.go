@@ -10,6 +10,8 @@ type ConvertContext struct {
 	// The offset of the next argument in the condition string.
 	// Mainly using for PostgreSQL.
 	ArgsOffset int
+	// Whether `display_time` refers to the updated time instead of the created time.
+	DisplayWithUpdateTime bool
 }
 
 func NewConvertContext() *ConvertContext {
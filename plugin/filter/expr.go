@@ -40,7 +40,7 @@ func GetIdentExprName(expr *exprv1.Expr) (string, error) {
 }
 
 // GetFunctionValue evaluates CEL function calls and returns their value.
-// This is specifically for time functions like now().
+// This is specifically for time functions like now(), today(), date() and days().
 func GetFunctionValue(expr *exprv1.Expr) (any, error) {
 	callExpr, ok := expr.ExprKind.(*exprv1.Expr_CallExpr)
 	if !ok {
@@ -53,6 +53,41 @@ func GetFunctionValue(expr *exprv1.Expr) (any, error) {
 			return nil, errors.New("now() function takes no arguments")
 		}
 		return time.Now().Unix(), nil
+	case "today":
+		if len(callExpr.CallExpr.Args) != 0 {
+			return nil, errors.New("today() function takes no arguments")
+		}
+		return time.Now().UTC().Truncate(24 * time.Hour).Unix(), nil
+	case "date":
+		if len(callExpr.CallExpr.Args) != 1 {
+			return nil, errors.New("date() function takes exactly one argument")
+		}
+		value, err := GetConstValue(callExpr.CallExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		valueStr, ok := value.(string)
+		if !ok {
+			return nil, errors.New("date() argument must be a string")
+		}
+		date, err := time.Parse(time.DateOnly, valueStr)
+		if err != nil {
+			return nil, errors.New("date() argument must be in the YYYY-MM-DD format")
+		}
+		return date.Unix(), nil
+	case "days":
+		if len(callExpr.CallExpr.Args) != 1 {
+			return nil, errors.New("days() function takes exactly one argument")
+		}
+		value, err := GetExprValue(callExpr.CallExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		valueInt, ok := value.(int64)
+		if !ok {
+			return nil, errors.New("days() argument must be an integer")
+		}
+		return valueInt * 24 * 60 * 60, nil
 	case "_-_":
 		// Handle subtraction for expressions like "now() - 60 * 60 * 24"
 		if len(callExpr.CallExpr.Args) != 2 {
@@ -125,3 +160,74 @@ func GetExprValue(expr *exprv1.Expr) (any, error) {
 	// If not a constant, try to evaluate as a function
 	return GetFunctionValue(expr)
 }
+
+// EarthRadiusKm is the mean radius of the earth used by the geospatial functions.
+const EarthRadiusKm = 6371.0
+
+// GetWithinRadiusArgs returns the latitude, longitude and radius in kilometers of a within_radius call.
+func GetWithinRadiusArgs(args []*exprv1.Expr) (float64, float64, float64, error) {
+	values, err := getNumberValues(args, 3)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if err := validateCoordinates(values[0], values[1]); err != nil {
+		return 0, 0, 0, err
+	}
+	if values[2] <= 0 {
+		return 0, 0, 0, errors.New("radius must be positive")
+	}
+	return values[0], values[1], values[2], nil
+}
+
+// GetWithinBoundingBoxArgs returns the min latitude, min longitude, max latitude and max longitude of a within_bbox call.
+// The min longitude is greater than the max longitude if the box crosses the antimeridian.
+func GetWithinBoundingBoxArgs(args []*exprv1.Expr) (float64, float64, float64, float64, error) {
+	values, err := getNumberValues(args, 4)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if err := validateCoordinates(values[0], values[1]); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if err := validateCoordinates(values[2], values[3]); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if values[0] > values[2] {
+		return 0, 0, 0, 0, errors.New("min latitude must not be greater than max latitude")
+	}
+	return values[0], values[1], values[2], values[3], nil
+}
+
+func getNumberValues(args []*exprv1.Expr, count int) ([]float64, error) {
+	if len(args) != count {
+		return nil, errors.New("invalid number of arguments")
+	}
+	values := []float64{}
+	for _, arg := range args {
+		value, err := GetConstValue(arg)
+		if err != nil {
+			return nil, err
+		}
+		switch v := value.(type) {
+		case int64:
+			values = append(values, float64(v))
+		case uint64:
+			values = append(values, float64(v))
+		case float64:
+			values = append(values, v)
+		default:
+			return nil, errors.New("invalid number value")
+		}
+	}
+	return values, nil
+}
+
+func validateCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if longitude < -180 || longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -12,6 +13,7 @@ import (
 
 // MemoFilterCELAttributes are the CEL attributes for memo.
 var MemoFilterCELAttributes = []cel.EnvOption{
+	cel.Variable("id", cel.IntType),
 	cel.Variable("content", cel.StringType),
 	cel.Variable("creator_id", cel.IntType),
 	cel.Variable("created_ts", cel.IntType),
@@ -19,7 +21,13 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("pinned", cel.BoolType),
 	cel.Variable("tag", cel.StringType),
 	cel.Variable("visibility", cel.StringType),
+	cel.Variable("tags", cel.ListType(cel.StringType)),
+	cel.Variable("row_status", cel.StringType),
+	cel.Variable("display_time", cel.IntType),
+	cel.Variable("has_link", cel.BoolType),
 	cel.Variable("has_task_list", cel.BoolType),
+	cel.Variable("has_code", cel.BoolType),
+	cel.Variable("has_incomplete_tasks", cel.BoolType),
 	// Current timestamp function.
 	cel.Function("now",
 		cel.Overload("now",
@@ -30,6 +38,63 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 			}),
 		),
 	),
+	// Date functions, the dates are in UTC and converted to timestamps.
+	// today() returns the timestamp of the start of the current day.
+	cel.Function("today",
+		cel.Overload("today",
+			[]*cel.Type{},
+			cel.IntType,
+		),
+	),
+	// date("2006-01-02") returns the timestamp of the start of the day.
+	cel.Function("date",
+		cel.Overload("date_string",
+			[]*cel.Type{cel.StringType},
+			cel.IntType,
+		),
+	),
+	// days(n) returns the duration of n days in seconds, e.g. `now() - days(7)`.
+	cel.Function("days",
+		cel.Overload("days_int",
+			[]*cel.Type{cel.IntType},
+			cel.IntType,
+		),
+	),
+	// Geospatial functions on the memo location, the coordinates are in degrees.
+	// within_radius(latitude, longitude, kilometers)
+	cel.Function("within_radius",
+		cel.Overload("within_radius",
+			[]*cel.Type{cel.DynType, cel.DynType, cel.DynType},
+			cel.BoolType,
+		),
+	),
+	// within_bbox(min_latitude, min_longitude, max_latitude, max_longitude)
+	cel.Function("within_bbox",
+		cel.Overload("within_bbox",
+			[]*cel.Type{cel.DynType, cel.DynType, cel.DynType, cel.DynType},
+			cel.BoolType,
+		),
+	),
+}
+
+// MemoInternalFilterCELAttributes extends the memo attributes with the ones only used by the server in the filters it builds,
+// e.g. for the visibility of the memos. They're not available in the filters of the users.
+var MemoInternalFilterCELAttributes = append(slices.Clone(MemoFilterCELAttributes),
+	// granted_to(user_id) matches the memos granted to the user.
+	cel.Function("granted_to",
+		cel.Overload("granted_to_int",
+			[]*cel.Type{cel.IntType},
+			cel.BoolType,
+		),
+	),
+)
+
+// MemoPayloadPropertyKeys maps the boolean property identifiers to the keys of the memo payload property.
+var MemoPayloadPropertyKeys = map[string]string{
+	"has_link":             "hasLink",
+	"has_task_list":        "hasTaskList",
+	"has_code":             "hasCode",
+	"has_incomplete_tasks": "hasIncompleteTasks",
 }
 
 // Parse parses the filter string and returns the parsed expression.
@@ -0,0 +1,257 @@
+package webpush
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+var (
+	// timeout is the timeout for the push request. Default to 30 seconds.
+	timeout = 30 * time.Second
+
+	// ErrSubscriptionGone is returned if the push service reports that the subscription has expired or been unsubscribed.
+	// The subscription should be deleted then.
+	ErrSubscriptionGone = errors.New("push subscription is gone")
+)
+
+const (
+	// recordSize is the record size of the aes128gcm content coding, the payload is sent in a single record.
+	recordSize = 4096
+	// headerSize is the size of the aes128gcm header: salt, record size, key id length and the key id.
+	headerSize = 16 + 4 + 1 + 65
+	// MaxPayloadSize is the maximum size of the payload, it fits in a single record with the tag and the delimiter.
+	MaxPayloadSize = recordSize - headerSize - 16 - 1
+	// vapidTokenDuration is how long the VAPID token is valid, at most 24 hours by RFC 8292.
+	vapidTokenDuration = 12 * time.Hour
+)
+
+// Subscription is the push subscription of a browser, as returned by `PushSubscription.toJSON()`.
+type Subscription struct {
+	Endpoint string
+	// P256dh is the P-256 public key of the browser, base64url encoded.
+	P256dh string
+	// Auth is the authentication secret of the browser, base64url encoded.
+	Auth string
+}
+
+// Options are the options of a push message.
+type Options struct {
+	// VAPIDPublicKey and VAPIDPrivateKey are the application server keys, see GenerateVAPIDKeys.
+	VAPIDPublicKey  string
+	VAPIDPrivateKey string
+	// Subscriber is the contact of the application server, a "mailto:" or an "https:" URL.
+	Subscriber string
+	// TTL is how long the push service keeps the message if the browser is offline.
+	TTL time.Duration
+	// Topic replaces the pending message with the same topic, it's optional.
+	Topic string
+}
+
+// GenerateVAPIDKeys generates a P-256 key pair for VAPID. The keys are base64url encoded,
+// the public key is the uncompressed point, as expected by `applicationServerKey` in browsers.
+func GenerateVAPIDKeys() (privateKey, publicKey string, err error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to generate key")
+	}
+	return base64.RawURLEncoding.EncodeToString(key.Bytes()), base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// Send encrypts the payload for the subscription (RFC 8291) and sends it to its push service (RFC 8030),
+// authorized by the VAPID keys (RFC 8292).
+func Send(subscription *Subscription, payload []byte, options *Options) error {
+	body, err := encrypt(subscription, payload)
+	if err != nil {
+		return err
+	}
+	authorization, err := getVAPIDAuthorization(subscription.Endpoint, options, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct push request to %s", subscription.Endpoint)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(options.TTL.Seconds())))
+	if options.Topic != "" {
+		req.Header.Set("Topic", options.Topic)
+	}
+	client := &http.Client{
+		Timeout: timeout,
+		// The push services don't redirect, the redirects are not followed so the subscriptions can't lead elsewhere.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send push message to %s", subscription.Endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return ErrSubscriptionGone
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("failed to send push message to %s, status code: %d, response body: %s", subscription.Endpoint, resp.StatusCode, b)
+	}
+	return nil
+}
+
+// encrypt encrypts the payload with the aes128gcm content coding in a single record.
+func encrypt(subscription *Subscription, payload []byte) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, errors.Errorf("payload is too large: %d bytes", len(payload))
+	}
+	userAgentPublicKeyBytes, err := base64.RawURLEncoding.DecodeString(trimPadding(subscription.P256dh))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	userAgentPublicKey, err := ecdh.P256().NewPublicKey(userAgentPublicKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	authSecret, err := base64.RawURLEncoding.DecodeString(trimPadding(subscription.Auth))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid auth secret")
+	}
+
+	// The application server key pair is generated for each message.
+	applicationServerKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	sharedSecret, err := applicationServerKey.ECDH(userAgentPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute shared secret")
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+	applicationServerPublicKeyBytes := applicationServerKey.PublicKey().Bytes()
+
+	contentEncryptionKey, nonce, err := deriveKeys(sharedSecret, authSecret, salt, userAgentPublicKeyBytes, applicationServerPublicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(contentEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	// The padding delimiter 0x02 marks the last record.
+	plaintext := append(append([]byte{}, payload...), 0x02)
+
+	header := &bytes.Buffer{}
+	header.Write(salt)
+	if err := binary.Write(header, binary.BigEndian, uint32(recordSize)); err != nil {
+		return nil, err
+	}
+	header.WriteByte(byte(len(applicationServerPublicKeyBytes)))
+	header.Write(applicationServerPublicKeyBytes)
+	return gcm.Seal(header.Bytes(), nonce, plaintext, nil), nil
+}
+
+// deriveKeys derives the content encryption key and the nonce from the shared secret, as specified by RFC 8291.
+func deriveKeys(sharedSecret, authSecret, salt, userAgentPublicKey, applicationServerPublicKey []byte) ([]byte, []byte, error) {
+	keyInfo := "WebPush: info\x00" + string(userAgentPublicKey) + string(applicationServerPublicKey)
+	prkKey, err := hkdf.Extract(sha256.New, sharedSecret, authSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+	ikm, err := hkdf.Expand(sha256.New, prkKey, keyInfo, 32)
+	if err != nil {
+		return nil, nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+	contentEncryptionKey, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contentEncryptionKey, nonce, nil
+}
+
+// getVAPIDAuthorization returns the Authorization header of the VAPID scheme for the push service of the endpoint.
+func getVAPIDAuthorization(endpoint string, options *Options, now time.Time) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+		return "", errors.Errorf("invalid endpoint %q", endpoint)
+	}
+	privateKey, err := parseVAPIDPrivateKey(options.VAPIDPrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Audience:  jwt.ClaimStrings{fmt.Sprintf("%s://%s", endpointURL.Scheme, endpointURL.Host)},
+		ExpiresAt: jwt.NewNumericDate(now.Add(vapidTokenDuration)),
+		Subject:   options.Subscriber,
+	})
+	tokenString, err := token.SignedString(privateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign VAPID token")
+	}
+	return fmt.Sprintf("vapid t=%s, k=%s", tokenString, trimPadding(options.VAPIDPublicKey)), nil
+}
+
+func parseVAPIDPrivateKey(privateKey string) (*ecdsa.PrivateKey, error) {
+	privateKeyBytes, err := base64.RawURLEncoding.DecodeString(trimPadding(privateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid VAPID private key")
+	}
+	key, err := ecdh.P256().NewPrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid VAPID private key")
+	}
+	// The public key is the uncompressed point: 0x04 || X || Y.
+	publicKeyBytes := key.PublicKey().Bytes()
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(publicKeyBytes[1:33]),
+			Y:     new(big.Int).SetBytes(publicKeyBytes[33:]),
+		},
+		D: new(big.Int).SetBytes(privateKeyBytes),
+	}, nil
+}
+
+// trimPadding trims the padding of base64url values, browsers may send them either way.
+func trimPadding(value string) string {
+	return strings.TrimRight(value, "=")
+}
@@ -0,0 +1,182 @@
+package webpush
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// decrypt decrypts the aes128gcm body with the keys of the browser.
+func decrypt(t *testing.T, body []byte, userAgentKey *ecdh.PrivateKey, authSecret []byte) []byte {
+	require.Greater(t, len(body), headerSize)
+	salt := body[:16]
+	require.Equal(t, uint32(recordSize), binary.BigEndian.Uint32(body[16:20]))
+	keyIDLength := int(body[20])
+	applicationServerPublicKeyBytes := body[21 : 21+keyIDLength]
+	applicationServerPublicKey, err := ecdh.P256().NewPublicKey(applicationServerPublicKeyBytes)
+	require.NoError(t, err)
+	sharedSecret, err := userAgentKey.ECDH(applicationServerPublicKey)
+	require.NoError(t, err)
+
+	contentEncryptionKey, nonce, err := deriveKeys(sharedSecret, authSecret, salt, userAgentKey.PublicKey().Bytes(), applicationServerPublicKeyBytes)
+	require.NoError(t, err)
+	block, err := aes.NewCipher(contentEncryptionKey)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	plaintext, err := gcm.Open(nil, nonce, body[21+keyIDLength:], nil)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), plaintext[len(plaintext)-1])
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestSend(t *testing.T) {
+	privateKey, publicKey, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	userAgentKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+
+	var request *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	subscription := &Subscription{
+		Endpoint: server.URL + "/push/abc",
+		P256dh:   base64.URLEncoding.EncodeToString(userAgentKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(authSecret),
+	}
+	payload := []byte(`{"title":"New comment"}`)
+	err = Send(subscription, payload, &Options{
+		VAPIDPublicKey:  publicKey,
+		VAPIDPrivateKey: privateKey,
+		Subscriber:      "mailto:admin@example.com",
+		TTL:             time.Hour,
+		Topic:           "inbox",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "aes128gcm", request.Header.Get("Content-Encoding"))
+	require.Equal(t, "3600", request.Header.Get("TTL"))
+	require.Equal(t, "inbox", request.Header.Get("Topic"))
+	require.Equal(t, payload, decrypt(t, body, userAgentKey, authSecret))
+
+	authorization := request.Header.Get("Authorization")
+	require.True(t, strings.HasPrefix(authorization, "vapid t="))
+	parts := strings.Split(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	require.Equal(t, 2, len(parts))
+	require.Equal(t, publicKey, parts[1])
+	vapidPublicKey, err := parseVAPIDPrivateKey(privateKey)
+	require.NoError(t, err)
+	claims := &jwt.RegisteredClaims{}
+	_, err = jwt.ParseWithClaims(parts[0], claims, func(*jwt.Token) (any, error) {
+		return &vapidPublicKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience(server.URL))
+	require.NoError(t, err)
+	require.Equal(t, "mailto:admin@example.com", claims.Subject)
+}
+
+func TestSendToGoneSubscription(t *testing.T) {
+	privateKey, publicKey, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	userAgentKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	err = Send(&Subscription{
+		Endpoint: server.URL,
+		P256dh:   base64.RawURLEncoding.EncodeToString(userAgentKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(bytes.Repeat([]byte{1}, 16)),
+	}, []byte("hello"), &Options{
+		VAPIDPublicKey:  publicKey,
+		VAPIDPrivateKey: privateKey,
+		Subscriber:      "mailto:admin@example.com",
+	})
+	require.ErrorIs(t, err, ErrSubscriptionGone)
+}
+
+func TestSendTooLargePayload(t *testing.T) {
+	_, err := encrypt(&Subscription{}, make([]byte, MaxPayloadSize+1))
+	require.Error(t, err)
+}
+
+// TestDeriveKeys checks the key derivation against the example of RFC 8291, section 5.
+func TestDeriveKeys(t *testing.T) {
+	decode := func(value string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(value)
+		require.NoError(t, err)
+		return b
+	}
+	contentEncryptionKey, nonce, err := deriveKeys(
+		decode("kyrL1jIIOHEzg3sM2ZWRHDRB62YACZhhSlknJ672kSs"),
+		decode("BTBZMqHH6r4Tts7J_aSIgg"),
+		decode("DGv6ra1nlYgDCS1FRnbzlw"),
+		decode("BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+		decode("BP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A8"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, decode("oIhVW04MRdy2XN9CiKLxTg"), contentEncryptionKey)
+	require.Equal(t, decode("4h_95klXJ5E_qnoN"), nonce)
+
+	block, err := aes.NewCipher(contentEncryptionKey)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	body := decode("DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN")
+	ciphertext := gcm.Seal(nil, nonce, []byte("When I grow up, I want to be a watermelon\x02"), nil)
+	require.Equal(t, body[headerSize:], ciphertext)
+}
+
+func TestSendWithoutFollowingRedirect(t *testing.T) {
+	privateKey, publicKey, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	userAgentKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		redirected = true
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	err = Send(&Subscription{
+		Endpoint: server.URL,
+		P256dh:   base64.RawURLEncoding.EncodeToString(userAgentKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(bytes.Repeat([]byte{1}, 16)),
+	}, []byte("hello"), &Options{
+		VAPIDPublicKey:  publicKey,
+		VAPIDPrivateKey: privateKey,
+		Subscriber:      "mailto:admin@example.com",
+	})
+	require.Error(t, err)
+	require.False(t, redirected)
+}
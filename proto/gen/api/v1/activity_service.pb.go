@@ -116,8 +116,15 @@ func (x *Activity) GetPayload() *ActivityPayload {
 }
 
 type ActivityPayload struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	MemoComment   *ActivityMemoCommentPayload   `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	MemoLifecycle *ActivityMemoLifecyclePayload `protobuf:"bytes,2,opt,name=memo_lifecycle,json=memoLifecycle,proto3" json:"memo_lifecycle,omitempty"`
+	MemoReminder  *ActivityMemoReminderPayload  `protobuf:"bytes,3,opt,name=memo_reminder,json=memoReminder,proto3" json:"memo_reminder,omitempty"`
+	MemoMention   *ActivityMemoMentionPayload   `protobuf:"bytes,4,opt,name=memo_mention,json=memoMention,proto3" json:"memo_mention,omitempty"`
+	MemoReaction  *ActivityMemoReactionPayload  `protobuf:"bytes,5,opt,name=memo_reaction,json=memoReaction,proto3" json:"memo_reaction,omitempty"`
+	MemoReference *ActivityMemoReferencePayload `protobuf:"bytes,6,opt,name=memo_reference,json=memoReference,proto3" json:"memo_reference,omitempty"`
+	ShortcutMatch *ActivityShortcutMatchPayload `protobuf:"bytes,7,opt,name=shortcut_match,json=shortcutMatch,proto3" json:"shortcut_match,omitempty"`
+	Digest        *ActivityDigestPayload        `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -159,6 +166,55 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoLifecycle() *ActivityMemoLifecyclePayload {
+	if x != nil {
+		return x.MemoLifecycle
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		return x.MemoReminder
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		return x.MemoMention
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReaction() *ActivityMemoReactionPayload {
+	if x != nil {
+		return x.MemoReaction
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReference() *ActivityMemoReferencePayload {
+	if x != nil {
+		return x.MemoReference
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetShortcutMatch() *ActivityShortcutMatchPayload {
+	if x != nil {
+		return x.ShortcutMatch
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetDigest() *ActivityDigestPayload {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
 // ActivityMemoCommentPayload represents the payload of a memo comment activity.
 type ActivityMemoCommentPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -215,6 +271,393 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemo() string {
 	return ""
 }
 
+// ActivityMemoLifecyclePayload represents the payload of a memo lifecycle activity.
+type ActivityMemoLifecyclePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the applied lifecycle rule.
+	RuleId    string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleTitle string               `protobuf:"bytes,2,opt,name=rule_title,json=ruleTitle,proto3" json:"rule_title,omitempty"`
+	Action    LifecycleRule_Action `protobuf:"varint,3,opt,name=action,proto3,enum=memos.api.v1.LifecycleRule_Action" json:"action,omitempty"`
+	// The names of the memos changed by the rule.
+	// Refer to `Memo.name`.
+	Memos         []string `protobuf:"bytes,4,rep,name=memos,proto3" json:"memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoLifecyclePayload) Reset() {
+	*x = ActivityMemoLifecyclePayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoLifecyclePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoLifecyclePayload) ProtoMessage() {}
+
+func (x *ActivityMemoLifecyclePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoLifecyclePayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoLifecyclePayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityMemoLifecyclePayload) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ActivityMemoLifecyclePayload) GetRuleTitle() string {
+	if x != nil {
+		return x.RuleTitle
+	}
+	return ""
+}
+
+func (x *ActivityMemoLifecyclePayload) GetAction() LifecycleRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return LifecycleRule_ACTION_UNSPECIFIED
+}
+
+func (x *ActivityMemoLifecyclePayload) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+// ActivityMemoReminderPayload represents the payload of a memo reminder activity.
+type ActivityMemoReminderPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo whose reminder came due.
+	// Refer to `Memo.name`.
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityMemoMentionPayload represents the payload of a memo mention activity.
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo mentioning the user.
+	// Refer to `Memo.name`.
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityMemoReactionPayload represents the payload of a memo reaction activity.
+type ActivityMemoReactionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo reacted to.
+	// Refer to `Memo.name`.
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	ReactionType  string `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReactionPayload) Reset() {
+	*x = ActivityMemoReactionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReactionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityMemoReactionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityMemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+// ActivityMemoReferencePayload represents the payload of a memo reference activity.
+type ActivityMemoReferencePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo referencing the related memo.
+	// Refer to `Memo.name`.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The name of the referenced memo.
+	RelatedMemo   string `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReferencePayload) Reset() {
+	*x = ActivityMemoReferencePayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReferencePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReferencePayload) ProtoMessage() {}
+
+func (x *ActivityMemoReferencePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReferencePayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReferencePayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActivityMemoReferencePayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityMemoReferencePayload) GetRelatedMemo() string {
+	if x != nil {
+		return x.RelatedMemo
+	}
+	return ""
+}
+
+// ActivityShortcutMatchPayload represents the payload of a shortcut match activity.
+type ActivityShortcutMatchPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the new memo matching the shortcut.
+	// Refer to `Memo.name`.
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	ShortcutId    string `protobuf:"bytes,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	ShortcutTitle string `protobuf:"bytes,3,opt,name=shortcut_title,json=shortcutTitle,proto3" json:"shortcut_title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutMatchPayload) Reset() {
+	*x = ActivityShortcutMatchPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutMatchPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutMatchPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutMatchPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutMatchPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutMatchPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActivityShortcutMatchPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityShortcutMatchPayload) GetShortcutId() string {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return ""
+}
+
+func (x *ActivityShortcutMatchPayload) GetShortcutTitle() string {
+	if x != nil {
+		return x.ShortcutTitle
+	}
+	return ""
+}
+
+// ActivityDigestPayload represents the payload of a digest activity.
+type ActivityDigestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the activities of the inbox messages rolled into the digest.
+	// Format: activities/{id}
+	Activities    []string `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityDigestPayload) Reset() {
+	*x = ActivityDigestPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityDigestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDigestPayload) ProtoMessage() {}
+
+func (x *ActivityDigestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDigestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityDigestPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ActivityDigestPayload) GetActivities() []string {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
 type GetActivityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the activity.
@@ -226,7 +669,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +681,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +694,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetActivityRequest) GetName() string {
@@ -265,7 +708,7 @@ var File_api_v1_activity_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x01\n" +
+	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a&api/v1/workspace_setting_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x01\n" +
 	"\bActivity\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x18\n" +
 	"\acreator\x18\x02 \x01(\tR\acreator\x12\x12\n" +
@@ -273,12 +716,44 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\tR\x05level\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x127\n" +
-	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadR\apayload\"^\n" +
+	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadR\apayload\"\x81\x05\n" +
 	"\x0fActivityPayload\x12K\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadR\vmemoComment\"S\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadR\vmemoComment\x12Q\n" +
+	"\x0ememo_lifecycle\x18\x02 \x01(\v2*.memos.api.v1.ActivityMemoLifecyclePayloadR\rmemoLifecycle\x12N\n" +
+	"\rmemo_reminder\x18\x03 \x01(\v2).memos.api.v1.ActivityMemoReminderPayloadR\fmemoReminder\x12K\n" +
+	"\fmemo_mention\x18\x04 \x01(\v2(.memos.api.v1.ActivityMemoMentionPayloadR\vmemoMention\x12N\n" +
+	"\rmemo_reaction\x18\x05 \x01(\v2).memos.api.v1.ActivityMemoReactionPayloadR\fmemoReaction\x12Q\n" +
+	"\x0ememo_reference\x18\x06 \x01(\v2*.memos.api.v1.ActivityMemoReferencePayloadR\rmemoReference\x12Q\n" +
+	"\x0eshortcut_match\x18\a \x01(\v2*.memos.api.v1.ActivityShortcutMatchPayloadR\rshortcutMatch\x12;\n" +
+	"\x06digest\x18\b \x01(\v2#.memos.api.v1.ActivityDigestPayloadR\x06digest\"S\n" +
 	"\x1aActivityMemoCommentPayload\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
-	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"(\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"\xa8\x01\n" +
+	"\x1cActivityMemoLifecyclePayload\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1d\n" +
+	"\n" +
+	"rule_title\x18\x02 \x01(\tR\truleTitle\x12:\n" +
+	"\x06action\x18\x03 \x01(\x0e2\".memos.api.v1.LifecycleRule.ActionR\x06action\x12\x14\n" +
+	"\x05memos\x18\x04 \x03(\tR\x05memos\"1\n" +
+	"\x1bActivityMemoReminderPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"0\n" +
+	"\x1aActivityMemoMentionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"V\n" +
+	"\x1bActivityMemoReactionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\"U\n" +
+	"\x1cActivityMemoReferencePayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"z\n" +
+	"\x1cActivityShortcutMatchPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\tR\n" +
+	"shortcutId\x12%\n" +
+	"\x0eshortcut_title\x18\x03 \x01(\tR\rshortcutTitle\"7\n" +
+	"\x15ActivityDigestPayload\x12\x1e\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\tR\n" +
+	"activities\"(\n" +
 	"\x12GetActivityRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name2\x86\x01\n" +
 	"\x0fActivityService\x12s\n" +
@@ -297,25 +772,41 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_activity_service_proto_rawDescData
 }
 
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_api_v1_activity_service_proto_goTypes = []any{
-	(*Activity)(nil),                   // 0: memos.api.v1.Activity
-	(*ActivityPayload)(nil),            // 1: memos.api.v1.ActivityPayload
-	(*ActivityMemoCommentPayload)(nil), // 2: memos.api.v1.ActivityMemoCommentPayload
-	(*GetActivityRequest)(nil),         // 3: memos.api.v1.GetActivityRequest
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	(*Activity)(nil),                     // 0: memos.api.v1.Activity
+	(*ActivityPayload)(nil),              // 1: memos.api.v1.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),   // 2: memos.api.v1.ActivityMemoCommentPayload
+	(*ActivityMemoLifecyclePayload)(nil), // 3: memos.api.v1.ActivityMemoLifecyclePayload
+	(*ActivityMemoReminderPayload)(nil),  // 4: memos.api.v1.ActivityMemoReminderPayload
+	(*ActivityMemoMentionPayload)(nil),   // 5: memos.api.v1.ActivityMemoMentionPayload
+	(*ActivityMemoReactionPayload)(nil),  // 6: memos.api.v1.ActivityMemoReactionPayload
+	(*ActivityMemoReferencePayload)(nil), // 7: memos.api.v1.ActivityMemoReferencePayload
+	(*ActivityShortcutMatchPayload)(nil), // 8: memos.api.v1.ActivityShortcutMatchPayload
+	(*ActivityDigestPayload)(nil),        // 9: memos.api.v1.ActivityDigestPayload
+	(*GetActivityRequest)(nil),           // 10: memos.api.v1.GetActivityRequest
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+	(LifecycleRule_Action)(0),            // 12: memos.api.v1.LifecycleRule.Action
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
-	4, // 0: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
-	1, // 1: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
-	2, // 2: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
-	3, // 3: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
-	0, // 4: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 1: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
+	2,  // 2: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
+	3,  // 3: memos.api.v1.ActivityPayload.memo_lifecycle:type_name -> memos.api.v1.ActivityMemoLifecyclePayload
+	4,  // 4: memos.api.v1.ActivityPayload.memo_reminder:type_name -> memos.api.v1.ActivityMemoReminderPayload
+	5,  // 5: memos.api.v1.ActivityPayload.memo_mention:type_name -> memos.api.v1.ActivityMemoMentionPayload
+	6,  // 6: memos.api.v1.ActivityPayload.memo_reaction:type_name -> memos.api.v1.ActivityMemoReactionPayload
+	7,  // 7: memos.api.v1.ActivityPayload.memo_reference:type_name -> memos.api.v1.ActivityMemoReferencePayload
+	8,  // 8: memos.api.v1.ActivityPayload.shortcut_match:type_name -> memos.api.v1.ActivityShortcutMatchPayload
+	9,  // 9: memos.api.v1.ActivityPayload.digest:type_name -> memos.api.v1.ActivityDigestPayload
+	12, // 10: memos.api.v1.ActivityMemoLifecyclePayload.action:type_name -> memos.api.v1.LifecycleRule.Action
+	10, // 11: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
+	0,  // 12: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -323,13 +814,14 @@ func file_api_v1_activity_service_proto_init() {
 	if File_api_v1_activity_service_proto != nil {
 		return
 	}
+	file_api_v1_workspace_setting_service_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -405,6 +405,105 @@ func (*SignOutRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{6}
 }
 
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email of the user.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the password reset email.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The new password.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 var File_api_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_auth_service_proto_rawDesc = "" +
@@ -428,12 +527,19 @@ const file_api_v1_auth_service_proto_rawDesc = "" +
 	"\rSignUpRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x10\n" +
-	"\x0eSignOutRequest2\x82\x03\n" +
+	"\x0eSignOutRequest\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"H\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword2\x82\x05\n" +
 	"\vAuthService\x12d\n" +
 	"\rGetAuthStatus\x12\".memos.api.v1.GetAuthStatusRequest\x1a\x12.memos.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/status\x12V\n" +
 	"\x06SignIn\x12\x1b.memos.api.v1.SignInRequest\x1a\x12.memos.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signin\x12V\n" +
 	"\x06SignUp\x12\x1b.memos.api.v1.SignUpRequest\x1a\x12.memos.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signup\x12]\n" +
-	"\aSignOut\x12\x1c.memos.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signoutB\xa8\x01\n" +
+	"\aSignOut\x12\x1c.memos.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signout\x12\x88\x01\n" +
+	"\x14RequestPasswordReset\x12).memos.api.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/auth/password:requestReset\x12s\n" +
+	"\rResetPassword\x12\".memos.api.v1.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/password:resetB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10AuthServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -448,35 +554,41 @@ func file_api_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_service_proto_rawDescData
 }
 
-var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_v1_auth_service_proto_goTypes = []any{
-	(*GetAuthStatusRequest)(nil),  // 0: memos.api.v1.GetAuthStatusRequest
-	(*GetAuthStatusResponse)(nil), // 1: memos.api.v1.GetAuthStatusResponse
-	(*SignInRequest)(nil),         // 2: memos.api.v1.SignInRequest
-	(*PasswordCredentials)(nil),   // 3: memos.api.v1.PasswordCredentials
-	(*SSOCredentials)(nil),        // 4: memos.api.v1.SSOCredentials
-	(*SignUpRequest)(nil),         // 5: memos.api.v1.SignUpRequest
-	(*SignOutRequest)(nil),        // 6: memos.api.v1.SignOutRequest
-	(*User)(nil),                  // 7: memos.api.v1.User
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(*GetAuthStatusRequest)(nil),        // 0: memos.api.v1.GetAuthStatusRequest
+	(*GetAuthStatusResponse)(nil),       // 1: memos.api.v1.GetAuthStatusResponse
+	(*SignInRequest)(nil),               // 2: memos.api.v1.SignInRequest
+	(*PasswordCredentials)(nil),         // 3: memos.api.v1.PasswordCredentials
+	(*SSOCredentials)(nil),              // 4: memos.api.v1.SSOCredentials
+	(*SignUpRequest)(nil),               // 5: memos.api.v1.SignUpRequest
+	(*SignOutRequest)(nil),              // 6: memos.api.v1.SignOutRequest
+	(*RequestPasswordResetRequest)(nil), // 7: memos.api.v1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),        // 8: memos.api.v1.ResetPasswordRequest
+	(*User)(nil),                        // 9: memos.api.v1.User
+	(*emptypb.Empty)(nil),               // 10: google.protobuf.Empty
 }
 var file_api_v1_auth_service_proto_depIdxs = []int32{
-	7, // 0: memos.api.v1.GetAuthStatusResponse.user:type_name -> memos.api.v1.User
-	3, // 1: memos.api.v1.SignInRequest.password_credentials:type_name -> memos.api.v1.PasswordCredentials
-	4, // 2: memos.api.v1.SignInRequest.sso_credentials:type_name -> memos.api.v1.SSOCredentials
-	0, // 3: memos.api.v1.AuthService.GetAuthStatus:input_type -> memos.api.v1.GetAuthStatusRequest
-	2, // 4: memos.api.v1.AuthService.SignIn:input_type -> memos.api.v1.SignInRequest
-	5, // 5: memos.api.v1.AuthService.SignUp:input_type -> memos.api.v1.SignUpRequest
-	6, // 6: memos.api.v1.AuthService.SignOut:input_type -> memos.api.v1.SignOutRequest
-	7, // 7: memos.api.v1.AuthService.GetAuthStatus:output_type -> memos.api.v1.User
-	7, // 8: memos.api.v1.AuthService.SignIn:output_type -> memos.api.v1.User
-	7, // 9: memos.api.v1.AuthService.SignUp:output_type -> memos.api.v1.User
-	8, // 10: memos.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9,  // 0: memos.api.v1.GetAuthStatusResponse.user:type_name -> memos.api.v1.User
+	3,  // 1: memos.api.v1.SignInRequest.password_credentials:type_name -> memos.api.v1.PasswordCredentials
+	4,  // 2: memos.api.v1.SignInRequest.sso_credentials:type_name -> memos.api.v1.SSOCredentials
+	0,  // 3: memos.api.v1.AuthService.GetAuthStatus:input_type -> memos.api.v1.GetAuthStatusRequest
+	2,  // 4: memos.api.v1.AuthService.SignIn:input_type -> memos.api.v1.SignInRequest
+	5,  // 5: memos.api.v1.AuthService.SignUp:input_type -> memos.api.v1.SignUpRequest
+	6,  // 6: memos.api.v1.AuthService.SignOut:input_type -> memos.api.v1.SignOutRequest
+	7,  // 7: memos.api.v1.AuthService.RequestPasswordReset:input_type -> memos.api.v1.RequestPasswordResetRequest
+	8,  // 8: memos.api.v1.AuthService.ResetPassword:input_type -> memos.api.v1.ResetPasswordRequest
+	9,  // 9: memos.api.v1.AuthService.GetAuthStatus:output_type -> memos.api.v1.User
+	9,  // 10: memos.api.v1.AuthService.SignIn:output_type -> memos.api.v1.User
+	9,  // 11: memos.api.v1.AuthService.SignUp:output_type -> memos.api.v1.User
+	10, // 12: memos.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
+	10, // 13: memos.api.v1.AuthService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	10, // 14: memos.api.v1.AuthService.ResetPassword:output_type -> google.protobuf.Empty
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_service_proto_init() }
@@ -495,7 +607,7 @@ func file_api_v1_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_service_proto_rawDesc), len(file_api_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,6 +27,7 @@ const (
 	State_STATE_UNSPECIFIED State = 0
 	State_NORMAL            State = 1
 	State_ARCHIVED          State = 2
+	State_DELETED           State = 3
 )
 
 // Enum value maps for State.
@@ -35,11 +36,13 @@ var (
 		0: "STATE_UNSPECIFIED",
 		1: "NORMAL",
 		2: "ARCHIVED",
+		3: "DELETED",
 	}
 	State_value = map[string]int32{
 		"STATE_UNSPECIFIED": 0,
 		"NORMAL":            1,
 		"ARCHIVED":          2,
+		"DELETED":           3,
 	}
 )
 
@@ -121,9 +124,11 @@ func (Direction) EnumDescriptor() ([]byte, []int) {
 
 // Used internally for obfuscating the page token.
 type PageToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The cursor of the last memo of the previous page, it's used instead of the offset if set.
+	MemoCursor    *PageToken_MemoCursor `protobuf:"bytes,3,opt,name=memo_cursor,json=memoCursor,proto3" json:"memo_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -172,19 +177,134 @@ func (x *PageToken) GetOffset() int32 {
 	return 0
 }
 
+func (x *PageToken) GetMemoCursor() *PageToken_MemoCursor {
+	if x != nil {
+		return x.MemoCursor
+	}
+	return nil
+}
+
+type PageToken_MemoCursor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pinned        bool                   `protobuf:"varint,1,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedTs     int64                  `protobuf:"varint,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs     int64                  `protobuf:"varint,5,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	ReactionCount int32                  `protobuf:"varint,6,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`
+	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	// The order of the listed memos, e.g. "pinned desc, created_ts desc".
+	// The cursor is only valid for the listing in the same order.
+	OrderBy       string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageToken_MemoCursor) Reset() {
+	*x = PageToken_MemoCursor{}
+	mi := &file_api_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageToken_MemoCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageToken_MemoCursor) ProtoMessage() {}
+
+func (x *PageToken_MemoCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageToken_MemoCursor.ProtoReflect.Descriptor instead.
+func (*PageToken_MemoCursor) Descriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *PageToken_MemoCursor) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *PageToken_MemoCursor) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PageToken_MemoCursor) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *PageToken_MemoCursor) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+func (x *PageToken_MemoCursor) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+func (x *PageToken_MemoCursor) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PageToken_MemoCursor) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 var File_api_v1_common_proto protoreflect.FileDescriptor
 
 const file_api_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/v1/common.proto\x12\fmemos.api.v1\"9\n" +
+	"\x13api/v1/common.proto\x12\fmemos.api.v1\"\xcb\x02\n" +
 	"\tPageToken\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset*8\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12C\n" +
+	"\vmemo_cursor\x18\x03 \x01(\v2\".memos.api.v1.PageToken.MemoCursorR\n" +
+	"memoCursor\x1a\xca\x01\n" +
+	"\n" +
+	"MemoCursor\x12\x16\n" +
+	"\x06pinned\x18\x01 \x01(\bR\x06pinned\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x04 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"updated_ts\x18\x05 \x01(\x03R\tupdatedTs\x12%\n" +
+	"\x0ereaction_count\x18\x06 \x01(\x05R\rreactionCount\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy*E\n" +
 	"\x05State\x12\x15\n" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06NORMAL\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02*9\n" +
+	"\bARCHIVED\x10\x02\x12\v\n" +
+	"\aDELETED\x10\x03*9\n" +
 	"\tDirection\x12\x19\n" +
 	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03ASC\x10\x01\x12\b\n" +
@@ -204,18 +324,20 @@ func file_api_v1_common_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_api_v1_common_proto_goTypes = []any{
-	(State)(0),        // 0: memos.api.v1.State
-	(Direction)(0),    // 1: memos.api.v1.Direction
-	(*PageToken)(nil), // 2: memos.api.v1.PageToken
+	(State)(0),                   // 0: memos.api.v1.State
+	(Direction)(0),               // 1: memos.api.v1.Direction
+	(*PageToken)(nil),            // 2: memos.api.v1.PageToken
+	(*PageToken_MemoCursor)(nil), // 3: memos.api.v1.PageToken.MemoCursor
 }
 var file_api_v1_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: memos.api.v1.PageToken.memo_cursor:type_name -> memos.api.v1.PageToken.MemoCursor
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_common_proto_init() }
@@ -229,7 +351,7 @@ func file_api_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_common_proto_rawDesc), len(file_api_v1_common_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
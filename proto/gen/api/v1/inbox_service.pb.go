@@ -80,6 +80,13 @@ const (
 	Inbox_TYPE_UNSPECIFIED Inbox_Type = 0
 	Inbox_MEMO_COMMENT     Inbox_Type = 1
 	Inbox_VERSION_UPDATE   Inbox_Type = 2
+	Inbox_MEMO_LIFECYCLE   Inbox_Type = 3
+	Inbox_REMINDER         Inbox_Type = 4
+	Inbox_MEMO_MENTION     Inbox_Type = 5
+	Inbox_MEMO_REACTION    Inbox_Type = 6
+	Inbox_MEMO_REFERENCE   Inbox_Type = 7
+	Inbox_SHORTCUT_MATCH   Inbox_Type = 8
+	Inbox_DIGEST           Inbox_Type = 9
 )
 
 // Enum value maps for Inbox_Type.
@@ -88,11 +95,25 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_LIFECYCLE",
+		4: "REMINDER",
+		5: "MEMO_MENTION",
+		6: "MEMO_REACTION",
+		7: "MEMO_REFERENCE",
+		8: "SHORTCUT_MATCH",
+		9: "DIGEST",
 	}
 	Inbox_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
 		"VERSION_UPDATE":   2,
+		"MEMO_LIFECYCLE":   3,
+		"REMINDER":         4,
+		"MEMO_MENTION":     5,
+		"MEMO_REACTION":    6,
+		"MEMO_REFERENCE":   7,
+		"SHORTCUT_MATCH":   8,
+		"DIGEST":           9,
 	}
 )
 
@@ -388,6 +409,140 @@ func (x *UpdateInboxRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+type MarkAllInboxesReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: users/{user}
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllInboxesReadRequest) Reset() {
+	*x = MarkAllInboxesReadRequest{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllInboxesReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllInboxesReadRequest) ProtoMessage() {}
+
+func (x *MarkAllInboxesReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllInboxesReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllInboxesReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkAllInboxesReadRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type GetUnreadInboxCountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: users/{user}
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadInboxCountRequest) Reset() {
+	*x = GetUnreadInboxCountRequest{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadInboxCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadInboxCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadInboxCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadInboxCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadInboxCountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUnreadInboxCountRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type GetUnreadInboxCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadInboxCountResponse) Reset() {
+	*x = GetUnreadInboxCountResponse{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadInboxCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadInboxCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadInboxCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadInboxCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadInboxCountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUnreadInboxCountResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
 type DeleteInboxRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the inbox to delete.
@@ -398,7 +553,7 @@ type DeleteInboxRequest struct {
 
 func (x *DeleteInboxRequest) Reset() {
 	*x = DeleteInboxRequest{}
-	mi := &file_api_v1_inbox_service_proto_msgTypes[4]
+	mi := &file_api_v1_inbox_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -410,7 +565,7 @@ func (x *DeleteInboxRequest) String() string {
 func (*DeleteInboxRequest) ProtoMessage() {}
 
 func (x *DeleteInboxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_inbox_service_proto_msgTypes[4]
+	mi := &file_api_v1_inbox_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +578,7 @@ func (x *DeleteInboxRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteInboxRequest.ProtoReflect.Descriptor instead.
 func (*DeleteInboxRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteInboxRequest) GetName() string {
@@ -437,7 +592,7 @@ var File_api_v1_inbox_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x03\n" +
+	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x04\n" +
 	"\x05Inbox\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06sender\x18\x02 \x01(\tR\x06sender\x12\x1a\n" +
@@ -452,11 +607,19 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\"B\n" +
+	"\bARCHIVED\x10\x02\"\xbd\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02B\x0e\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x12\n" +
+	"\x0eMEMO_LIFECYCLE\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x05\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x06\x12\x12\n" +
+	"\x0eMEMO_REFERENCE\x10\a\x12\x12\n" +
+	"\x0eSHORTCUT_MATCH\x10\b\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\tB\x0e\n" +
 	"\f_activity_id\"d\n" +
 	"\x12ListInboxesRequest\x12\x12\n" +
 	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1b\n" +
@@ -469,12 +632,20 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\x12UpdateInboxRequest\x12)\n" +
 	"\x05inbox\x18\x01 \x01(\v2\x13.memos.api.v1.InboxR\x05inbox\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"(\n" +
+	"updateMask\"/\n" +
+	"\x19MarkAllInboxesReadRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\"0\n" +
+	"\x1aGetUnreadInboxCountRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\"@\n" +
+	"\x1bGetUnreadInboxCountResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\"(\n" +
 	"\x12DeleteInboxRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name2\xf7\x02\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name2\x88\x05\n" +
 	"\fInboxService\x12k\n" +
 	"\vListInboxes\x12 .memos.api.v1.ListInboxesRequest\x1a!.memos.api.v1.ListInboxesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/inboxes\x12\x87\x01\n" +
-	"\vUpdateInbox\x12 .memos.api.v1.UpdateInboxRequest\x1a\x13.memos.api.v1.Inbox\"A\xdaA\x11inbox,update_mask\x82\xd3\xe4\x93\x02':\x05inbox2\x1e/api/v1/{inbox.name=inboxes/*}\x12p\n" +
+	"\vUpdateInbox\x12 .memos.api.v1.UpdateInboxRequest\x1a\x13.memos.api.v1.Inbox\"A\xdaA\x11inbox,update_mask\x82\xd3\xe4\x93\x02':\x05inbox2\x1e/api/v1/{inbox.name=inboxes/*}\x12}\n" +
+	"\x12MarkAllInboxesRead\x12'.memos.api.v1.MarkAllInboxesReadRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/inboxes:markAllRead\x12\x8f\x01\n" +
+	"\x13GetUnreadInboxCount\x12(.memos.api.v1.GetUnreadInboxCountRequest\x1a).memos.api.v1.GetUnreadInboxCountResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/inboxes:unreadCount\x12p\n" +
 	"\vDeleteInbox\x12 .memos.api.v1.DeleteInboxRequest\x1a\x16.google.protobuf.Empty\"'\xdaA\x04name\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/{name=inboxes/*}B\xa9\x01\n" +
 	"\x10com.memos.api.v1B\x11InboxServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
@@ -491,37 +662,44 @@ func file_api_v1_inbox_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_inbox_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_inbox_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_inbox_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_api_v1_inbox_service_proto_goTypes = []any{
-	(Inbox_Status)(0),             // 0: memos.api.v1.Inbox.Status
-	(Inbox_Type)(0),               // 1: memos.api.v1.Inbox.Type
-	(*Inbox)(nil),                 // 2: memos.api.v1.Inbox
-	(*ListInboxesRequest)(nil),    // 3: memos.api.v1.ListInboxesRequest
-	(*ListInboxesResponse)(nil),   // 4: memos.api.v1.ListInboxesResponse
-	(*UpdateInboxRequest)(nil),    // 5: memos.api.v1.UpdateInboxRequest
-	(*DeleteInboxRequest)(nil),    // 6: memos.api.v1.DeleteInboxRequest
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(Inbox_Status)(0),                   // 0: memos.api.v1.Inbox.Status
+	(Inbox_Type)(0),                     // 1: memos.api.v1.Inbox.Type
+	(*Inbox)(nil),                       // 2: memos.api.v1.Inbox
+	(*ListInboxesRequest)(nil),          // 3: memos.api.v1.ListInboxesRequest
+	(*ListInboxesResponse)(nil),         // 4: memos.api.v1.ListInboxesResponse
+	(*UpdateInboxRequest)(nil),          // 5: memos.api.v1.UpdateInboxRequest
+	(*MarkAllInboxesReadRequest)(nil),   // 6: memos.api.v1.MarkAllInboxesReadRequest
+	(*GetUnreadInboxCountRequest)(nil),  // 7: memos.api.v1.GetUnreadInboxCountRequest
+	(*GetUnreadInboxCountResponse)(nil), // 8: memos.api.v1.GetUnreadInboxCountResponse
+	(*DeleteInboxRequest)(nil),          // 9: memos.api.v1.DeleteInboxRequest
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 11: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),               // 12: google.protobuf.Empty
 }
 var file_api_v1_inbox_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Inbox.status:type_name -> memos.api.v1.Inbox.Status
-	7, // 1: memos.api.v1.Inbox.create_time:type_name -> google.protobuf.Timestamp
-	1, // 2: memos.api.v1.Inbox.type:type_name -> memos.api.v1.Inbox.Type
-	2, // 3: memos.api.v1.ListInboxesResponse.inboxes:type_name -> memos.api.v1.Inbox
-	2, // 4: memos.api.v1.UpdateInboxRequest.inbox:type_name -> memos.api.v1.Inbox
-	8, // 5: memos.api.v1.UpdateInboxRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3, // 6: memos.api.v1.InboxService.ListInboxes:input_type -> memos.api.v1.ListInboxesRequest
-	5, // 7: memos.api.v1.InboxService.UpdateInbox:input_type -> memos.api.v1.UpdateInboxRequest
-	6, // 8: memos.api.v1.InboxService.DeleteInbox:input_type -> memos.api.v1.DeleteInboxRequest
-	4, // 9: memos.api.v1.InboxService.ListInboxes:output_type -> memos.api.v1.ListInboxesResponse
-	2, // 10: memos.api.v1.InboxService.UpdateInbox:output_type -> memos.api.v1.Inbox
-	9, // 11: memos.api.v1.InboxService.DeleteInbox:output_type -> google.protobuf.Empty
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Inbox.status:type_name -> memos.api.v1.Inbox.Status
+	10, // 1: memos.api.v1.Inbox.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 2: memos.api.v1.Inbox.type:type_name -> memos.api.v1.Inbox.Type
+	2,  // 3: memos.api.v1.ListInboxesResponse.inboxes:type_name -> memos.api.v1.Inbox
+	2,  // 4: memos.api.v1.UpdateInboxRequest.inbox:type_name -> memos.api.v1.Inbox
+	11, // 5: memos.api.v1.UpdateInboxRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 6: memos.api.v1.InboxService.ListInboxes:input_type -> memos.api.v1.ListInboxesRequest
+	5,  // 7: memos.api.v1.InboxService.UpdateInbox:input_type -> memos.api.v1.UpdateInboxRequest
+	6,  // 8: memos.api.v1.InboxService.MarkAllInboxesRead:input_type -> memos.api.v1.MarkAllInboxesReadRequest
+	7,  // 9: memos.api.v1.InboxService.GetUnreadInboxCount:input_type -> memos.api.v1.GetUnreadInboxCountRequest
+	9,  // 10: memos.api.v1.InboxService.DeleteInbox:input_type -> memos.api.v1.DeleteInboxRequest
+	4,  // 11: memos.api.v1.InboxService.ListInboxes:output_type -> memos.api.v1.ListInboxesResponse
+	2,  // 12: memos.api.v1.InboxService.UpdateInbox:output_type -> memos.api.v1.Inbox
+	12, // 13: memos.api.v1.InboxService.MarkAllInboxesRead:output_type -> google.protobuf.Empty
+	8,  // 14: memos.api.v1.InboxService.GetUnreadInboxCount:output_type -> memos.api.v1.GetUnreadInboxCountResponse
+	12, // 15: memos.api.v1.InboxService.DeleteInbox:output_type -> google.protobuf.Empty
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_inbox_service_proto_init() }
@@ -536,7 +714,7 @@ func file_api_v1_inbox_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_inbox_service_proto_rawDesc), len(file_api_v1_inbox_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
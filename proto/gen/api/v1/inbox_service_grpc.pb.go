@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InboxService_ListInboxes_FullMethodName = "/memos.api.v1.InboxService/ListInboxes"
-	InboxService_UpdateInbox_FullMethodName = "/memos.api.v1.InboxService/UpdateInbox"
-	InboxService_DeleteInbox_FullMethodName = "/memos.api.v1.InboxService/DeleteInbox"
+	InboxService_ListInboxes_FullMethodName         = "/memos.api.v1.InboxService/ListInboxes"
+	InboxService_UpdateInbox_FullMethodName         = "/memos.api.v1.InboxService/UpdateInbox"
+	InboxService_MarkAllInboxesRead_FullMethodName  = "/memos.api.v1.InboxService/MarkAllInboxesRead"
+	InboxService_GetUnreadInboxCount_FullMethodName = "/memos.api.v1.InboxService/GetUnreadInboxCount"
+	InboxService_DeleteInbox_FullMethodName         = "/memos.api.v1.InboxService/DeleteInbox"
 )
 
 // InboxServiceClient is the client API for InboxService service.
@@ -33,6 +35,10 @@ type InboxServiceClient interface {
 	ListInboxes(ctx context.Context, in *ListInboxesRequest, opts ...grpc.CallOption) (*ListInboxesResponse, error)
 	// UpdateInbox updates an inbox.
 	UpdateInbox(ctx context.Context, in *UpdateInboxRequest, opts ...grpc.CallOption) (*Inbox, error)
+	// MarkAllInboxesRead archives all the unread inboxes of the current user.
+	MarkAllInboxesRead(ctx context.Context, in *MarkAllInboxesReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUnreadInboxCount returns the number of the unread inboxes of the current user.
+	GetUnreadInboxCount(ctx context.Context, in *GetUnreadInboxCountRequest, opts ...grpc.CallOption) (*GetUnreadInboxCountResponse, error)
 	// DeleteInbox deletes an inbox.
 	DeleteInbox(ctx context.Context, in *DeleteInboxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -65,6 +71,26 @@ func (c *inboxServiceClient) UpdateInbox(ctx context.Context, in *UpdateInboxReq
 	return out, nil
 }
 
+func (c *inboxServiceClient) MarkAllInboxesRead(ctx context.Context, in *MarkAllInboxesReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InboxService_MarkAllInboxesRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) GetUnreadInboxCount(ctx context.Context, in *GetUnreadInboxCountRequest, opts ...grpc.CallOption) (*GetUnreadInboxCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadInboxCountResponse)
+	err := c.cc.Invoke(ctx, InboxService_GetUnreadInboxCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inboxServiceClient) DeleteInbox(ctx context.Context, in *DeleteInboxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -83,6 +109,10 @@ type InboxServiceServer interface {
 	ListInboxes(context.Context, *ListInboxesRequest) (*ListInboxesResponse, error)
 	// UpdateInbox updates an inbox.
 	UpdateInbox(context.Context, *UpdateInboxRequest) (*Inbox, error)
+	// MarkAllInboxesRead archives all the unread inboxes of the current user.
+	MarkAllInboxesRead(context.Context, *MarkAllInboxesReadRequest) (*emptypb.Empty, error)
+	// GetUnreadInboxCount returns the number of the unread inboxes of the current user.
+	GetUnreadInboxCount(context.Context, *GetUnreadInboxCountRequest) (*GetUnreadInboxCountResponse, error)
 	// DeleteInbox deletes an inbox.
 	DeleteInbox(context.Context, *DeleteInboxRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedInboxServiceServer()
@@ -101,6 +131,12 @@ func (UnimplementedInboxServiceServer) ListInboxes(context.Context, *ListInboxes
 func (UnimplementedInboxServiceServer) UpdateInbox(context.Context, *UpdateInboxRequest) (*Inbox, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInbox not implemented")
 }
+func (UnimplementedInboxServiceServer) MarkAllInboxesRead(context.Context, *MarkAllInboxesReadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllInboxesRead not implemented")
+}
+func (UnimplementedInboxServiceServer) GetUnreadInboxCount(context.Context, *GetUnreadInboxCountRequest) (*GetUnreadInboxCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadInboxCount not implemented")
+}
 func (UnimplementedInboxServiceServer) DeleteInbox(context.Context, *DeleteInboxRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInbox not implemented")
 }
@@ -161,6 +197,42 @@ func _InboxService_UpdateInbox_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InboxService_MarkAllInboxesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllInboxesReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).MarkAllInboxesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_MarkAllInboxesRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).MarkAllInboxesRead(ctx, req.(*MarkAllInboxesReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_GetUnreadInboxCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadInboxCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).GetUnreadInboxCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_GetUnreadInboxCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).GetUnreadInboxCount(ctx, req.(*GetUnreadInboxCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InboxService_DeleteInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInboxRequest)
 	if err := dec(in); err != nil {
@@ -194,6 +266,14 @@ var InboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInbox",
 			Handler:    _InboxService_UpdateInbox_Handler,
 		},
+		{
+			MethodName: "MarkAllInboxesRead",
+			Handler:    _InboxService_MarkAllInboxesRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadInboxCount",
+			Handler:    _InboxService_GetUnreadInboxCount_Handler,
+		},
 		{
 			MethodName: "DeleteInbox",
 			Handler:    _InboxService_DeleteInbox_Handler,
@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -77,6 +78,59 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
 }
 
+type MemoEvent_Type int32
+
+const (
+	MemoEvent_TYPE_UNSPECIFIED MemoEvent_Type = 0
+	MemoEvent_CREATED          MemoEvent_Type = 1
+	MemoEvent_UPDATED          MemoEvent_Type = 2
+	// The memo is moved to the trash, the memo is the last state before.
+	MemoEvent_DELETED MemoEvent_Type = 3
+)
+
+// Enum value maps for MemoEvent_Type.
+var (
+	MemoEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+	}
+	MemoEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"CREATED":          1,
+		"UPDATED":          2,
+		"DELETED":          3,
+	}
+)
+
+func (x MemoEvent_Type) Enum() *MemoEvent_Type {
+	p := new(MemoEvent_Type)
+	*p = x
+	return p
+}
+
+func (x MemoEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MemoEvent_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[1]
+}
+
+func (x MemoEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoEvent_Type.Descriptor instead.
+func (MemoEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type MemoRelation_Type int32
 
 const (
@@ -110,11 +164,11 @@ func (x MemoRelation_Type) String() string {
 }
 
 func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
 }
 
 func (MemoRelation_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_memo_service_proto_enumTypes[1]
+	return &file_api_v1_memo_service_proto_enumTypes[2]
 }
 
 func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
@@ -123,7 +177,219 @@ func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemoRelation_Type.Descriptor instead.
 func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type MemoGraph_Node_Type int32
+
+const (
+	MemoGraph_Node_TYPE_UNSPECIFIED MemoGraph_Node_Type = 0
+	MemoGraph_Node_MEMO             MemoGraph_Node_Type = 1
+	MemoGraph_Node_TAG              MemoGraph_Node_Type = 2
+)
+
+// Enum value maps for MemoGraph_Node_Type.
+var (
+	MemoGraph_Node_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "MEMO",
+		2: "TAG",
+	}
+	MemoGraph_Node_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"MEMO":             1,
+		"TAG":              2,
+	}
+)
+
+func (x MemoGraph_Node_Type) Enum() *MemoGraph_Node_Type {
+	p := new(MemoGraph_Node_Type)
+	*p = x
+	return p
+}
+
+func (x MemoGraph_Node_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGraph_Node_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[3].Descriptor()
+}
+
+func (MemoGraph_Node_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[3]
+}
+
+func (x MemoGraph_Node_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGraph_Node_Type.Descriptor instead.
+func (MemoGraph_Node_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30, 0, 0}
+}
+
+type MemoGraph_Edge_Type int32
+
+const (
+	MemoGraph_Edge_TYPE_UNSPECIFIED MemoGraph_Edge_Type = 0
+	// The source memo references the target memo.
+	MemoGraph_Edge_REFERENCE MemoGraph_Edge_Type = 1
+	// The source memo is a comment of the target memo.
+	MemoGraph_Edge_COMMENT MemoGraph_Edge_Type = 2
+	// The source memo has the target tag.
+	MemoGraph_Edge_TAG MemoGraph_Edge_Type = 3
+	// The source and target tags are used together, the edge is undirected.
+	MemoGraph_Edge_TAG_COOCCURRENCE MemoGraph_Edge_Type = 4
+)
+
+// Enum value maps for MemoGraph_Edge_Type.
+var (
+	MemoGraph_Edge_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "REFERENCE",
+		2: "COMMENT",
+		3: "TAG",
+		4: "TAG_COOCCURRENCE",
+	}
+	MemoGraph_Edge_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"REFERENCE":        1,
+		"COMMENT":          2,
+		"TAG":              3,
+		"TAG_COOCCURRENCE": 4,
+	}
+)
+
+func (x MemoGraph_Edge_Type) Enum() *MemoGraph_Edge_Type {
+	p := new(MemoGraph_Edge_Type)
+	*p = x
+	return p
+}
+
+func (x MemoGraph_Edge_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGraph_Edge_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[4].Descriptor()
+}
+
+func (MemoGraph_Edge_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[4]
+}
+
+func (x MemoGraph_Edge_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGraph_Edge_Type.Descriptor instead.
+func (MemoGraph_Edge_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30, 1, 0}
+}
+
+type GetMemoGraphRequest_Format int32
+
+const (
+	GetMemoGraphRequest_FORMAT_UNSPECIFIED GetMemoGraphRequest_Format = 0
+	// `MemoGraph` in JSON, the default format.
+	GetMemoGraphRequest_JSON    GetMemoGraphRequest_Format = 1
+	GetMemoGraphRequest_GRAPHML GetMemoGraphRequest_Format = 2
+	GetMemoGraphRequest_DOT     GetMemoGraphRequest_Format = 3
+)
+
+// Enum value maps for GetMemoGraphRequest_Format.
+var (
+	GetMemoGraphRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "GRAPHML",
+		3: "DOT",
+	}
+	GetMemoGraphRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JSON":               1,
+		"GRAPHML":            2,
+		"DOT":                3,
+	}
+)
+
+func (x GetMemoGraphRequest_Format) Enum() *GetMemoGraphRequest_Format {
+	p := new(GetMemoGraphRequest_Format)
+	*p = x
+	return p
+}
+
+func (x GetMemoGraphRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetMemoGraphRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[5].Descriptor()
+}
+
+func (GetMemoGraphRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[5]
+}
+
+func (x GetMemoGraphRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest_Format.Descriptor instead.
+func (GetMemoGraphRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type MemoGrant_Permission int32
+
+const (
+	MemoGrant_PERMISSION_UNSPECIFIED MemoGrant_Permission = 0
+	// The grantee can read the memo and its resources.
+	MemoGrant_READ MemoGrant_Permission = 1
+	// The grantee can read and comment on the memo.
+	MemoGrant_COMMENT MemoGrant_Permission = 2
+)
+
+// Enum value maps for MemoGrant_Permission.
+var (
+	MemoGrant_Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "READ",
+		2: "COMMENT",
+	}
+	MemoGrant_Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED": 0,
+		"READ":                   1,
+		"COMMENT":                2,
+	}
+)
+
+func (x MemoGrant_Permission) Enum() *MemoGrant_Permission {
+	p := new(MemoGrant_Permission)
+	*p = x
+	return p
+}
+
+func (x MemoGrant_Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGrant_Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[6].Descriptor()
+}
+
+func (MemoGrant_Permission) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[6]
+}
+
+func (x MemoGrant_Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGrant_Permission.Descriptor instead.
+func (MemoGrant_Permission) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type Memo struct {
@@ -153,7 +419,21 @@ type Memo struct {
 	// The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,19,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// The location of the memo.
-	Location      *Location `protobuf:"bytes,20,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Location *Location `protobuf:"bytes,20,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// The time when the memo is scheduled to be published.
+	// At that time, the visibility of the memo is changed to `target_visibility`.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=publish_time,json=publishTime,proto3,oneof" json:"publish_time,omitempty"`
+	// The visibility to apply when the memo is published.
+	// Default to PUBLIC when `publish_time` is set.
+	TargetVisibility Visibility `protobuf:"varint,22,opt,name=target_visibility,json=targetVisibility,proto3,enum=memos.api.v1.Visibility" json:"target_visibility,omitempty"`
+	// The etag of the memo, derived from its update time and a hash of its content.
+	// Pass it to `UpdateMemo` or `DeleteMemo` to avoid overwriting concurrent changes.
+	Etag string `protobuf:"bytes,23,opt,name=etag,proto3" json:"etag,omitempty"`
+	// The reminders of the memo, delivered to the inbox of the creator when they come due.
+	Reminders []*Memo_Reminder `protobuf:"bytes,24,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	// The calendar day of a daily memo, e.g. "2025-01-02".
+	// Refer to `GetOrCreateDailyMemo`.
+	DailyDate     string `protobuf:"bytes,25,opt,name=daily_date,json=dailyDate,proto3" json:"daily_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -314,6 +594,41 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
+func (x *Memo) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *Memo) GetTargetVisibility() Visibility {
+	if x != nil {
+		return x.TargetVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Memo) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Memo) GetReminders() []*Memo_Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *Memo) GetDailyDate() string {
+	if x != nil {
+		return x.DailyDate
+	}
+	return ""
+}
+
 type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -419,6 +734,74 @@ func (x *CreateMemoRequest) GetMemo() *Memo {
 	return nil
 }
 
+type GetOrCreateDailyMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The calendar day in the format of "YYYY-MM-DD".
+	// If not specified, it's the current day in the timezone.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// The IANA timezone of the day, e.g. "Asia/Tokyo".
+	// If not specified, the timezone of the server is used.
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The id of the template of the current user to create the memo from.
+	// Besides the variables of `Template.content`, `{{weekday}}`, `{{week.start}}` and `{{week.end}}`
+	// are expanded, with the week starting at the workspace `week_start_day_offset`.
+	// If not specified, the memo is created with the date as its heading.
+	TemplateId    string `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrCreateDailyMemoRequest) Reset() {
+	*x = GetOrCreateDailyMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrCreateDailyMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrCreateDailyMemoRequest) ProtoMessage() {}
+
+func (x *GetOrCreateDailyMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrCreateDailyMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetOrCreateDailyMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrCreateDailyMemoRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetOrCreateDailyMemoRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetOrCreateDailyMemoRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
 type ListMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The parent is the owner of the memos.
@@ -431,11 +814,16 @@ type ListMemosRequest struct {
 	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	// The state of the memos to list.
 	// Default to `NORMAL`. Set to `ARCHIVED` to list archived memos.
+	// Set to `DELETED` to list the memos in the trash.
 	State State `protobuf:"varint,4,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
-	// What field to sort the results by.
-	// Default to display_time.
+	// The comma separated fields to sort the results by, following AIP-132.
+	// Each field is sorted in ascending order unless it's followed by `desc`,
+	// e.g. "update_time desc, pinned desc, reaction_count desc, title asc".
+	// Supported fields: pinned, create_time, update_time, display_time, reaction_count and title.
+	// The title is the first line of the memo content.
+	// Default to display_time in the direction below.
 	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
-	// The direction to sort the results by.
+	// The direction to sort the results by if `sort` is not specified.
 	// Default to DESC.
 	Direction Direction `protobuf:"varint,6,opt,name=direction,proto3,enum=memos.api.v1.Direction" json:"direction,omitempty"`
 	// Filter is a CEL expression to filter memos.
@@ -450,7 +838,7 @@ type ListMemosRequest struct {
 
 func (x *ListMemosRequest) Reset() {
 	*x = ListMemosRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +850,7 @@ func (x *ListMemosRequest) String() string {
 func (*ListMemosRequest) ProtoMessage() {}
 
 func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +863,7 @@ func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
 func (*ListMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListMemosRequest) GetParent() string {
@@ -546,7 +934,7 @@ type ListMemosResponse struct {
 
 func (x *ListMemosResponse) Reset() {
 	*x = ListMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -558,7 +946,7 @@ func (x *ListMemosResponse) String() string {
 func (*ListMemosResponse) ProtoMessage() {}
 
 func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +959,7 @@ func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
 func (*ListMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListMemosResponse) GetMemos() []*Memo {
@@ -588,29 +976,30 @@ func (x *ListMemosResponse) GetNextPageToken() string {
 	return ""
 }
 
-type GetMemoRequest struct {
+type WatchMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Filter is a CEL expression to filter the watched memos.
+	// Refer to `Shortcut.filter`.
+	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoRequest) Reset() {
-	*x = GetMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+func (x *WatchMemosRequest) Reset() {
+	*x = WatchMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoRequest) String() string {
+func (x *WatchMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoRequest) ProtoMessage() {}
+func (*WatchMemosRequest) ProtoMessage() {}
 
-func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+func (x *WatchMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,43 +1010,42 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WatchMemosRequest.ProtoReflect.Descriptor instead.
+func (*WatchMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMemoRequest) GetName() string {
+func (x *WatchMemosRequest) GetFilter() string {
 	if x != nil {
-		return x.Name
+		return x.Filter
 	}
 	return ""
 }
 
-type UpdateMemoRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The memo to update.
-	// The `name` field is required.
-	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+type MemoEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MemoEvent_Type         `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.MemoEvent_Type" json:"type,omitempty"`
+	Memo          *Memo                  `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	EventTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemoRequest) Reset() {
-	*x = UpdateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *MemoEvent) Reset() {
+	*x = MemoEvent{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoRequest) String() string {
+func (x *MemoEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemoRequest) ProtoMessage() {}
+func (*MemoEvent) ProtoMessage() {}
 
-func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *MemoEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,48 +1056,2078 @@ func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MemoEvent.ProtoReflect.Descriptor instead.
+func (*MemoEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MemoEvent) GetType() MemoEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoEvent_TYPE_UNSPECIFIED
+}
+
+func (x *MemoEvent) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *MemoEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+type SearchMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The search query. The memos must contain all of its whitespace separated terms.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of results to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `SearchMemos` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filter is a CEL expression to filter the searched memos.
+	// Refer to `Shortcut.filter`.
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosRequest) Reset() {
+	*x = SearchMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosRequest) ProtoMessage() {}
+
+func (x *SearchMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosRequest.ProtoReflect.Descriptor instead.
+func (*SearchMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchMemosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type SearchMemosResponse struct {
+	state   protoimpl.MessageState        `protogen:"open.v1"`
+	Results []*SearchMemosResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosResponse) Reset() {
+	*x = SearchMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosResponse) ProtoMessage() {}
+
+func (x *SearchMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosResponse.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchMemosResponse) GetResults() []*SearchMemosResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The token of a share link of the memo.
+	// It grants access to the memo regardless of its visibility.
+	ShareToken    string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRequest) Reset() {
+	*x = GetMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRequest) ProtoMessage() {}
+
+func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMemoRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type UpdateMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo to update.
+	// The `name` field is required.
+	Memo       *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The etag of the memo as last read by the client.
+	// If set, the update fails with ABORTED when the memo has been changed since.
+	// The `If-Match` HTTP header is used when it's empty.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoRequest) Reset() {
+	*x = UpdateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoRequest) ProtoMessage() {}
+
+func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateMemoRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The etag of the memo as last read by the client.
+	// If set, the deletion fails with ABORTED when the memo has been changed since.
+	// The `If-Match` HTTP header is used when it's empty.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoRequest) Reset() {
+	*x = DeleteMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoRequest) ProtoMessage() {}
+
+func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteMemoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UndeleteMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteMemoRequest) Reset() {
+	*x = UndeleteMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteMemoRequest) ProtoMessage() {}
+
+func (x *UndeleteMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteMemoRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UndeleteMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PurgeMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeMemoRequest) Reset() {
+	*x = PurgeMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeMemoRequest) ProtoMessage() {}
+
+func (x *PurgeMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeMemoRequest.ProtoReflect.Descriptor instead.
+func (*PurgeMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PurgeMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BatchUpdateMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter is a CEL expression to select the memos to update.
+	// Refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The state of the memos to select.
+	// Default to `NORMAL`.
+	State  State                           `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	Update *BatchUpdateMemosRequest_Update `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`
+	// The fields of `update` to apply.
+	// Supported paths: visibility, pinned, state, add_tag, remove_tag.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// If true, the memos are not updated and only the affected count is returned.
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosRequest) Reset() {
+	*x = BatchUpdateMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosRequest) ProtoMessage() {}
+
+func (x *BatchUpdateMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchUpdateMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BatchUpdateMemosRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *BatchUpdateMemosRequest) GetUpdate() *BatchUpdateMemosRequest_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BatchUpdateMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that are (or would be, in dry run mode) updated.
+	AffectedCount int32 `protobuf:"varint,1,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosResponse) Reset() {
+	*x = BatchUpdateMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosResponse) ProtoMessage() {}
+
+func (x *BatchUpdateMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchUpdateMemosResponse) GetAffectedCount() int32 {
+	if x != nil {
+		return x.AffectedCount
+	}
+	return 0
+}
+
+type BatchDeleteMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter is a CEL expression to select the memos to delete.
+	// Refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The state of the memos to select.
+	// Default to `NORMAL`.
+	State State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// If true, the memos are not deleted and only the affected count is returned.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteMemosRequest) Reset() {
+	*x = BatchDeleteMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteMemosRequest) ProtoMessage() {}
+
+func (x *BatchDeleteMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteMemosRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchDeleteMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BatchDeleteMemosRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *BatchDeleteMemosRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BatchDeleteMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that are (or would be, in dry run mode) deleted.
+	AffectedCount int32 `protobuf:"varint,1,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteMemosResponse) Reset() {
+	*x = BatchDeleteMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteMemosResponse) ProtoMessage() {}
+
+func (x *BatchDeleteMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteMemosResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchDeleteMemosResponse) GetAffectedCount() int32 {
+	if x != nil {
+		return x.AffectedCount
+	}
+	return 0
+}
+
+type RenameMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent, who owns the tags.
+	// Format: memos/{id}. Use "memos/-" to rename all tags.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	OldTag        string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameMemoTagRequest) Reset() {
+	*x = RenameMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameMemoTagRequest) ProtoMessage() {}
+
+func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenameMemoTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetOldTag() string {
+	if x != nil {
+		return x.OldTag
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+type DeleteMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent, who owns the tags.
+	// Format: memos/{id}. Use "memos/-" to delete all tags.
+	Parent             string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Tag                string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	DeleteRelatedMemos bool   `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteMemoTagRequest) Reset() {
+	*x = DeleteMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoTagRequest) ProtoMessage() {}
+
+func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteMemoTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *DeleteMemoTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+	if x != nil {
+		return x.DeleteRelatedMemos
+	}
+	return false
+}
+
+type SetMemoResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Resources     []*Resource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoResourcesRequest) Reset() {
+	*x = SetMemoResourcesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoResourcesRequest) ProtoMessage() {}
+
+func (x *SetMemoResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoResourcesRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetMemoResourcesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoResourcesRequest) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type ListMemoResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoResourcesRequest) Reset() {
+	*x = ListMemoResourcesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoResourcesRequest) ProtoMessage() {}
+
+func (x *ListMemoResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListMemoResourcesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoResourcesResponse) Reset() {
+	*x = ListMemoResourcesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoResourcesResponse) ProtoMessage() {}
+
+func (x *ListMemoResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMemoResourcesResponse) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type MemoRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memo          *MemoRelation_Memo     `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	RelatedMemo   *MemoRelation_Memo     `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	Type          MemoRelation_Type      `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRelation) Reset() {
+	*x = MemoRelation{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRelation) ProtoMessage() {}
+
+func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
+func (*MemoRelation) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
+	if x != nil {
+		return x.RelatedMemo
+	}
+	return nil
+}
+
+func (x *MemoRelation) GetType() MemoRelation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoRelation_TYPE_UNSPECIFIED
+}
+
+type SetMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoRelationsRequest) Reset() {
+	*x = SetMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoRelationsRequest) ProtoMessage() {}
+
+func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type ListMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsRequest) Reset() {
+	*x = ListMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsRequest) ProtoMessage() {}
+
+func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoRelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relations     []*MemoRelation        `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsResponse) Reset() {
+	*x = ListMemoRelationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsResponse) ProtoMessage() {}
+
+func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type ListMemoBacklinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoBacklinksRequest) Reset() {
+	*x = ListMemoBacklinksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoBacklinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoBacklinksRequest) ProtoMessage() {}
+
+func (x *ListMemoBacklinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoBacklinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMemoBacklinksRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoBacklinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos linking to the memo.
+	Backlinks     []*MemoRelation_Memo `protobuf:"bytes,1,rep,name=backlinks,proto3" json:"backlinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoBacklinksResponse) Reset() {
+	*x = ListMemoBacklinksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoBacklinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoBacklinksResponse) ProtoMessage() {}
+
+func (x *ListMemoBacklinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoBacklinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMemoBacklinksResponse) GetBacklinks() []*MemoRelation_Memo {
+	if x != nil {
+		return x.Backlinks
+	}
+	return nil
+}
+
+type MemoGraph struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*MemoGraph_Node      `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*MemoGraph_Edge      `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoGraph) Reset() {
+	*x = MemoGraph{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoGraph) ProtoMessage() {}
+
+func (x *MemoGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoGraph.ProtoReflect.Descriptor instead.
+func (*MemoGraph) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MemoGraph) GetNodes() []*MemoGraph_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *MemoGraph) GetEdges() []*MemoGraph_Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type GetMemoGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent is the owner of the memos.
+	// If not specified or `users/-`, the graph contains all visible memos.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Filter is a CEL expression to filter the memos of the graph.
+	// Refer to `Shortcut.filter`.
+	Filter        string                     `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        GetMemoGraphRequest_Format `protobuf:"varint,3,opt,name=format,proto3,enum=memos.api.v1.GetMemoGraphRequest_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoGraphRequest) Reset() {
+	*x = GetMemoGraphRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphRequest) ProtoMessage() {}
+
+func (x *GetMemoGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMemoGraphRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *GetMemoGraphRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetMemoGraphRequest) GetFormat() GetMemoGraphRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return GetMemoGraphRequest_FORMAT_UNSPECIFIED
+}
+
+type ListMemoLocationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent is the owner of the memos.
+	// If not specified or `users/-`, it will list the locations of all visible memos.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Filter is a CEL expression to filter the memos.
+	// e.g. `within_bbox(40.5, -74.3, 40.9, -73.7)` for the memos in the map view.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The zoom level of the map view, from 0 to 22.
+	// The locations are clustered in grid cells of 360 / 2^(zoom + 3) degrees.
+	// If not specified, only the memos at the same location are clustered.
+	Zoom          *int32 `protobuf:"varint,3,opt,name=zoom,proto3,oneof" json:"zoom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoLocationsRequest) Reset() {
+	*x = ListMemoLocationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoLocationsRequest) ProtoMessage() {}
+
+func (x *ListMemoLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMemoLocationsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListMemoLocationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListMemoLocationsRequest) GetZoom() int32 {
+	if x != nil && x.Zoom != nil {
+		return *x.Zoom
+	}
+	return 0
+}
+
+type ListMemoLocationsResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Clusters      []*ListMemoLocationsResponse_Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoLocationsResponse) Reset() {
+	*x = ListMemoLocationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoLocationsResponse) ProtoMessage() {}
+
+func (x *ListMemoLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMemoLocationsResponse) GetClusters() []*ListMemoLocationsResponse_Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type CreateMemoCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// It can be a comment to reply to the comment.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The comment to create.
+	Comment       *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoCommentRequest) Reset() {
+	*x = CreateMemoCommentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoCommentRequest) ProtoMessage() {}
+
+func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateMemoCommentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoCommentRequest) GetComment() *Memo {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type ListMemoCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsRequest) Reset() {
+	*x = ListMemoCommentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsRequest) ProtoMessage() {}
+
+func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMemoCommentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The direct comments of the memo.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// The comment threads of the memo, with the replies to the comments nested.
+	Threads       []*MemoCommentThread `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsResponse) Reset() {
+	*x = ListMemoCommentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsResponse) ProtoMessage() {}
+
+func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemoCommentsResponse) GetThreads() []*MemoCommentThread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type MemoCommentThread struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Comment *Memo                  `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	// The replies to the comment, i.e. the comments created on the comment memo.
+	Replies       []*MemoCommentThread `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoCommentThread) Reset() {
+	*x = MemoCommentThread{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoCommentThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoCommentThread) ProtoMessage() {}
+
+func (x *MemoCommentThread) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoCommentThread.ProtoReflect.Descriptor instead.
+func (*MemoCommentThread) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MemoCommentThread) GetComment() *Memo {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *MemoCommentThread) GetReplies() []*MemoCommentThread {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type ListMemoReactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsRequest) Reset() {
+	*x = ListMemoReactionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsRequest) ProtoMessage() {}
+
+func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMemoReactionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoReactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsResponse) Reset() {
+	*x = ListMemoReactionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsResponse) ProtoMessage() {}
+
+func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type UpsertMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	Name          string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertMemoReactionRequest) Reset() {
+	*x = UpsertMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertMemoReactionRequest) ProtoMessage() {}
+
+func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpsertMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type DeleteMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the reaction.
+	// Refer to the `Reaction.id`.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoReactionRequest) Reset() {
+	*x = DeleteMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoReactionRequest) ProtoMessage() {}
+
+func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteMemoReactionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MemoRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo revision.
+	// Format: memos/{memo}/revisions/{revision}, revision is the system generated id.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The time when the revision was recorded.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The content of the memo at this revision.
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memo at this revision.
+	Visibility Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// The tags of the memo at this revision.
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The location of the memo at this revision.
+	Location      *Location `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevision) Reset() {
+	*x = MemoRevision{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoRequest) GetMemo() *Memo {
+func (x *MemoRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevision) ProtoMessage() {}
+
+func (x *MemoRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
 	if x != nil {
-		return x.Memo
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevision.ProtoReflect.Descriptor instead.
+func (*MemoRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MemoRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *MemoRevision) GetContent() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *MemoRevision) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type DeleteMemoRequest struct {
+func (x *MemoRevision) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type ListMemoRevisionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of revisions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListMemoRevisions` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsRequest) Reset() {
+	*x = ListMemoRevisionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsRequest) ProtoMessage() {}
+
+func (x *ListMemoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMemoRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The revisions are ordered from the newest to the oldest.
+	Revisions []*MemoRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsResponse) Reset() {
+	*x = ListMemoRevisionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsResponse) ProtoMessage() {}
+
+func (x *ListMemoRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMemoRevisionsResponse) GetRevisions() []*MemoRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListMemoRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo revision.
+	// Format: memos/{memo}/revisions/{revision}
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoRequest) Reset() {
-	*x = DeleteMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *GetMemoRevisionRequest) Reset() {
+	*x = GetMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoRequest) String() string {
+func (x *GetMemoRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoRequest) ProtoMessage() {}
+func (*GetMemoRevisionRequest) ProtoMessage() {}
 
-func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *GetMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,44 +3138,42 @@ func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DeleteMemoRequest) GetName() string {
+func (x *GetMemoRevisionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type RenameMemoTagRequest struct {
+type RestoreMemoRevisionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent, who owns the tags.
-	// Format: memos/{id}. Use "memos/-" to rename all tags.
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	OldTag        string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
-	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// The name of the memo revision to restore.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameMemoTagRequest) Reset() {
-	*x = RenameMemoTagRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+func (x *RestoreMemoRevisionRequest) Reset() {
+	*x = RestoreMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameMemoTagRequest) String() string {
+func (x *RestoreMemoRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameMemoTagRequest) ProtoMessage() {}
+func (*RestoreMemoRevisionRequest) ProtoMessage() {}
 
-func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+func (x *RestoreMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,58 +3184,98 @@ func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RestoreMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *RenameMemoTagRequest) GetParent() string {
+func (x *RestoreMemoRevisionRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RenameMemoTagRequest) GetOldTag() string {
+type DiffMemoRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the revision to compare against.
+	// Format: memos/{memo}/revisions/{revision}
+	// If not specified, the revision is compared against the current memo.
+	Base          string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffMemoRevisionsRequest) Reset() {
+	*x = DiffMemoRevisionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffMemoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffMemoRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffMemoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
 	if x != nil {
-		return x.OldTag
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffMemoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffMemoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DiffMemoRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RenameMemoTagRequest) GetNewTag() string {
+func (x *DiffMemoRevisionsRequest) GetBase() string {
 	if x != nil {
-		return x.NewTag
+		return x.Base
 	}
 	return ""
 }
 
-type DeleteMemoTagRequest struct {
+type DiffMemoRevisionsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent, who owns the tags.
-	// Format: memos/{id}. Use "memos/-" to delete all tags.
-	Parent             string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	Tag                string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	DeleteRelatedMemos bool   `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The unified diff of the memo content, from `base` to `name`.
+	Diff          string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoTagRequest) Reset() {
-	*x = DeleteMemoTagRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+func (x *DiffMemoRevisionsResponse) Reset() {
+	*x = DiffMemoRevisionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoTagRequest) String() string {
+func (x *DiffMemoRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoTagRequest) ProtoMessage() {}
+func (*DiffMemoRevisionsResponse) ProtoMessage() {}
 
-func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+func (x *DiffMemoRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,56 +3286,115 @@ func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DiffMemoRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffMemoRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DeleteMemoTagRequest) GetParent() string {
+func (x *DiffMemoRevisionsResponse) GetDiff() string {
 	if x != nil {
-		return x.Parent
+		return x.Diff
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetTag() string {
+type MemoShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo share.
+	// Format: memos/{memo}/shares/{share}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The signed token used to access the shared memo.
+	Token      string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time when the share link expires. Never expires if not set.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoShare) Reset() {
+	*x = MemoShare{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoShare) ProtoMessage() {}
+
+func (x *MemoShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
 	if x != nil {
-		return x.Tag
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoShare.ProtoReflect.Descriptor instead.
+func (*MemoShare) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MemoShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoShare) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+func (x *MemoShare) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoShare) GetExpireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DeleteRelatedMemos
+		return x.ExpireTime
 	}
-	return false
+	return nil
 }
 
-type SetMemoResourcesRequest struct {
+type CreateMemoShareRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
-	Name          string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Resources     []*Resource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The time when the share link expires. Never expires if not set.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoResourcesRequest) Reset() {
-	*x = SetMemoResourcesRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+func (x *CreateMemoShareRequest) Reset() {
+	*x = CreateMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoResourcesRequest) String() string {
+func (x *CreateMemoShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoResourcesRequest) ProtoMessage() {}
+func (*CreateMemoShareRequest) ProtoMessage() {}
 
-func (x *SetMemoResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+func (x *CreateMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,48 +3405,48 @@ func (x *SetMemoResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoResourcesRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SetMemoResourcesRequest) GetName() string {
+func (x *CreateMemoShareRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *SetMemoResourcesRequest) GetResources() []*Resource {
+func (x *CreateMemoShareRequest) GetExpireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Resources
+		return x.ExpireTime
 	}
 	return nil
 }
 
-type ListMemoResourcesRequest struct {
+type ListMemoSharesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoResourcesRequest) Reset() {
-	*x = ListMemoResourcesRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+func (x *ListMemoSharesRequest) Reset() {
+	*x = ListMemoSharesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoResourcesRequest) String() string {
+func (x *ListMemoSharesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoResourcesRequest) ProtoMessage() {}
+func (*ListMemoSharesRequest) ProtoMessage() {}
 
-func (x *ListMemoResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+func (x *ListMemoSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,40 +3457,40 @@ func (x *ListMemoResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoResourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListMemoSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListMemoResourcesRequest) GetName() string {
+func (x *ListMemoSharesRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-type ListMemoResourcesResponse struct {
+type ListMemoSharesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Shares        []*MemoShare           `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoResourcesResponse) Reset() {
-	*x = ListMemoResourcesResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+func (x *ListMemoSharesResponse) Reset() {
+	*x = ListMemoSharesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoResourcesResponse) String() string {
+func (x *ListMemoSharesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoResourcesResponse) ProtoMessage() {}
+func (*ListMemoSharesResponse) ProtoMessage() {}
 
-func (x *ListMemoResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+func (x *ListMemoSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,42 +3501,42 @@ func (x *ListMemoResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoResourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListMemoSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListMemoResourcesResponse) GetResources() []*Resource {
+func (x *ListMemoSharesResponse) GetShares() []*MemoShare {
 	if x != nil {
-		return x.Resources
+		return x.Shares
 	}
 	return nil
 }
 
-type MemoRelation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memo          *MemoRelation_Memo     `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	RelatedMemo   *MemoRelation_Memo     `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
-	Type          MemoRelation_Type      `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+type RevokeMemoShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo share.
+	// Format: memos/{memo}/shares/{share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoRelation) Reset() {
-	*x = MemoRelation{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *RevokeMemoShareRequest) Reset() {
+	*x = RevokeMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation) String() string {
+func (x *RevokeMemoShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation) ProtoMessage() {}
+func (*RevokeMemoShareRequest) ProtoMessage() {}
 
-func (x *MemoRelation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *RevokeMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,56 +3547,92 @@ func (x *MemoRelation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
-func (*MemoRelation) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RevokeMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
+func (x *RevokeMemoShareRequest) GetName() string {
 	if x != nil {
-		return x.Memo
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
+type GetSharedMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the share link.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedMemoRequest) Reset() {
+	*x = GetSharedMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedMemoRequest) ProtoMessage() {}
+
+func (x *GetSharedMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
 	if x != nil {
-		return x.RelatedMemo
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MemoRelation) GetType() MemoRelation_Type {
+// Deprecated: Use GetSharedMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSharedMemoRequest) GetToken() string {
 	if x != nil {
-		return x.Type
+		return x.Token
 	}
-	return MemoRelation_TYPE_UNSPECIFIED
+	return ""
 }
 
-type SetMemoRelationsRequest struct {
+type MemoGrant struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	Name          string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// The name of the memo grant.
+	// Format: memos/{memo}/grants/{grant}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The user granted access to the memo.
+	// Format: users/{id}
+	Grantee       string                 `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Permission    MemoGrant_Permission   `protobuf:"varint,3,opt,name=permission,proto3,enum=memos.api.v1.MemoGrant_Permission" json:"permission,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoRelationsRequest) Reset() {
-	*x = SetMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *MemoGrant) Reset() {
+	*x = MemoGrant{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoRelationsRequest) String() string {
+func (x *MemoGrant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoRelationsRequest) ProtoMessage() {}
+func (*MemoGrant) ProtoMessage() {}
 
-func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *MemoGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,48 +3643,62 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MemoGrant.ProtoReflect.Descriptor instead.
+func (*MemoGrant) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SetMemoRelationsRequest) GetName() string {
+func (x *MemoGrant) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+func (x *MemoGrant) GetGrantee() string {
 	if x != nil {
-		return x.Relations
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *MemoGrant) GetPermission() MemoGrant_Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return MemoGrant_PERMISSION_UNSPECIFIED
+}
+
+func (x *MemoGrant) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
 	}
 	return nil
 }
 
-type ListMemoRelationsRequest struct {
+type ListMemoGrantsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsRequest) Reset() {
-	*x = ListMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *ListMemoGrantsRequest) Reset() {
+	*x = ListMemoGrantsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsRequest) String() string {
+func (x *ListMemoGrantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoGrantsRequest) ProtoMessage() {}
 
-func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *ListMemoGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,40 +3709,40 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListMemoGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ListMemoRelationsRequest) GetName() string {
+func (x *ListMemoGrantsRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-type ListMemoRelationsResponse struct {
+type ListMemoGrantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Relations     []*MemoRelation        `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	Grants        []*MemoGrant           `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsResponse) Reset() {
-	*x = ListMemoRelationsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+func (x *ListMemoGrantsResponse) Reset() {
+	*x = ListMemoGrantsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsResponse) String() string {
+func (x *ListMemoGrantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsResponse) ProtoMessage() {}
+func (*ListMemoGrantsResponse) ProtoMessage() {}
 
-func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+func (x *ListMemoGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,43 +3753,42 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListMemoGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+func (x *ListMemoGrantsResponse) GetGrants() []*MemoGrant {
 	if x != nil {
-		return x.Relations
+		return x.Grants
 	}
 	return nil
 }
 
-type CreateMemoCommentRequest struct {
+type CreateMemoGrantRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The comment to create.
-	Comment       *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Parent        string     `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Grant         *MemoGrant `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoCommentRequest) Reset() {
-	*x = CreateMemoCommentRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *CreateMemoGrantRequest) Reset() {
+	*x = CreateMemoGrantRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoCommentRequest) String() string {
+func (x *CreateMemoGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoCommentRequest) ProtoMessage() {}
+func (*CreateMemoGrantRequest) ProtoMessage() {}
 
-func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *CreateMemoGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,48 +3799,49 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateMemoGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoGrantRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *CreateMemoCommentRequest) GetName() string {
+func (x *CreateMemoGrantRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetComment() *Memo {
+func (x *CreateMemoGrantRequest) GetGrant() *MemoGrant {
 	if x != nil {
-		return x.Comment
+		return x.Grant
 	}
 	return nil
 }
 
-type ListMemoCommentsRequest struct {
+type DeleteMemoGrantRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
+	// The name of the memo grant.
+	// Format: memos/{memo}/grants/{grant}
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsRequest) Reset() {
-	*x = ListMemoCommentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *DeleteMemoGrantRequest) Reset() {
+	*x = DeleteMemoGrantRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsRequest) String() string {
+func (x *DeleteMemoGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsRequest) ProtoMessage() {}
+func (*DeleteMemoGrantRequest) ProtoMessage() {}
 
-func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *DeleteMemoGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,40 +3852,123 @@ func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteMemoGrantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoGrantRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListMemoCommentsRequest) GetName() string {
+func (x *DeleteMemoGrantRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ListMemoCommentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memos         []*Memo                `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+type Memo_Property struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
+	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	// The usernames mentioned in the memo with `@username`.
+	Mentions      []string `protobuf:"bytes,5,rep,name=mentions,proto3" json:"mentions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsResponse) Reset() {
-	*x = ListMemoCommentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *Memo_Property) Reset() {
+	*x = Memo_Property{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memo_Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo_Property) ProtoMessage() {}
+
+func (x *Memo_Property) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
+func (*Memo_Property) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Memo_Property) GetHasLink() bool {
+	if x != nil {
+		return x.HasLink
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasTaskList() bool {
+	if x != nil {
+		return x.HasTaskList
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasCode() bool {
+	if x != nil {
+		return x.HasCode
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasIncompleteTasks() bool {
+	if x != nil {
+		return x.HasIncompleteTasks
+	}
+	return false
+}
+
+func (x *Memo_Property) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type Memo_Reminder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of a one-off reminder, or the start of a recurring reminder.
+	RemindTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
+	// The cron expression of a recurring reminder, e.g. "0 9 * * MON".
+	// A timezone can be set with a "CRON_TZ=" prefix, e.g. "CRON_TZ=Asia/Tokyo 0 9 * * *".
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	// The time when the reminder was last delivered.
+	LastRemindTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_remind_time,json=lastRemindTime,proto3" json:"last_remind_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Memo_Reminder) Reset() {
+	*x = Memo_Reminder{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsResponse) String() string {
+func (x *Memo_Reminder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsResponse) ProtoMessage() {}
+func (*Memo_Reminder) ProtoMessage() {}
 
-func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *Memo_Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,41 +3979,60 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Memo_Reminder.ProtoReflect.Descriptor instead.
+func (*Memo_Reminder) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0, 1}
 }
 
-func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+func (x *Memo_Reminder) GetRemindTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Memos
+		return x.RemindTime
 	}
 	return nil
 }
 
-type ListMemoReactionsRequest struct {
+func (x *Memo_Reminder) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Memo_Reminder) GetLastRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRemindTime
+	}
+	return nil
+}
+
+type SearchMemosResponse_Result struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Memo  *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// An excerpt of the memo content, the matched terms are wrapped in `<mark>` and `</mark>`.
+	// The rest of the excerpt is not escaped.
+	Snippet string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The relevance of the memo to the query, higher is more relevant.
+	// Scores are only comparable within the same search.
+	Score         float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsRequest) Reset() {
-	*x = ListMemoReactionsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *SearchMemosResponse_Result) Reset() {
+	*x = SearchMemosResponse_Result{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsRequest) String() string {
+func (x *SearchMemosResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsRequest) ProtoMessage() {}
+func (*SearchMemosResponse_Result) ProtoMessage() {}
 
-func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *SearchMemosResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,40 +4043,60 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SearchMemosResponse_Result.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *ListMemoReactionsRequest) GetName() string {
+func (x *SearchMemosResponse_Result) GetMemo() *Memo {
 	if x != nil {
-		return x.Name
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *SearchMemosResponse_Result) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
 	}
 	return ""
 }
 
-type ListMemoReactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+func (x *SearchMemosResponse_Result) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type BatchUpdateMemosRequest_Update struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Visibility Visibility             `protobuf:"varint,1,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	Pinned     bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	State      State                  `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// The tag to add to the content of the memos, without the leading `#`.
+	AddTag string `protobuf:"bytes,4,opt,name=add_tag,json=addTag,proto3" json:"add_tag,omitempty"`
+	// The tag to remove from the content of the memos, without the leading `#`.
+	RemoveTag     string `protobuf:"bytes,5,opt,name=remove_tag,json=removeTag,proto3" json:"remove_tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsResponse) Reset() {
-	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *BatchUpdateMemosRequest_Update) Reset() {
+	*x = BatchUpdateMemosRequest_Update{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsResponse) String() string {
+func (x *BatchUpdateMemosRequest_Update) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsResponse) ProtoMessage() {}
+func (*BatchUpdateMemosRequest_Update) ProtoMessage() {}
 
-func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *BatchUpdateMemosRequest_Update) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,42 +4107,73 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use BatchUpdateMemosRequest_Update.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosRequest_Update) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+func (x *BatchUpdateMemosRequest_Update) GetVisibility() Visibility {
 	if x != nil {
-		return x.Reactions
+		return x.Visibility
 	}
-	return nil
+	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
-type UpsertMemoReactionRequest struct {
+func (x *BatchUpdateMemosRequest_Update) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *BatchUpdateMemosRequest_Update) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *BatchUpdateMemosRequest_Update) GetAddTag() string {
+	if x != nil {
+		return x.AddTag
+	}
+	return ""
+}
+
+func (x *BatchUpdateMemosRequest_Update) GetRemoveTag() string {
+	if x != nil {
+		return x.RemoveTag
+	}
+	return ""
+}
+
+type MemoRelation_Memo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
-	Name          string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid  string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The snippet of the memo content. Plain text only.
+	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertMemoReactionRequest) Reset() {
-	*x = UpsertMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *MemoRelation_Memo) Reset() {
+	*x = MemoRelation_Memo{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertMemoReactionRequest) String() string {
+func (x *MemoRelation_Memo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertMemoReactionRequest) ProtoMessage() {}
+func (*MemoRelation_Memo) ProtoMessage() {}
 
-func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,49 +4184,59 @@ func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
+func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *UpsertMemoReactionRequest) GetName() string {
+func (x *MemoRelation_Memo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+func (x *MemoRelation_Memo) GetUid() string {
 	if x != nil {
-		return x.Reaction
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-type DeleteMemoReactionRequest struct {
+func (x *MemoRelation_Memo) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type MemoGraph_Node struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The id of the reaction.
-	// Refer to the `Reaction.id`.
-	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the node.
+	// Format: memos/{memo} or tags/{tag}
+	Name string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type MemoGraph_Node_Type `protobuf:"varint,2,opt,name=type,proto3,enum=memos.api.v1.MemoGraph_Node_Type" json:"type,omitempty"`
+	// The memo content snippet or the tag.
+	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoReactionRequest) Reset() {
-	*x = DeleteMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoGraph_Node) Reset() {
+	*x = MemoGraph_Node{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoReactionRequest) String() string {
+func (x *MemoGraph_Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoReactionRequest) ProtoMessage() {}
+func (*MemoGraph_Node) ProtoMessage() {}
 
-func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoGraph_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,43 +4247,60 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MemoGraph_Node.ProtoReflect.Descriptor instead.
+func (*MemoGraph_Node) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30, 0}
 }
 
-func (x *DeleteMemoReactionRequest) GetId() int32 {
+func (x *MemoGraph_Node) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type Memo_Property struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
-	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
-	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
-	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *MemoGraph_Node) GetType() MemoGraph_Node_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoGraph_Node_TYPE_UNSPECIFIED
 }
 
-func (x *Memo_Property) Reset() {
-	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *MemoGraph_Node) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type MemoGraph_Edge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the source node.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The name of the target node.
+	Target string              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Type   MemoGraph_Edge_Type `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoGraph_Edge_Type" json:"type,omitempty"`
+	// The number of memos using both tags of a `TAG_COOCCURRENCE` edge, 1 otherwise.
+	Weight        int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoGraph_Edge) Reset() {
+	*x = MemoGraph_Edge{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo_Property) String() string {
+func (x *MemoGraph_Edge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo_Property) ProtoMessage() {}
+func (*MemoGraph_Edge) ProtoMessage() {}
 
-func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *MemoGraph_Edge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,66 +4311,69 @@ func (x *Memo_Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
-func (*Memo_Property) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use MemoGraph_Edge.ProtoReflect.Descriptor instead.
+func (*MemoGraph_Edge) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30, 1}
 }
 
-func (x *Memo_Property) GetHasLink() bool {
+func (x *MemoGraph_Edge) GetSource() string {
 	if x != nil {
-		return x.HasLink
+		return x.Source
 	}
-	return false
+	return ""
 }
 
-func (x *Memo_Property) GetHasTaskList() bool {
+func (x *MemoGraph_Edge) GetTarget() string {
 	if x != nil {
-		return x.HasTaskList
+		return x.Target
 	}
-	return false
+	return ""
 }
 
-func (x *Memo_Property) GetHasCode() bool {
+func (x *MemoGraph_Edge) GetType() MemoGraph_Edge_Type {
 	if x != nil {
-		return x.HasCode
+		return x.Type
 	}
-	return false
+	return MemoGraph_Edge_TYPE_UNSPECIFIED
 }
 
-func (x *Memo_Property) GetHasIncompleteTasks() bool {
+func (x *MemoGraph_Edge) GetWeight() int32 {
 	if x != nil {
-		return x.HasIncompleteTasks
+		return x.Weight
 	}
-	return false
+	return 0
 }
 
-type MemoRelation_Memo struct {
+type ListMemoLocationsResponse_Cluster struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	// Format: memos/{id}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uid  string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// The snippet of the memo content. Plain text only.
-	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The average latitude of the memos in the cluster.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// The average longitude of the memos in the cluster.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// The number of memos in the cluster.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// The names of the memos in the cluster.
+	// Format: memos/{memo}
+	Memos         []string `protobuf:"bytes,4,rep,name=memos,proto3" json:"memos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoRelation_Memo) Reset() {
-	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *ListMemoLocationsResponse_Cluster) Reset() {
+	*x = ListMemoLocationsResponse_Cluster{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation_Memo) String() string {
+func (x *ListMemoLocationsResponse_Cluster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation_Memo) ProtoMessage() {}
+func (*ListMemoLocationsResponse_Cluster) ProtoMessage() {}
 
-func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *ListMemoLocationsResponse_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,37 +4384,44 @@ func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
-func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use ListMemoLocationsResponse_Cluster.ProtoReflect.Descriptor instead.
+func (*ListMemoLocationsResponse_Cluster) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (x *MemoRelation_Memo) GetName() string {
+func (x *ListMemoLocationsResponse_Cluster) GetLatitude() float64 {
 	if x != nil {
-		return x.Name
+		return x.Latitude
 	}
-	return ""
+	return 0
 }
 
-func (x *MemoRelation_Memo) GetUid() string {
+func (x *ListMemoLocationsResponse_Cluster) GetLongitude() float64 {
 	if x != nil {
-		return x.Uid
+		return x.Longitude
 	}
-	return ""
+	return 0
 }
 
-func (x *MemoRelation_Memo) GetSnippet() string {
+func (x *ListMemoLocationsResponse_Cluster) GetCount() int32 {
 	if x != nil {
-		return x.Snippet
+		return x.Count
 	}
-	return ""
+	return 0
+}
+
+func (x *ListMemoLocationsResponse_Cluster) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
 }
 
 var File_api_v1_memo_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/markdown_service.proto\x1a\x1dapi/v1/reaction_service.proto\x1a\x1dapi/v1/resource_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\a\n" +
+	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/markdown_service.proto\x1a\x1dapi/v1/reaction_service.proto\x1a\x1dapi/v1/resource_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\v\n" +
 	"\x04Memo\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12)\n" +
 	"\x05state\x18\x03 \x01(\x0e2\x13.memos.api.v1.StateR\x05state\x12\x18\n" +
@@ -1690,20 +4445,38 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\bproperty\x18\x11 \x01(\v2\x1b.memos.api.v1.Memo.PropertyB\x03\xe0A\x03R\bproperty\x12 \n" +
 	"\x06parent\x18\x12 \x01(\tB\x03\xe0A\x03H\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x13 \x01(\tB\x03\xe0A\x03R\asnippet\x127\n" +
-	"\blocation\x18\x14 \x01(\v2\x16.memos.api.v1.LocationH\x01R\blocation\x88\x01\x01\x1a\x96\x01\n" +
+	"\blocation\x18\x14 \x01(\v2\x16.memos.api.v1.LocationH\x01R\blocation\x88\x01\x01\x12B\n" +
+	"\fpublish_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vpublishTime\x88\x01\x01\x12E\n" +
+	"\x11target_visibility\x18\x16 \x01(\x0e2\x18.memos.api.v1.VisibilityR\x10targetVisibility\x12\x17\n" +
+	"\x04etag\x18\x17 \x01(\tB\x03\xe0A\x03R\x04etag\x129\n" +
+	"\treminders\x18\x18 \x03(\v2\x1b.memos.api.v1.Memo.ReminderR\treminders\x12\"\n" +
+	"\n" +
+	"daily_date\x18\x19 \x01(\tB\x03\xe0A\x03R\tdailyDate\x1a\xb2\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasksB\t\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12\x1a\n" +
+	"\bmentions\x18\x05 \x03(\tR\bmentions\x1a\xa6\x01\n" +
+	"\bReminder\x12;\n" +
+	"\vremind_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"remindTime\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12I\n" +
+	"\x10last_remind_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0elastRemindTimeB\t\n" +
 	"\a_parentB\v\n" +
-	"\t_locationJ\x04\b\x02\x10\x03\"f\n" +
+	"\t_locationB\x0f\n" +
+	"\r_publish_timeJ\x04\b\x02\x10\x03\"f\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\"@\n" +
 	"\x11CreateMemoRequest\x12+\n" +
-	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\"\x93\x02\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\"n\n" +
+	"\x1bGetOrCreateDailyMemoRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\"\x93\x02\n" +
 	"\x10ListMemosRequest\x12\x16\n" +
 	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -1717,15 +4490,72 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"old_filter\x18\b \x01(\tR\toldFilter\"e\n" +
 	"\x11ListMemosResponse\x12(\n" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"+\n" +
+	"\x11WatchMemosRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\"\xe5\x01\n" +
+	"\tMemoEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.memos.api.v1.MemoEvent.TypeR\x04type\x12&\n" +
+	"\x04memo\x18\x02 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x129\n" +
+	"\n" +
+	"event_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\"C\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\v\n" +
+	"\aDELETED\x10\x03\"\x83\x01\n" +
+	"\x12SearchMemosRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\"\xe3\x01\n" +
+	"\x13SearchMemosResponse\x12B\n" +
+	"\aresults\x18\x01 \x03(\v2(.memos.api.v1.SearchMemosResponse.ResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x1a`\n" +
+	"\x06Result\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"E\n" +
 	"\x0eGetMemoRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"}\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\"\x91\x01\n" +
 	"\x11UpdateMemoRequest\x12+\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"'\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\";\n" +
 	"\x11DeleteMemoRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"`\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\")\n" +
+	"\x13UndeleteMemoRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"&\n" +
+	"\x10PurgeMemoRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xb8\x03\n" +
+	"\x17BatchUpdateMemosRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12)\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateR\x05state\x12D\n" +
+	"\x06update\x18\x03 \x01(\v2,.memos.api.v1.BatchUpdateMemosRequest.UpdateR\x06update\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x1a\xbd\x01\n" +
+	"\x06Update\x128\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\x12)\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x13.memos.api.v1.StateR\x05state\x12\x17\n" +
+	"\aadd_tag\x18\x04 \x01(\tR\x06addTag\x12\x1d\n" +
+	"\n" +
+	"remove_tag\x18\x05 \x01(\tR\tremoveTag\"A\n" +
+	"\x18BatchUpdateMemosResponse\x12%\n" +
+	"\x0eaffected_count\x18\x01 \x01(\x05R\raffectedCount\"u\n" +
+	"\x17BatchDeleteMemosRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12)\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateR\x05state\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"A\n" +
+	"\x18BatchDeleteMemosResponse\x12%\n" +
+	"\x0eaffected_count\x18\x01 \x01(\x05R\raffectedCount\"`\n" +
 	"\x14RenameMemoTagRequest\x12\x16\n" +
 	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x17\n" +
 	"\aold_tag\x18\x02 \x01(\tR\x06oldTag\x12\x17\n" +
@@ -1759,14 +4589,65 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x18ListMemoRelationsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"U\n" +
 	"\x19ListMemoRelationsResponse\x128\n" +
-	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\"\\\n" +
+	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\".\n" +
+	"\x18ListMemoBacklinksRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"Z\n" +
+	"\x19ListMemoBacklinksResponse\x12=\n" +
+	"\tbacklinks\x18\x01 \x03(\v2\x1f.memos.api.v1.MemoRelation.MemoR\tbacklinks\"\xef\x03\n" +
+	"\tMemoGraph\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.memos.api.v1.MemoGraph.NodeR\x05nodes\x122\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1c.memos.api.v1.MemoGraph.EdgeR\x05edges\x1a\x98\x01\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.memos.api.v1.MemoGraph.Node.TypeR\x04type\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"/\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04MEMO\x10\x01\x12\a\n" +
+	"\x03TAG\x10\x02\x1a\xde\x01\n" +
+	"\x04Edge\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x125\n" +
+	"\x04type\x18\x03 \x01(\x0e2!.memos.api.v1.MemoGraph.Edge.TypeR\x04type\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\"W\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tREFERENCE\x10\x01\x12\v\n" +
+	"\aCOMMENT\x10\x02\x12\a\n" +
+	"\x03TAG\x10\x03\x12\x14\n" +
+	"\x10TAG_COOCCURRENCE\x10\x04\"\xc9\x01\n" +
+	"\x13GetMemoGraphRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12@\n" +
+	"\x06format\x18\x03 \x01(\x0e2(.memos.api.v1.GetMemoGraphRequest.FormatR\x06format\"@\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\x12\v\n" +
+	"\aGRAPHML\x10\x02\x12\a\n" +
+	"\x03DOT\x10\x03\"l\n" +
+	"\x18ListMemoLocationsRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x17\n" +
+	"\x04zoom\x18\x03 \x01(\x05H\x00R\x04zoom\x88\x01\x01B\a\n" +
+	"\x05_zoom\"\xd9\x01\n" +
+	"\x19ListMemoLocationsResponse\x12K\n" +
+	"\bclusters\x18\x01 \x03(\v2/.memos.api.v1.ListMemoLocationsResponse.ClusterR\bclusters\x1ao\n" +
+	"\aCluster\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05memos\x18\x04 \x03(\tR\x05memos\"\\\n" +
 	"\x18CreateMemoCommentRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
 	"\acomment\x18\x02 \x01(\v2\x12.memos.api.v1.MemoR\acomment\"-\n" +
 	"\x17ListMemoCommentsRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x7f\n" +
 	"\x18ListMemoCommentsResponse\x12(\n" +
-	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\".\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x129\n" +
+	"\athreads\x18\x02 \x03(\v2\x1f.memos.api.v1.MemoCommentThreadR\athreads\"|\n" +
+	"\x11MemoCommentThread\x12,\n" +
+	"\acomment\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\acomment\x129\n" +
+	"\areplies\x18\x02 \x03(\v2\x1f.memos.api.v1.MemoCommentThreadR\areplies\".\n" +
 	"\x18ListMemoReactionsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"Q\n" +
 	"\x19ListMemoReactionsResponse\x124\n" +
@@ -1775,34 +4656,127 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionR\breaction\"+\n" +
 	"\x19DeleteMemoReactionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id*P\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x9a\x02\n" +
+	"\fMemoRevision\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12@\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x128\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x127\n" +
+	"\blocation\x18\x06 \x01(\v2\x16.memos.api.v1.LocationH\x00R\blocation\x88\x01\x01B\v\n" +
+	"\t_location\"j\n" +
+	"\x18ListMemoRevisionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"}\n" +
+	"\x19ListMemoRevisionsResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\",\n" +
+	"\x16GetMemoRevisionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"0\n" +
+	"\x1aRestoreMemoRevisionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"B\n" +
+	"\x18DiffMemoRevisionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\tR\x04base\"/\n" +
+	"\x19DiffMemoRevisionsResponse\x12\x12\n" +
+	"\x04diff\x18\x01 \x01(\tR\x04diff\"\xce\x01\n" +
+	"\tMemoShare\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\xe0A\x03R\x05token\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"expireTime\x88\x01\x01B\x0e\n" +
+	"\f_expire_time\"\x82\x01\n" +
+	"\x16CreateMemoShareRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12@\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"expireTime\x88\x01\x01B\x0e\n" +
+	"\f_expire_time\"/\n" +
+	"\x15ListMemoSharesRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"I\n" +
+	"\x16ListMemoSharesResponse\x12/\n" +
+	"\x06shares\x18\x01 \x03(\v2\x17.memos.api.v1.MemoShareR\x06shares\",\n" +
+	"\x16RevokeMemoShareRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\",\n" +
+	"\x14GetSharedMemoRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x8a\x02\n" +
+	"\tMemoGrant\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x1d\n" +
+	"\agrantee\x18\x02 \x01(\tB\x03\xe0A\x02R\agrantee\x12B\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\x0e2\".memos.api.v1.MemoGrant.PermissionR\n" +
+	"permission\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"?\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04READ\x10\x01\x12\v\n" +
+	"\aCOMMENT\x10\x02\"/\n" +
+	"\x15ListMemoGrantsRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"I\n" +
+	"\x16ListMemoGrantsResponse\x12/\n" +
+	"\x06grants\x18\x01 \x03(\v2\x17.memos.api.v1.MemoGrantR\x06grants\"_\n" +
+	"\x16CreateMemoGrantRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12-\n" +
+	"\x05grant\x18\x02 \x01(\v2\x17.memos.api.v1.MemoGrantR\x05grant\",\n" +
+	"\x16DeleteMemoGrantRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name*P\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\xbf\x10\n" +
+	"\x06PUBLIC\x10\x032\xe0&\n" +
 	"\vMemoService\x12^\n" +
 	"\n" +
-	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\x1b\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12\x85\x01\n" +
-	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"7\x82\xd3\xe4\x93\x021Z \x12\x1e/api/v1/{parent=users/*}/memos\x12\r/api/v1/memos\x12b\n" +
+	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\x1b\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12u\n" +
+	"\x14GetOrCreateDailyMemo\x12).memos.api.v1.GetOrCreateDailyMemoRequest\x1a\x12.memos.api.v1.Memo\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/memos:daily\x12\x85\x01\n" +
+	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"7\x82\xd3\xe4\x93\x021Z \x12\x1e/api/v1/{parent=users/*}/memos\x12\r/api/v1/memos\x12e\n" +
+	"\n" +
+	"WatchMemos\x12\x1f.memos.api.v1.WatchMemosRequest\x1a\x17.memos.api.v1.MemoEvent\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/memos:watch0\x01\x12x\n" +
+	"\vSearchMemos\x12 .memos.api.v1.SearchMemosRequest\x1a!.memos.api.v1.SearchMemosResponse\"$\xdaA\x05query\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/memos:search\x12b\n" +
 	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12\x7f\n" +
 	"\n" +
 	"UpdateMemo\x12\x1f.memos.api.v1.UpdateMemoRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x10memo,update_mask\x82\xd3\xe4\x93\x02#:\x04memo2\x1b/api/v1/{memo.name=memos/*}\x12l\n" +
 	"\n" +
-	"DeleteMemo\x12\x1f.memos.api.v1.DeleteMemoRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=memos/*}\x12|\n" +
+	"DeleteMemo\x12\x1f.memos.api.v1.DeleteMemoRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=memos/*}\x12x\n" +
+	"\fUndeleteMemo\x12!.memos.api.v1.UndeleteMemoRequest\x1a\x12.memos.api.v1.Memo\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/{name=memos/*}:undelete\x12s\n" +
+	"\tPurgeMemo\x12\x1e.memos.api.v1.PurgeMemoRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/{name=memos/*}:purge\x12\xa3\x01\n" +
+	"\x10BatchUpdateMemos\x12%.memos.api.v1.BatchUpdateMemosRequest\x1a&.memos.api.v1.BatchUpdateMemosResponse\"@\xdaA\x19filter,update,update_mask\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos:batchUpdate\x12\x90\x01\n" +
+	"\x10BatchDeleteMemos\x12%.memos.api.v1.BatchDeleteMemosRequest\x1a&.memos.api.v1.BatchDeleteMemosResponse\"-\xdaA\x06filter\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos:batchDelete\x12|\n" +
 	"\rRenameMemoTag\x12\".memos.api.v1.RenameMemoTagRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*2$/api/v1/{parent=memos/*}/tags:rename\x12x\n" +
 	"\rDeleteMemoTag\x12\".memos.api.v1.DeleteMemoTagRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%*#/api/v1/{parent=memos/*}/tags/{tag}\x12\x85\x01\n" +
 	"\x10SetMemoResources\x12%.memos.api.v1.SetMemoResourcesRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/resources\x12\x95\x01\n" +
 	"\x11ListMemoResources\x12&.memos.api.v1.ListMemoResourcesRequest\x1a'.memos.api.v1.ListMemoResourcesResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/resources\x12\x85\x01\n" +
 	"\x10SetMemoRelations\x12%.memos.api.v1.SetMemoRelationsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
-	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x88\x01\n" +
+	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
+	"\x11ListMemoBacklinks\x12&.memos.api.v1.ListMemoBacklinksRequest\x1a'.memos.api.v1.ListMemoBacklinksResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/backlinks\x12^\n" +
+	"\fGetMemoGraph\x12!.memos.api.v1.GetMemoGraphRequest\x1a\x14.google.api.HttpBody\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/graph\x12\xa5\x01\n" +
+	"\x11ListMemoLocations\x12&.memos.api.v1.ListMemoLocationsRequest\x1a'.memos.api.v1.ListMemoLocationsResponse\"?\x82\xd3\xe4\x93\x029Z$\x12\"/api/v1/{parent=users/*}/locations\x12\x11/api/v1/locations\x12\x88\x01\n" +
 	"\x11CreateMemoComment\x12&.memos.api.v1.CreateMemoCommentRequest\x1a\x12.memos.api.v1.Memo\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*:\acomment\"\x1f/api/v1/{name=memos/*}/comments\x12\x91\x01\n" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12z\n" +
-	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/reactions/{id}B\xa8\x01\n" +
+	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/reactions/{id}\x12\x95\x01\n" +
+	"\x11ListMemoRevisions\x12&.memos.api.v1.ListMemoRevisionsRequest\x1a'.memos.api.v1.ListMemoRevisionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/revisions\x12\x86\x01\n" +
+	"\x0fGetMemoRevision\x12$.memos.api.v1.GetMemoRevisionRequest\x1a\x1a.memos.api.v1.MemoRevision\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*/revisions/*}\x12\x91\x01\n" +
+	"\x13RestoreMemoRevision\x12(.memos.api.v1.RestoreMemoRevisionRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=memos/*/revisions/*}:restore\x12\x9c\x01\n" +
+	"\x11DiffMemoRevisions\x12&.memos.api.v1.DiffMemoRevisionsRequest\x1a'.memos.api.v1.DiffMemoRevisionsResponse\"6\xdaA\x04name\x82\xd3\xe4\x93\x02)\x12'/api/v1/{name=memos/*/revisions/*}:diff\x12\x85\x01\n" +
+	"\x0fCreateMemoShare\x12$.memos.api.v1.CreateMemoShareRequest\x1a\x17.memos.api.v1.MemoShare\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/{parent=memos/*}/shares\x12\x8d\x01\n" +
+	"\x0eListMemoShares\x12#.memos.api.v1.ListMemoSharesRequest\x1a$.memos.api.v1.ListMemoSharesResponse\"0\xdaA\x06parent\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{parent=memos/*}/shares\x12\x7f\n" +
+	"\x0fRevokeMemoShare\x12$.memos.api.v1.RevokeMemoShareRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=memos/*/shares/*}\x12o\n" +
+	"\rGetSharedMemo\x12\".memos.api.v1.GetSharedMemoRequest\x1a\x12.memos.api.v1.Memo\"&\xdaA\x05token\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/shares/{token}\x12\x8d\x01\n" +
+	"\x0eListMemoGrants\x12#.memos.api.v1.ListMemoGrantsRequest\x1a$.memos.api.v1.ListMemoGrantsResponse\"0\xdaA\x06parent\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{parent=memos/*}/grants\x12\x8f\x01\n" +
+	"\x0fCreateMemoGrant\x12$.memos.api.v1.CreateMemoGrantRequest\x1a\x17.memos.api.v1.MemoGrant\"=\xdaA\fparent,grant\x82\xd3\xe4\x93\x02(:\x05grant\"\x1f/api/v1/{parent=memos/*}/grants\x12\x7f\n" +
+	"\x0fDeleteMemoGrant\x12$.memos.api.v1.DeleteMemoGrantRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=memos/*/grants/*}B\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -1817,112 +4791,240 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                   // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),            // 1: memos.api.v1.MemoRelation.Type
-	(*Memo)(nil),                      // 2: memos.api.v1.Memo
-	(*Location)(nil),                  // 3: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),         // 4: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),          // 5: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),         // 6: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),            // 7: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),         // 8: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),         // 9: memos.api.v1.DeleteMemoRequest
-	(*RenameMemoTagRequest)(nil),      // 10: memos.api.v1.RenameMemoTagRequest
-	(*DeleteMemoTagRequest)(nil),      // 11: memos.api.v1.DeleteMemoTagRequest
-	(*SetMemoResourcesRequest)(nil),   // 12: memos.api.v1.SetMemoResourcesRequest
-	(*ListMemoResourcesRequest)(nil),  // 13: memos.api.v1.ListMemoResourcesRequest
-	(*ListMemoResourcesResponse)(nil), // 14: memos.api.v1.ListMemoResourcesResponse
-	(*MemoRelation)(nil),              // 15: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),   // 16: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),  // 17: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil), // 18: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),  // 19: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),   // 20: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),  // 21: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),  // 22: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil), // 23: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil), // 24: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil), // 25: memos.api.v1.DeleteMemoReactionRequest
-	(*Memo_Property)(nil),             // 26: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),         // 27: memos.api.v1.MemoRelation.Memo
-	(State)(0),                        // 28: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil),     // 29: google.protobuf.Timestamp
-	(*Node)(nil),                      // 30: memos.api.v1.Node
-	(*Resource)(nil),                  // 31: memos.api.v1.Resource
-	(*Reaction)(nil),                  // 32: memos.api.v1.Reaction
-	(Direction)(0),                    // 33: memos.api.v1.Direction
-	(*fieldmaskpb.FieldMask)(nil),     // 34: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),             // 35: google.protobuf.Empty
+	(Visibility)(0),                           // 0: memos.api.v1.Visibility
+	(MemoEvent_Type)(0),                       // 1: memos.api.v1.MemoEvent.Type
+	(MemoRelation_Type)(0),                    // 2: memos.api.v1.MemoRelation.Type
+	(MemoGraph_Node_Type)(0),                  // 3: memos.api.v1.MemoGraph.Node.Type
+	(MemoGraph_Edge_Type)(0),                  // 4: memos.api.v1.MemoGraph.Edge.Type
+	(GetMemoGraphRequest_Format)(0),           // 5: memos.api.v1.GetMemoGraphRequest.Format
+	(MemoGrant_Permission)(0),                 // 6: memos.api.v1.MemoGrant.Permission
+	(*Memo)(nil),                              // 7: memos.api.v1.Memo
+	(*Location)(nil),                          // 8: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),                 // 9: memos.api.v1.CreateMemoRequest
+	(*GetOrCreateDailyMemoRequest)(nil),       // 10: memos.api.v1.GetOrCreateDailyMemoRequest
+	(*ListMemosRequest)(nil),                  // 11: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),                 // 12: memos.api.v1.ListMemosResponse
+	(*WatchMemosRequest)(nil),                 // 13: memos.api.v1.WatchMemosRequest
+	(*MemoEvent)(nil),                         // 14: memos.api.v1.MemoEvent
+	(*SearchMemosRequest)(nil),                // 15: memos.api.v1.SearchMemosRequest
+	(*SearchMemosResponse)(nil),               // 16: memos.api.v1.SearchMemosResponse
+	(*GetMemoRequest)(nil),                    // 17: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),                 // 18: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),                 // 19: memos.api.v1.DeleteMemoRequest
+	(*UndeleteMemoRequest)(nil),               // 20: memos.api.v1.UndeleteMemoRequest
+	(*PurgeMemoRequest)(nil),                  // 21: memos.api.v1.PurgeMemoRequest
+	(*BatchUpdateMemosRequest)(nil),           // 22: memos.api.v1.BatchUpdateMemosRequest
+	(*BatchUpdateMemosResponse)(nil),          // 23: memos.api.v1.BatchUpdateMemosResponse
+	(*BatchDeleteMemosRequest)(nil),           // 24: memos.api.v1.BatchDeleteMemosRequest
+	(*BatchDeleteMemosResponse)(nil),          // 25: memos.api.v1.BatchDeleteMemosResponse
+	(*RenameMemoTagRequest)(nil),              // 26: memos.api.v1.RenameMemoTagRequest
+	(*DeleteMemoTagRequest)(nil),              // 27: memos.api.v1.DeleteMemoTagRequest
+	(*SetMemoResourcesRequest)(nil),           // 28: memos.api.v1.SetMemoResourcesRequest
+	(*ListMemoResourcesRequest)(nil),          // 29: memos.api.v1.ListMemoResourcesRequest
+	(*ListMemoResourcesResponse)(nil),         // 30: memos.api.v1.ListMemoResourcesResponse
+	(*MemoRelation)(nil),                      // 31: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),           // 32: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),          // 33: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),         // 34: memos.api.v1.ListMemoRelationsResponse
+	(*ListMemoBacklinksRequest)(nil),          // 35: memos.api.v1.ListMemoBacklinksRequest
+	(*ListMemoBacklinksResponse)(nil),         // 36: memos.api.v1.ListMemoBacklinksResponse
+	(*MemoGraph)(nil),                         // 37: memos.api.v1.MemoGraph
+	(*GetMemoGraphRequest)(nil),               // 38: memos.api.v1.GetMemoGraphRequest
+	(*ListMemoLocationsRequest)(nil),          // 39: memos.api.v1.ListMemoLocationsRequest
+	(*ListMemoLocationsResponse)(nil),         // 40: memos.api.v1.ListMemoLocationsResponse
+	(*CreateMemoCommentRequest)(nil),          // 41: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),           // 42: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),          // 43: memos.api.v1.ListMemoCommentsResponse
+	(*MemoCommentThread)(nil),                 // 44: memos.api.v1.MemoCommentThread
+	(*ListMemoReactionsRequest)(nil),          // 45: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),         // 46: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),         // 47: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),         // 48: memos.api.v1.DeleteMemoReactionRequest
+	(*MemoRevision)(nil),                      // 49: memos.api.v1.MemoRevision
+	(*ListMemoRevisionsRequest)(nil),          // 50: memos.api.v1.ListMemoRevisionsRequest
+	(*ListMemoRevisionsResponse)(nil),         // 51: memos.api.v1.ListMemoRevisionsResponse
+	(*GetMemoRevisionRequest)(nil),            // 52: memos.api.v1.GetMemoRevisionRequest
+	(*RestoreMemoRevisionRequest)(nil),        // 53: memos.api.v1.RestoreMemoRevisionRequest
+	(*DiffMemoRevisionsRequest)(nil),          // 54: memos.api.v1.DiffMemoRevisionsRequest
+	(*DiffMemoRevisionsResponse)(nil),         // 55: memos.api.v1.DiffMemoRevisionsResponse
+	(*MemoShare)(nil),                         // 56: memos.api.v1.MemoShare
+	(*CreateMemoShareRequest)(nil),            // 57: memos.api.v1.CreateMemoShareRequest
+	(*ListMemoSharesRequest)(nil),             // 58: memos.api.v1.ListMemoSharesRequest
+	(*ListMemoSharesResponse)(nil),            // 59: memos.api.v1.ListMemoSharesResponse
+	(*RevokeMemoShareRequest)(nil),            // 60: memos.api.v1.RevokeMemoShareRequest
+	(*GetSharedMemoRequest)(nil),              // 61: memos.api.v1.GetSharedMemoRequest
+	(*MemoGrant)(nil),                         // 62: memos.api.v1.MemoGrant
+	(*ListMemoGrantsRequest)(nil),             // 63: memos.api.v1.ListMemoGrantsRequest
+	(*ListMemoGrantsResponse)(nil),            // 64: memos.api.v1.ListMemoGrantsResponse
+	(*CreateMemoGrantRequest)(nil),            // 65: memos.api.v1.CreateMemoGrantRequest
+	(*DeleteMemoGrantRequest)(nil),            // 66: memos.api.v1.DeleteMemoGrantRequest
+	(*Memo_Property)(nil),                     // 67: memos.api.v1.Memo.Property
+	(*Memo_Reminder)(nil),                     // 68: memos.api.v1.Memo.Reminder
+	(*SearchMemosResponse_Result)(nil),        // 69: memos.api.v1.SearchMemosResponse.Result
+	(*BatchUpdateMemosRequest_Update)(nil),    // 70: memos.api.v1.BatchUpdateMemosRequest.Update
+	(*MemoRelation_Memo)(nil),                 // 71: memos.api.v1.MemoRelation.Memo
+	(*MemoGraph_Node)(nil),                    // 72: memos.api.v1.MemoGraph.Node
+	(*MemoGraph_Edge)(nil),                    // 73: memos.api.v1.MemoGraph.Edge
+	(*ListMemoLocationsResponse_Cluster)(nil), // 74: memos.api.v1.ListMemoLocationsResponse.Cluster
+	(State)(0),                    // 75: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil), // 76: google.protobuf.Timestamp
+	(*Node)(nil),                  // 77: memos.api.v1.Node
+	(*Resource)(nil),              // 78: memos.api.v1.Resource
+	(*Reaction)(nil),              // 79: memos.api.v1.Reaction
+	(Direction)(0),                // 80: memos.api.v1.Direction
+	(*fieldmaskpb.FieldMask)(nil), // 81: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 82: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),     // 83: google.api.HttpBody
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	28, // 0: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	29, // 1: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	29, // 2: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	29, // 3: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
-	30, // 4: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
-	0,  // 5: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	31, // 6: memos.api.v1.Memo.resources:type_name -> memos.api.v1.Resource
-	15, // 7: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	32, // 8: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	26, // 9: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	3,  // 10: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	2,  // 11: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	28, // 12: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	33, // 13: memos.api.v1.ListMemosRequest.direction:type_name -> memos.api.v1.Direction
-	2,  // 14: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 15: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	34, // 16: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	31, // 17: memos.api.v1.SetMemoResourcesRequest.resources:type_name -> memos.api.v1.Resource
-	31, // 18: memos.api.v1.ListMemoResourcesResponse.resources:type_name -> memos.api.v1.Resource
-	27, // 19: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	27, // 20: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 21: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	15, // 22: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	15, // 23: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 24: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	2,  // 25: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	32, // 26: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	32, // 27: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	4,  // 28: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	5,  // 29: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	7,  // 30: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	8,  // 31: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	9,  // 32: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	10, // 33: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
-	11, // 34: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
-	12, // 35: memos.api.v1.MemoService.SetMemoResources:input_type -> memos.api.v1.SetMemoResourcesRequest
-	13, // 36: memos.api.v1.MemoService.ListMemoResources:input_type -> memos.api.v1.ListMemoResourcesRequest
-	16, // 37: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	17, // 38: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	19, // 39: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	20, // 40: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	22, // 41: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	24, // 42: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	25, // 43: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	2,  // 44: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	6,  // 45: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	2,  // 46: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	2,  // 47: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	35, // 48: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	35, // 49: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
-	35, // 50: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
-	35, // 51: memos.api.v1.MemoService.SetMemoResources:output_type -> google.protobuf.Empty
-	14, // 52: memos.api.v1.MemoService.ListMemoResources:output_type -> memos.api.v1.ListMemoResourcesResponse
-	35, // 53: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	18, // 54: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	2,  // 55: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	21, // 56: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	23, // 57: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	32, // 58: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	35, // 59: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	44, // [44:60] is the sub-list for method output_type
-	28, // [28:44] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	75,  // 0: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	76,  // 1: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	76,  // 2: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	76,  // 3: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
+	77,  // 4: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
+	0,   // 5: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
+	78,  // 6: memos.api.v1.Memo.resources:type_name -> memos.api.v1.Resource
+	31,  // 7: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	79,  // 8: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	67,  // 9: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	8,   // 10: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	76,  // 11: memos.api.v1.Memo.publish_time:type_name -> google.protobuf.Timestamp
+	0,   // 12: memos.api.v1.Memo.target_visibility:type_name -> memos.api.v1.Visibility
+	68,  // 13: memos.api.v1.Memo.reminders:type_name -> memos.api.v1.Memo.Reminder
+	7,   // 14: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	75,  // 15: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	80,  // 16: memos.api.v1.ListMemosRequest.direction:type_name -> memos.api.v1.Direction
+	7,   // 17: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	1,   // 18: memos.api.v1.MemoEvent.type:type_name -> memos.api.v1.MemoEvent.Type
+	7,   // 19: memos.api.v1.MemoEvent.memo:type_name -> memos.api.v1.Memo
+	76,  // 20: memos.api.v1.MemoEvent.event_time:type_name -> google.protobuf.Timestamp
+	69,  // 21: memos.api.v1.SearchMemosResponse.results:type_name -> memos.api.v1.SearchMemosResponse.Result
+	7,   // 22: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	81,  // 23: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	75,  // 24: memos.api.v1.BatchUpdateMemosRequest.state:type_name -> memos.api.v1.State
+	70,  // 25: memos.api.v1.BatchUpdateMemosRequest.update:type_name -> memos.api.v1.BatchUpdateMemosRequest.Update
+	81,  // 26: memos.api.v1.BatchUpdateMemosRequest.update_mask:type_name -> google.protobuf.FieldMask
+	75,  // 27: memos.api.v1.BatchDeleteMemosRequest.state:type_name -> memos.api.v1.State
+	78,  // 28: memos.api.v1.SetMemoResourcesRequest.resources:type_name -> memos.api.v1.Resource
+	78,  // 29: memos.api.v1.ListMemoResourcesResponse.resources:type_name -> memos.api.v1.Resource
+	71,  // 30: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	71,  // 31: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	2,   // 32: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	31,  // 33: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	31,  // 34: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	71,  // 35: memos.api.v1.ListMemoBacklinksResponse.backlinks:type_name -> memos.api.v1.MemoRelation.Memo
+	72,  // 36: memos.api.v1.MemoGraph.nodes:type_name -> memos.api.v1.MemoGraph.Node
+	73,  // 37: memos.api.v1.MemoGraph.edges:type_name -> memos.api.v1.MemoGraph.Edge
+	5,   // 38: memos.api.v1.GetMemoGraphRequest.format:type_name -> memos.api.v1.GetMemoGraphRequest.Format
+	74,  // 39: memos.api.v1.ListMemoLocationsResponse.clusters:type_name -> memos.api.v1.ListMemoLocationsResponse.Cluster
+	7,   // 40: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	7,   // 41: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	44,  // 42: memos.api.v1.ListMemoCommentsResponse.threads:type_name -> memos.api.v1.MemoCommentThread
+	7,   // 43: memos.api.v1.MemoCommentThread.comment:type_name -> memos.api.v1.Memo
+	44,  // 44: memos.api.v1.MemoCommentThread.replies:type_name -> memos.api.v1.MemoCommentThread
+	79,  // 45: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	79,  // 46: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	76,  // 47: memos.api.v1.MemoRevision.create_time:type_name -> google.protobuf.Timestamp
+	0,   // 48: memos.api.v1.MemoRevision.visibility:type_name -> memos.api.v1.Visibility
+	8,   // 49: memos.api.v1.MemoRevision.location:type_name -> memos.api.v1.Location
+	49,  // 50: memos.api.v1.ListMemoRevisionsResponse.revisions:type_name -> memos.api.v1.MemoRevision
+	76,  // 51: memos.api.v1.MemoShare.create_time:type_name -> google.protobuf.Timestamp
+	76,  // 52: memos.api.v1.MemoShare.expire_time:type_name -> google.protobuf.Timestamp
+	76,  // 53: memos.api.v1.CreateMemoShareRequest.expire_time:type_name -> google.protobuf.Timestamp
+	56,  // 54: memos.api.v1.ListMemoSharesResponse.shares:type_name -> memos.api.v1.MemoShare
+	6,   // 55: memos.api.v1.MemoGrant.permission:type_name -> memos.api.v1.MemoGrant.Permission
+	76,  // 56: memos.api.v1.MemoGrant.create_time:type_name -> google.protobuf.Timestamp
+	62,  // 57: memos.api.v1.ListMemoGrantsResponse.grants:type_name -> memos.api.v1.MemoGrant
+	62,  // 58: memos.api.v1.CreateMemoGrantRequest.grant:type_name -> memos.api.v1.MemoGrant
+	76,  // 59: memos.api.v1.Memo.Reminder.remind_time:type_name -> google.protobuf.Timestamp
+	76,  // 60: memos.api.v1.Memo.Reminder.last_remind_time:type_name -> google.protobuf.Timestamp
+	7,   // 61: memos.api.v1.SearchMemosResponse.Result.memo:type_name -> memos.api.v1.Memo
+	0,   // 62: memos.api.v1.BatchUpdateMemosRequest.Update.visibility:type_name -> memos.api.v1.Visibility
+	75,  // 63: memos.api.v1.BatchUpdateMemosRequest.Update.state:type_name -> memos.api.v1.State
+	3,   // 64: memos.api.v1.MemoGraph.Node.type:type_name -> memos.api.v1.MemoGraph.Node.Type
+	4,   // 65: memos.api.v1.MemoGraph.Edge.type:type_name -> memos.api.v1.MemoGraph.Edge.Type
+	9,   // 66: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	10,  // 67: memos.api.v1.MemoService.GetOrCreateDailyMemo:input_type -> memos.api.v1.GetOrCreateDailyMemoRequest
+	11,  // 68: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	13,  // 69: memos.api.v1.MemoService.WatchMemos:input_type -> memos.api.v1.WatchMemosRequest
+	15,  // 70: memos.api.v1.MemoService.SearchMemos:input_type -> memos.api.v1.SearchMemosRequest
+	17,  // 71: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	18,  // 72: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	19,  // 73: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	20,  // 74: memos.api.v1.MemoService.UndeleteMemo:input_type -> memos.api.v1.UndeleteMemoRequest
+	21,  // 75: memos.api.v1.MemoService.PurgeMemo:input_type -> memos.api.v1.PurgeMemoRequest
+	22,  // 76: memos.api.v1.MemoService.BatchUpdateMemos:input_type -> memos.api.v1.BatchUpdateMemosRequest
+	24,  // 77: memos.api.v1.MemoService.BatchDeleteMemos:input_type -> memos.api.v1.BatchDeleteMemosRequest
+	26,  // 78: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
+	27,  // 79: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
+	28,  // 80: memos.api.v1.MemoService.SetMemoResources:input_type -> memos.api.v1.SetMemoResourcesRequest
+	29,  // 81: memos.api.v1.MemoService.ListMemoResources:input_type -> memos.api.v1.ListMemoResourcesRequest
+	32,  // 82: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	33,  // 83: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	35,  // 84: memos.api.v1.MemoService.ListMemoBacklinks:input_type -> memos.api.v1.ListMemoBacklinksRequest
+	38,  // 85: memos.api.v1.MemoService.GetMemoGraph:input_type -> memos.api.v1.GetMemoGraphRequest
+	39,  // 86: memos.api.v1.MemoService.ListMemoLocations:input_type -> memos.api.v1.ListMemoLocationsRequest
+	41,  // 87: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	42,  // 88: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	45,  // 89: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	47,  // 90: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	48,  // 91: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	50,  // 92: memos.api.v1.MemoService.ListMemoRevisions:input_type -> memos.api.v1.ListMemoRevisionsRequest
+	52,  // 93: memos.api.v1.MemoService.GetMemoRevision:input_type -> memos.api.v1.GetMemoRevisionRequest
+	53,  // 94: memos.api.v1.MemoService.RestoreMemoRevision:input_type -> memos.api.v1.RestoreMemoRevisionRequest
+	54,  // 95: memos.api.v1.MemoService.DiffMemoRevisions:input_type -> memos.api.v1.DiffMemoRevisionsRequest
+	57,  // 96: memos.api.v1.MemoService.CreateMemoShare:input_type -> memos.api.v1.CreateMemoShareRequest
+	58,  // 97: memos.api.v1.MemoService.ListMemoShares:input_type -> memos.api.v1.ListMemoSharesRequest
+	60,  // 98: memos.api.v1.MemoService.RevokeMemoShare:input_type -> memos.api.v1.RevokeMemoShareRequest
+	61,  // 99: memos.api.v1.MemoService.GetSharedMemo:input_type -> memos.api.v1.GetSharedMemoRequest
+	63,  // 100: memos.api.v1.MemoService.ListMemoGrants:input_type -> memos.api.v1.ListMemoGrantsRequest
+	65,  // 101: memos.api.v1.MemoService.CreateMemoGrant:input_type -> memos.api.v1.CreateMemoGrantRequest
+	66,  // 102: memos.api.v1.MemoService.DeleteMemoGrant:input_type -> memos.api.v1.DeleteMemoGrantRequest
+	7,   // 103: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	7,   // 104: memos.api.v1.MemoService.GetOrCreateDailyMemo:output_type -> memos.api.v1.Memo
+	12,  // 105: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	14,  // 106: memos.api.v1.MemoService.WatchMemos:output_type -> memos.api.v1.MemoEvent
+	16,  // 107: memos.api.v1.MemoService.SearchMemos:output_type -> memos.api.v1.SearchMemosResponse
+	7,   // 108: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	7,   // 109: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	82,  // 110: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	7,   // 111: memos.api.v1.MemoService.UndeleteMemo:output_type -> memos.api.v1.Memo
+	82,  // 112: memos.api.v1.MemoService.PurgeMemo:output_type -> google.protobuf.Empty
+	23,  // 113: memos.api.v1.MemoService.BatchUpdateMemos:output_type -> memos.api.v1.BatchUpdateMemosResponse
+	25,  // 114: memos.api.v1.MemoService.BatchDeleteMemos:output_type -> memos.api.v1.BatchDeleteMemosResponse
+	82,  // 115: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
+	82,  // 116: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
+	82,  // 117: memos.api.v1.MemoService.SetMemoResources:output_type -> google.protobuf.Empty
+	30,  // 118: memos.api.v1.MemoService.ListMemoResources:output_type -> memos.api.v1.ListMemoResourcesResponse
+	82,  // 119: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	34,  // 120: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	36,  // 121: memos.api.v1.MemoService.ListMemoBacklinks:output_type -> memos.api.v1.ListMemoBacklinksResponse
+	83,  // 122: memos.api.v1.MemoService.GetMemoGraph:output_type -> google.api.HttpBody
+	40,  // 123: memos.api.v1.MemoService.ListMemoLocations:output_type -> memos.api.v1.ListMemoLocationsResponse
+	7,   // 124: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	43,  // 125: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	46,  // 126: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	79,  // 127: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	82,  // 128: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	51,  // 129: memos.api.v1.MemoService.ListMemoRevisions:output_type -> memos.api.v1.ListMemoRevisionsResponse
+	49,  // 130: memos.api.v1.MemoService.GetMemoRevision:output_type -> memos.api.v1.MemoRevision
+	7,   // 131: memos.api.v1.MemoService.RestoreMemoRevision:output_type -> memos.api.v1.Memo
+	55,  // 132: memos.api.v1.MemoService.DiffMemoRevisions:output_type -> memos.api.v1.DiffMemoRevisionsResponse
+	56,  // 133: memos.api.v1.MemoService.CreateMemoShare:output_type -> memos.api.v1.MemoShare
+	59,  // 134: memos.api.v1.MemoService.ListMemoShares:output_type -> memos.api.v1.ListMemoSharesResponse
+	82,  // 135: memos.api.v1.MemoService.RevokeMemoShare:output_type -> google.protobuf.Empty
+	7,   // 136: memos.api.v1.MemoService.GetSharedMemo:output_type -> memos.api.v1.Memo
+	64,  // 137: memos.api.v1.MemoService.ListMemoGrants:output_type -> memos.api.v1.ListMemoGrantsResponse
+	62,  // 138: memos.api.v1.MemoService.CreateMemoGrant:output_type -> memos.api.v1.MemoGrant
+	82,  // 139: memos.api.v1.MemoService.DeleteMemoGrant:output_type -> google.protobuf.Empty
+	103, // [103:140] is the sub-list for method output_type
+	66,  // [66:103] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -1935,13 +5037,17 @@ func file_api_v1_memo_service_proto_init() {
 	file_api_v1_reaction_service_proto_init()
 	file_api_v1_resource_service_proto_init()
 	file_api_v1_memo_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[32].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[50].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   26,
+			NumEnums:      7,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
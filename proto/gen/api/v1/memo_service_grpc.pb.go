@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,22 +21,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName         = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName          = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName            = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName         = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName         = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_RenameMemoTag_FullMethodName      = "/memos.api.v1.MemoService/RenameMemoTag"
-	MemoService_DeleteMemoTag_FullMethodName      = "/memos.api.v1.MemoService/DeleteMemoTag"
-	MemoService_SetMemoResources_FullMethodName   = "/memos.api.v1.MemoService/SetMemoResources"
-	MemoService_ListMemoResources_FullMethodName  = "/memos.api.v1.MemoService/ListMemoResources"
-	MemoService_SetMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName  = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName  = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName   = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName  = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName           = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_GetOrCreateDailyMemo_FullMethodName = "/memos.api.v1.MemoService/GetOrCreateDailyMemo"
+	MemoService_ListMemos_FullMethodName            = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_WatchMemos_FullMethodName           = "/memos.api.v1.MemoService/WatchMemos"
+	MemoService_SearchMemos_FullMethodName          = "/memos.api.v1.MemoService/SearchMemos"
+	MemoService_GetMemo_FullMethodName              = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName           = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName           = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_UndeleteMemo_FullMethodName         = "/memos.api.v1.MemoService/UndeleteMemo"
+	MemoService_PurgeMemo_FullMethodName            = "/memos.api.v1.MemoService/PurgeMemo"
+	MemoService_BatchUpdateMemos_FullMethodName     = "/memos.api.v1.MemoService/BatchUpdateMemos"
+	MemoService_BatchDeleteMemos_FullMethodName     = "/memos.api.v1.MemoService/BatchDeleteMemos"
+	MemoService_RenameMemoTag_FullMethodName        = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_DeleteMemoTag_FullMethodName        = "/memos.api.v1.MemoService/DeleteMemoTag"
+	MemoService_SetMemoResources_FullMethodName     = "/memos.api.v1.MemoService/SetMemoResources"
+	MemoService_ListMemoResources_FullMethodName    = "/memos.api.v1.MemoService/ListMemoResources"
+	MemoService_SetMemoRelations_FullMethodName     = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_ListMemoBacklinks_FullMethodName    = "/memos.api.v1.MemoService/ListMemoBacklinks"
+	MemoService_GetMemoGraph_FullMethodName         = "/memos.api.v1.MemoService/GetMemoGraph"
+	MemoService_ListMemoLocations_FullMethodName    = "/memos.api.v1.MemoService/ListMemoLocations"
+	MemoService_CreateMemoComment_FullMethodName    = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName     = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName    = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName   = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName   = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoRevisions_FullMethodName    = "/memos.api.v1.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevision_FullMethodName      = "/memos.api.v1.MemoService/GetMemoRevision"
+	MemoService_RestoreMemoRevision_FullMethodName  = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	MemoService_DiffMemoRevisions_FullMethodName    = "/memos.api.v1.MemoService/DiffMemoRevisions"
+	MemoService_CreateMemoShare_FullMethodName      = "/memos.api.v1.MemoService/CreateMemoShare"
+	MemoService_ListMemoShares_FullMethodName       = "/memos.api.v1.MemoService/ListMemoShares"
+	MemoService_RevokeMemoShare_FullMethodName      = "/memos.api.v1.MemoService/RevokeMemoShare"
+	MemoService_GetSharedMemo_FullMethodName        = "/memos.api.v1.MemoService/GetSharedMemo"
+	MemoService_ListMemoGrants_FullMethodName       = "/memos.api.v1.MemoService/ListMemoGrants"
+	MemoService_CreateMemoGrant_FullMethodName      = "/memos.api.v1.MemoService/CreateMemoGrant"
+	MemoService_DeleteMemoGrant_FullMethodName      = "/memos.api.v1.MemoService/DeleteMemoGrant"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -44,14 +66,32 @@ const (
 type MemoServiceClient interface {
 	// CreateMemo creates a memo.
 	CreateMemo(ctx context.Context, in *CreateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// GetOrCreateDailyMemo gets the daily memo of the current user for a calendar day,
+	// or creates it if it doesn't exist yet.
+	// Without a daily memo, the memo tagged with the day is used, e.g. `#2025-01-02` or `#daily/2025-01-02`.
+	GetOrCreateDailyMemo(ctx context.Context, in *GetOrCreateDailyMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
+	// WatchMemos streams the events of the memos created, updated or deleted from now on,
+	// limited to the memos visible to the caller. Comments are included.
+	// The events are also served as Server-Sent Events at `/api/v1/sse/memos`.
+	WatchMemos(ctx context.Context, in *WatchMemosRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MemoEvent], error)
+	// SearchMemos searches the content of memos, ordered by relevance.
+	SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// DeleteMemo deletes a memo.
 	DeleteMemo(ctx context.Context, in *DeleteMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteMemo restores a deleted memo from the trash.
+	UndeleteMemo(ctx context.Context, in *UndeleteMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// PurgeMemo permanently deletes a memo from the trash.
+	PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BatchUpdateMemos updates all the memos of the current user that match the filter.
+	BatchUpdateMemos(ctx context.Context, in *BatchUpdateMemosRequest, opts ...grpc.CallOption) (*BatchUpdateMemosResponse, error)
+	// BatchDeleteMemos moves all the memos of the current user that match the filter to the trash.
+	BatchDeleteMemos(ctx context.Context, in *BatchDeleteMemosRequest, opts ...grpc.CallOption) (*BatchDeleteMemosResponse, error)
 	// RenameMemoTag renames a tag for a memo.
 	RenameMemoTag(ctx context.Context, in *RenameMemoTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteMemoTag deletes a tag for a memo.
@@ -64,6 +104,14 @@ type MemoServiceClient interface {
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(ctx context.Context, in *ListMemoRelationsRequest, opts ...grpc.CallOption) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos linking to a memo with `[[memos/{uid}]]` or `![[memos/{uid}]]`.
+	ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error)
+	// GetMemoGraph returns the graph of the visible memos, their relations and tags.
+	// The graph contains at most the 1000 most recently created memos matching the request.
+	// The graph is encoded according to the requested format, refer to `MemoGraph` for the JSON format.
+	GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// ListMemoLocations lists the locations of the visible memos, clustered for a map view.
+	ListMemoLocations(ctx context.Context, in *ListMemoLocationsRequest, opts ...grpc.CallOption) (*ListMemoLocationsResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -74,6 +122,29 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions for a memo.
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error)
+	// DiffMemoRevisions returns a unified diff between two revisions of a memo.
+	DiffMemoRevisions(ctx context.Context, in *DiffMemoRevisionsRequest, opts ...grpc.CallOption) (*DiffMemoRevisionsResponse, error)
+	// CreateMemoShare creates a share link of a memo.
+	CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error)
+	// RevokeMemoShare revokes a share link of a memo.
+	RevokeMemoShare(ctx context.Context, in *RevokeMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSharedMemo gets the memo of a share link.
+	GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// ListMemoGrants lists the access grants of a memo.
+	ListMemoGrants(ctx context.Context, in *ListMemoGrantsRequest, opts ...grpc.CallOption) (*ListMemoGrantsResponse, error)
+	// CreateMemoGrant grants a user access to a memo.
+	// If the user already has a grant, its permission is updated.
+	CreateMemoGrant(ctx context.Context, in *CreateMemoGrantRequest, opts ...grpc.CallOption) (*MemoGrant, error)
+	// DeleteMemoGrant revokes an access grant of a memo.
+	DeleteMemoGrant(ctx context.Context, in *DeleteMemoGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type memoServiceClient struct {
@@ -94,6 +165,16 @@ func (c *memoServiceClient) CreateMemo(ctx context.Context, in *CreateMemoReques
 	return out, nil
 }
 
+func (c *memoServiceClient) GetOrCreateDailyMemo(ctx context.Context, in *GetOrCreateDailyMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_GetOrCreateDailyMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMemosResponse)
@@ -104,6 +185,35 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest,
 	return out, nil
 }
 
+func (c *memoServiceClient) WatchMemos(ctx context.Context, in *WatchMemosRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MemoEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[0], MemoService_WatchMemos_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMemosRequest, MemoEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_WatchMemosClient = grpc.ServerStreamingClient[MemoEvent]
+
+func (c *memoServiceClient) SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SearchMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -134,6 +244,46 @@ func (c *memoServiceClient) DeleteMemo(ctx context.Context, in *DeleteMemoReques
 	return out, nil
 }
 
+func (c *memoServiceClient) UndeleteMemo(ctx context.Context, in *UndeleteMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_UndeleteMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_PurgeMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) BatchUpdateMemos(ctx context.Context, in *BatchUpdateMemosRequest, opts ...grpc.CallOption) (*BatchUpdateMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_BatchUpdateMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) BatchDeleteMemos(ctx context.Context, in *BatchDeleteMemosRequest, opts ...grpc.CallOption) (*BatchDeleteMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_BatchDeleteMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) RenameMemoTag(ctx context.Context, in *RenameMemoTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -194,6 +344,36 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, in *ListMemoR
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoBacklinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoBacklinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoLocations(ctx context.Context, in *ListMemoLocationsRequest, opts ...grpc.CallOption) (*ListMemoLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoLocationsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -244,20 +424,148 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRevision)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DiffMemoRevisions(ctx context.Context, in *DiffMemoRevisionsRequest, opts ...grpc.CallOption) (*DiffMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_DiffMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShare)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoSharesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RevokeMemoShare(ctx context.Context, in *RevokeMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RevokeMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_GetSharedMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoGrants(ctx context.Context, in *ListMemoGrantsRequest, opts ...grpc.CallOption) (*ListMemoGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoGrantsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoGrant(ctx context.Context, in *CreateMemoGrantRequest, opts ...grpc.CallOption) (*MemoGrant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoGrant)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoGrant(ctx context.Context, in *DeleteMemoGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
 type MemoServiceServer interface {
 	// CreateMemo creates a memo.
 	CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error)
+	// GetOrCreateDailyMemo gets the daily memo of the current user for a calendar day,
+	// or creates it if it doesn't exist yet.
+	// Without a daily memo, the memo tagged with the day is used, e.g. `#2025-01-02` or `#daily/2025-01-02`.
+	GetOrCreateDailyMemo(context.Context, *GetOrCreateDailyMemoRequest) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
+	// WatchMemos streams the events of the memos created, updated or deleted from now on,
+	// limited to the memos visible to the caller. Comments are included.
+	// The events are also served as Server-Sent Events at `/api/v1/sse/memos`.
+	WatchMemos(*WatchMemosRequest, grpc.ServerStreamingServer[MemoEvent]) error
+	// SearchMemos searches the content of memos, ordered by relevance.
+	SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error)
 	// DeleteMemo deletes a memo.
 	DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error)
+	// UndeleteMemo restores a deleted memo from the trash.
+	UndeleteMemo(context.Context, *UndeleteMemoRequest) (*Memo, error)
+	// PurgeMemo permanently deletes a memo from the trash.
+	PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error)
+	// BatchUpdateMemos updates all the memos of the current user that match the filter.
+	BatchUpdateMemos(context.Context, *BatchUpdateMemosRequest) (*BatchUpdateMemosResponse, error)
+	// BatchDeleteMemos moves all the memos of the current user that match the filter to the trash.
+	BatchDeleteMemos(context.Context, *BatchDeleteMemosRequest) (*BatchDeleteMemosResponse, error)
 	// RenameMemoTag renames a tag for a memo.
 	RenameMemoTag(context.Context, *RenameMemoTagRequest) (*emptypb.Empty, error)
 	// DeleteMemoTag deletes a tag for a memo.
@@ -270,6 +578,14 @@ type MemoServiceServer interface {
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos linking to a memo with `[[memos/{uid}]]` or `![[memos/{uid}]]`.
+	ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error)
+	// GetMemoGraph returns the graph of the visible memos, their relations and tags.
+	// The graph contains at most the 1000 most recently created memos matching the request.
+	// The graph is encoded according to the requested format, refer to `MemoGraph` for the JSON format.
+	GetMemoGraph(context.Context, *GetMemoGraphRequest) (*httpbody.HttpBody, error)
+	// ListMemoLocations lists the locations of the visible memos, clustered for a map view.
+	ListMemoLocations(context.Context, *ListMemoLocationsRequest) (*ListMemoLocationsResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -280,6 +596,29 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions for a memo.
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error)
+	// DiffMemoRevisions returns a unified diff between two revisions of a memo.
+	DiffMemoRevisions(context.Context, *DiffMemoRevisionsRequest) (*DiffMemoRevisionsResponse, error)
+	// CreateMemoShare creates a share link of a memo.
+	CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error)
+	// RevokeMemoShare revokes a share link of a memo.
+	RevokeMemoShare(context.Context, *RevokeMemoShareRequest) (*emptypb.Empty, error)
+	// GetSharedMemo gets the memo of a share link.
+	GetSharedMemo(context.Context, *GetSharedMemoRequest) (*Memo, error)
+	// ListMemoGrants lists the access grants of a memo.
+	ListMemoGrants(context.Context, *ListMemoGrantsRequest) (*ListMemoGrantsResponse, error)
+	// CreateMemoGrant grants a user access to a memo.
+	// If the user already has a grant, its permission is updated.
+	CreateMemoGrant(context.Context, *CreateMemoGrantRequest) (*MemoGrant, error)
+	// DeleteMemoGrant revokes an access grant of a memo.
+	DeleteMemoGrant(context.Context, *DeleteMemoGrantRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -293,9 +632,18 @@ type UnimplementedMemoServiceServer struct{}
 func (UnimplementedMemoServiceServer) CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) GetOrCreateDailyMemo(context.Context, *GetOrCreateDailyMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrCreateDailyMemo not implemented")
+}
 func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) WatchMemos(*WatchMemosRequest, grpc.ServerStreamingServer[MemoEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
 }
@@ -305,6 +653,18 @@ func (UnimplementedMemoServiceServer) UpdateMemo(context.Context, *UpdateMemoReq
 func (UnimplementedMemoServiceServer) DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) UndeleteMemo(context.Context, *UndeleteMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) BatchUpdateMemos(context.Context, *BatchUpdateMemosRequest) (*BatchUpdateMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) BatchDeleteMemos(context.Context, *BatchDeleteMemosRequest) (*BatchDeleteMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) RenameMemoTag(context.Context, *RenameMemoTagRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameMemoTag not implemented")
 }
@@ -323,6 +683,15 @@ func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemo
 func (UnimplementedMemoServiceServer) ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRelations not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoBacklinks not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoGraph(context.Context, *GetMemoGraphRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoGraph not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoLocations(context.Context, *ListMemoLocationsRequest) (*ListMemoLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoLocations not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoComment not implemented")
 }
@@ -338,6 +707,39 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) DiffMemoRevisions(context.Context, *DiffMemoRevisionsRequest) (*DiffMemoRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoShares not implemented")
+}
+func (UnimplementedMemoServiceServer) RevokeMemoShare(context.Context, *RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) GetSharedMemo(context.Context, *GetSharedMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoGrants(context.Context, *ListMemoGrantsRequest) (*ListMemoGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoGrants not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoGrant(context.Context, *CreateMemoGrantRequest) (*MemoGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoGrant not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoGrant(context.Context, *DeleteMemoGrantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoGrant not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -377,6 +779,24 @@ func _MemoService_CreateMemo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_GetOrCreateDailyMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrCreateDailyMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetOrCreateDailyMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetOrCreateDailyMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetOrCreateDailyMemo(ctx, req.(*GetOrCreateDailyMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMemosRequest)
 	if err := dec(in); err != nil {
@@ -395,6 +815,35 @@ func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_WatchMemos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMemosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoServiceServer).WatchMemos(m, &grpc.GenericServerStream[WatchMemosRequest, MemoEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_WatchMemosServer = grpc.ServerStreamingServer[MemoEvent]
+
+func _MemoService_SearchMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SearchMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SearchMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SearchMemos(ctx, req.(*SearchMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
@@ -449,6 +898,78 @@ func _MemoService_DeleteMemo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_UndeleteMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UndeleteMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UndeleteMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UndeleteMemo(ctx, req.(*UndeleteMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_PurgeMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_PurgeMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, req.(*PurgeMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_BatchUpdateMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).BatchUpdateMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_BatchUpdateMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).BatchUpdateMemos(ctx, req.(*BatchUpdateMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_BatchDeleteMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).BatchDeleteMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_BatchDeleteMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).BatchDeleteMemos(ctx, req.(*BatchDeleteMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_RenameMemoTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenameMemoTagRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +1078,60 @@ func _MemoService_ListMemoRelations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoBacklinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, req.(*ListMemoBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, req.(*GetMemoGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoLocations(ctx, req.(*ListMemoLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemoCommentRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +1222,204 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, req.(*GetMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DiffMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DiffMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DiffMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DiffMemoRevisions(ctx, req.(*DiffMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, req.(*CreateMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, req.(*ListMemoSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RevokeMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RevokeMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RevokeMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RevokeMemoShare(ctx, req.(*RevokeMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetSharedMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetSharedMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, req.(*GetSharedMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoGrants(ctx, req.(*ListMemoGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoGrant(ctx, req.(*CreateMemoGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoGrant(ctx, req.(*DeleteMemoGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -658,10 +1431,18 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMemo",
 			Handler:    _MemoService_CreateMemo_Handler,
 		},
+		{
+			MethodName: "GetOrCreateDailyMemo",
+			Handler:    _MemoService_GetOrCreateDailyMemo_Handler,
+		},
 		{
 			MethodName: "ListMemos",
 			Handler:    _MemoService_ListMemos_Handler,
 		},
+		{
+			MethodName: "SearchMemos",
+			Handler:    _MemoService_SearchMemos_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _MemoService_GetMemo_Handler,
@@ -674,6 +1455,22 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemo",
 			Handler:    _MemoService_DeleteMemo_Handler,
 		},
+		{
+			MethodName: "UndeleteMemo",
+			Handler:    _MemoService_UndeleteMemo_Handler,
+		},
+		{
+			MethodName: "PurgeMemo",
+			Handler:    _MemoService_PurgeMemo_Handler,
+		},
+		{
+			MethodName: "BatchUpdateMemos",
+			Handler:    _MemoService_BatchUpdateMemos_Handler,
+		},
+		{
+			MethodName: "BatchDeleteMemos",
+			Handler:    _MemoService_BatchDeleteMemos_Handler,
+		},
 		{
 			MethodName: "RenameMemoTag",
 			Handler:    _MemoService_RenameMemoTag_Handler,
@@ -698,6 +1495,18 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoRelations",
 			Handler:    _MemoService_ListMemoRelations_Handler,
 		},
+		{
+			MethodName: "ListMemoBacklinks",
+			Handler:    _MemoService_ListMemoBacklinks_Handler,
+		},
+		{
+			MethodName: "GetMemoGraph",
+			Handler:    _MemoService_GetMemoGraph_Handler,
+		},
+		{
+			MethodName: "ListMemoLocations",
+			Handler:    _MemoService_ListMemoLocations_Handler,
+		},
 		{
 			MethodName: "CreateMemoComment",
 			Handler:    _MemoService_CreateMemoComment_Handler,
@@ -718,7 +1527,57 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevision",
+			Handler:    _MemoService_GetMemoRevision_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
+		{
+			MethodName: "DiffMemoRevisions",
+			Handler:    _MemoService_DiffMemoRevisions_Handler,
+		},
+		{
+			MethodName: "CreateMemoShare",
+			Handler:    _MemoService_CreateMemoShare_Handler,
+		},
+		{
+			MethodName: "ListMemoShares",
+			Handler:    _MemoService_ListMemoShares_Handler,
+		},
+		{
+			MethodName: "RevokeMemoShare",
+			Handler:    _MemoService_RevokeMemoShare_Handler,
+		},
+		{
+			MethodName: "GetSharedMemo",
+			Handler:    _MemoService_GetSharedMemo_Handler,
+		},
+		{
+			MethodName: "ListMemoGrants",
+			Handler:    _MemoService_ListMemoGrants_Handler,
+		},
+		{
+			MethodName: "CreateMemoGrant",
+			Handler:    _MemoService_CreateMemoGrant_Handler,
+		},
+		{
+			MethodName: "DeleteMemoGrant",
+			Handler:    _MemoService_DeleteMemoGrant_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMemos",
+			Handler:       _MemoService_WatchMemos_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
 }
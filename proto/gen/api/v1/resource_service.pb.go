@@ -305,7 +305,9 @@ type GetResourceBinaryRequest struct {
 	// The filename of the resource. Mainly used for downloading.
 	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 	// A flag indicating if the thumbnail version of the resource should be returned
-	Thumbnail     bool `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Thumbnail bool `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// The token of a share link of the memo that the resource belongs to.
+	ShareToken    string `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -361,6 +363,13 @@ func (x *GetResourceBinaryRequest) GetThumbnail() bool {
 	return false
 }
 
+func (x *GetResourceBinaryRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
 type UpdateResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
@@ -480,11 +489,13 @@ const file_api_v1_resource_service_proto_rawDesc = "" +
 	"\x15ListResourcesResponse\x124\n" +
 	"\tresources\x18\x01 \x03(\v2\x16.memos.api.v1.ResourceR\tresources\"(\n" +
 	"\x12GetResourceRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"h\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x89\x01\n" +
 	"\x18GetResourceBinaryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1c\n" +
-	"\tthumbnail\x18\x03 \x01(\bR\tthumbnail\"\x88\x01\n" +
+	"\tthumbnail\x18\x03 \x01(\bR\tthumbnail\x12\x1f\n" +
+	"\vshare_token\x18\x04 \x01(\tR\n" +
+	"shareToken\"\x88\x01\n" +
 	"\x15UpdateResourceRequest\x122\n" +
 	"\bresource\x18\x01 \x01(\v2\x16.memos.api.v1.ResourceR\bresource\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/tag_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full name of the tag without the leading `#`.
+	// Nested tags are separated by `/`, e.g. `work/project`.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color       string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon        string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Pinned      bool   `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// The number of memos with the tag or any of its nested tags.
+	MemoCount int32 `protobuf:"varint,6,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The nested tags, e.g. `work/project` is nested in `work`.
+	Children      []*Tag `protobuf:"bytes,7,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tag) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Tag) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Tag) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *Tag) GetChildren() []*Tag {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTagsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The top-level tags, with the nested tags in their children.
+	Tags          []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *UpdateTagRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type MergeTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The tags to merge, their nested tags are moved under the target tag.
+	SourceTags    []string `protobuf:"bytes,2,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"`
+	TargetTag     string   `protobuf:"bytes,3,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MergeTagsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetSourceTags() []string {
+	if x != nil {
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+var File_api_v1_tag_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_tag_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/v1/tag_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a google/protobuf/field_mask.proto\"\xd5\x01\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\x12\"\n" +
+	"\n" +
+	"memo_count\x18\x06 \x01(\x05B\x03\xe0A\x03R\tmemoCount\x122\n" +
+	"\bchildren\x18\a \x03(\v2\x11.memos.api.v1.TagB\x03\xe0A\x03R\bchildren\")\n" +
+	"\x0fListTagsRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"9\n" +
+	"\x10ListTagsResponse\x12%\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.memos.api.v1.TagR\x04tags\"\x91\x01\n" +
+	"\x10UpdateTagRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12(\n" +
+	"\x03tag\x18\x02 \x01(\v2\x11.memos.api.v1.TagB\x03\xe0A\x02R\x03tag\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"j\n" +
+	"\x10MergeTagsRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1f\n" +
+	"\vsource_tags\x18\x02 \x03(\tR\n" +
+	"sourceTags\x12\x1d\n" +
+	"\n" +
+	"target_tag\x18\x03 \x01(\tR\ttargetTag2\xac\x03\n" +
+	"\n" +
+	"TagService\x12y\n" +
+	"\bListTags\x12\x1d.memos.api.v1.ListTagsRequest\x1a\x1e.memos.api.v1.ListTagsResponse\".\xdaA\x06parent\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/{parent=users/*}/tags\x12\x91\x01\n" +
+	"\tUpdateTag\x12\x1e.memos.api.v1.UpdateTagRequest\x1a\x11.memos.api.v1.Tag\"Q\xdaA\x16parent,tag,update_mask\x82\xd3\xe4\x93\x022:\x03tag2+/api/v1/{parent=users/*}/tags/{tag.name=**}\x12\x8e\x01\n" +
+	"\tMergeTags\x12\x1e.memos.api.v1.MergeTagsRequest\x1a\x11.memos.api.v1.Tag\"N\xdaA\x1dparent,source_tags,target_tag\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/{parent=users/*}/tags:mergeB\xa7\x01\n" +
+	"\x10com.memos.api.v1B\x0fTagServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_tag_service_proto_rawDescOnce sync.Once
+	file_api_v1_tag_service_proto_rawDescData []byte
+)
+
+func file_api_v1_tag_service_proto_rawDescGZIP() []byte {
+	file_api_v1_tag_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_tag_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)))
+	})
+	return file_api_v1_tag_service_proto_rawDescData
+}
+
+var file_api_v1_tag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_tag_service_proto_goTypes = []any{
+	(*Tag)(nil),                   // 0: memos.api.v1.Tag
+	(*ListTagsRequest)(nil),       // 1: memos.api.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),      // 2: memos.api.v1.ListTagsResponse
+	(*UpdateTagRequest)(nil),      // 3: memos.api.v1.UpdateTagRequest
+	(*MergeTagsRequest)(nil),      // 4: memos.api.v1.MergeTagsRequest
+	(*fieldmaskpb.FieldMask)(nil), // 5: google.protobuf.FieldMask
+}
+var file_api_v1_tag_service_proto_depIdxs = []int32{
+	0, // 0: memos.api.v1.Tag.children:type_name -> memos.api.v1.Tag
+	0, // 1: memos.api.v1.ListTagsResponse.tags:type_name -> memos.api.v1.Tag
+	0, // 2: memos.api.v1.UpdateTagRequest.tag:type_name -> memos.api.v1.Tag
+	5, // 3: memos.api.v1.UpdateTagRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1, // 4: memos.api.v1.TagService.ListTags:input_type -> memos.api.v1.ListTagsRequest
+	3, // 5: memos.api.v1.TagService.UpdateTag:input_type -> memos.api.v1.UpdateTagRequest
+	4, // 6: memos.api.v1.TagService.MergeTags:input_type -> memos.api.v1.MergeTagsRequest
+	2, // 7: memos.api.v1.TagService.ListTags:output_type -> memos.api.v1.ListTagsResponse
+	0, // 8: memos.api.v1.TagService.UpdateTag:output_type -> memos.api.v1.Tag
+	0, // 9: memos.api.v1.TagService.MergeTags:output_type -> memos.api.v1.Tag
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_tag_service_proto_init() }
+func file_api_v1_tag_service_proto_init() {
+	if File_api_v1_tag_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_tag_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_tag_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_tag_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_tag_service_proto = out.File
+	file_api_v1_tag_service_proto_goTypes = nil
+	file_api_v1_tag_service_proto_depIdxs = nil
+}
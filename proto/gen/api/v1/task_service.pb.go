@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/task_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Task struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the task.
+	// Format: memos/{memo}/tasks/{task}, task is the index of the task in the memo content.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the memo containing the task.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The text of the task, without the checkbox.
+	Content  string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Complete bool   `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+	// The due date of the task, parsed from `@due(YYYY-MM-DD)` in its content.
+	// Format: YYYY-MM-DD
+	DueDate string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// The tags in the content of the task.
+	Tags          []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_api_v1_task_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_task_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_api_v1_task_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Task) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Task) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *Task) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent is the owner of the memos.
+	// If not specified or `users/-`, it will list the tasks of all visible memos.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of tasks to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListTasks` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only list the complete or incomplete tasks if set.
+	Complete *bool `protobuf:"varint,4,opt,name=complete,proto3,oneof" json:"complete,omitempty"`
+	// Only list the tasks of the memos with the tag.
+	Tag string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only list the tasks due on or after the date.
+	// Format: YYYY-MM-DD
+	DueAfter string `protobuf:"bytes,6,opt,name=due_after,json=dueAfter,proto3" json:"due_after,omitempty"`
+	// Only list the tasks due on or before the date.
+	// Format: YYYY-MM-DD
+	DueBefore     string `protobuf:"bytes,7,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_api_v1_task_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_task_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_task_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTasksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetComplete() bool {
+	if x != nil && x.Complete != nil {
+		return *x.Complete
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetDueAfter() string {
+	if x != nil {
+		return x.DueAfter
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetDueBefore() string {
+	if x != nil {
+		return x.DueBefore
+	}
+	return ""
+}
+
+type ListTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tasks []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_api_v1_task_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_task_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_task_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateTaskRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Task       *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The expected etag of the memo, refer to `UpdateMemoRequest.etag`.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_api_v1_task_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_task_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_task_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+var File_api_v1_task_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_task_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/task_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a google/protobuf/field_mask.proto\"\xac\x01\n" +
+	"\x04Task\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04memo\x18\x02 \x01(\tB\x03\xe0A\x03R\x04memo\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tB\x03\xe0A\x03R\acontent\x12\x1a\n" +
+	"\bcomplete\x18\x04 \x01(\bR\bcomplete\x12\x1e\n" +
+	"\bdue_date\x18\x05 \x01(\tB\x03\xe0A\x03R\adueDate\x12\x17\n" +
+	"\x04tags\x18\x06 \x03(\tB\x03\xe0A\x03R\x04tags\"\xe2\x01\n" +
+	"\x10ListTasksRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\bcomplete\x18\x04 \x01(\bH\x00R\bcomplete\x88\x01\x01\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x1b\n" +
+	"\tdue_after\x18\x06 \x01(\tR\bdueAfter\x12\x1d\n" +
+	"\n" +
+	"due_before\x18\a \x01(\tR\tdueBeforeB\v\n" +
+	"\t_complete\"e\n" +
+	"\x11ListTasksResponse\x12(\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x12.memos.api.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x91\x01\n" +
+	"\x11UpdateTaskRequest\x12+\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.memos.api.v1.TaskB\x03\xe0A\x02R\x04task\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag2\x9f\x02\n" +
+	"\vTaskService\x12\x85\x01\n" +
+	"\tListTasks\x12\x1e.memos.api.v1.ListTasksRequest\x1a\x1f.memos.api.v1.ListTasksResponse\"7\x82\xd3\xe4\x93\x021Z \x12\x1e/api/v1/{parent=users/*}/tasks\x12\r/api/v1/tasks\x12\x87\x01\n" +
+	"\n" +
+	"UpdateTask\x12\x1f.memos.api.v1.UpdateTaskRequest\x1a\x12.memos.api.v1.Task\"D\xdaA\x10task,update_mask\x82\xd3\xe4\x93\x02+:\x04task2#/api/v1/{task.name=memos/*/tasks/*}B\xa8\x01\n" +
+	"\x10com.memos.api.v1B\x10TaskServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_task_service_proto_rawDescOnce sync.Once
+	file_api_v1_task_service_proto_rawDescData []byte
+)
+
+func file_api_v1_task_service_proto_rawDescGZIP() []byte {
+	file_api_v1_task_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_task_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_task_service_proto_rawDesc), len(file_api_v1_task_service_proto_rawDesc)))
+	})
+	return file_api_v1_task_service_proto_rawDescData
+}
+
+var file_api_v1_task_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_v1_task_service_proto_goTypes = []any{
+	(*Task)(nil),                  // 0: memos.api.v1.Task
+	(*ListTasksRequest)(nil),      // 1: memos.api.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),     // 2: memos.api.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),     // 3: memos.api.v1.UpdateTaskRequest
+	(*fieldmaskpb.FieldMask)(nil), // 4: google.protobuf.FieldMask
+}
+var file_api_v1_task_service_proto_depIdxs = []int32{
+	0, // 0: memos.api.v1.ListTasksResponse.tasks:type_name -> memos.api.v1.Task
+	0, // 1: memos.api.v1.UpdateTaskRequest.task:type_name -> memos.api.v1.Task
+	4, // 2: memos.api.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1, // 3: memos.api.v1.TaskService.ListTasks:input_type -> memos.api.v1.ListTasksRequest
+	3, // 4: memos.api.v1.TaskService.UpdateTask:input_type -> memos.api.v1.UpdateTaskRequest
+	2, // 5: memos.api.v1.TaskService.ListTasks:output_type -> memos.api.v1.ListTasksResponse
+	0, // 6: memos.api.v1.TaskService.UpdateTask:output_type -> memos.api.v1.Task
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_task_service_proto_init() }
+func file_api_v1_task_service_proto_init() {
+	if File_api_v1_task_service_proto != nil {
+		return
+	}
+	file_api_v1_task_service_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_task_service_proto_rawDesc), len(file_api_v1_task_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_task_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_task_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_task_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_task_service_proto = out.File
+	file_api_v1_task_service_proto_goTypes = nil
+	file_api_v1_task_service_proto_depIdxs = nil
+}
@@ -0,0 +1,547 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/template_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Template struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The content of the template.
+	// Variables such as `{{date}}`, `{{time}}` and `{{user.nickname}}` are expanded
+	// when a memo is created from the template.
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memos created from the template.
+	Visibility    Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_api_v1_template_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Template) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Template) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type ListTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_api_v1_template_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTemplatesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_api_v1_template_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent        string    `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Template      *Template `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_api_v1_template_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTemplateRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Template      *Template              `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_api_v1_template_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTemplateRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *UpdateTemplateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The id of the template.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_api_v1_template_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTemplateRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *DeleteTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateMemoFromTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The id of the template.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The caller-provided variables, e.g. {"project": "memos"} expands `{{project}}`.
+	// They take precedence over the built-in variables.
+	Variables map[string]string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The IANA time zone used to expand `{{date}}` and `{{time}}`, e.g. "Asia/Shanghai".
+	// Default to the server time zone.
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoFromTemplateRequest) Reset() {
+	*x = CreateMemoFromTemplateRequest{}
+	mi := &file_api_v1_template_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateMemoFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_template_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_template_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateMemoFromTemplateRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoFromTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateMemoFromTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateMemoFromTemplateRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+var File_api_v1_template_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_template_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapi/v1/template_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\x84\x01\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x128\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\".\n" +
+	"\x14ListTemplatesRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"M\n" +
+	"\x15ListTemplatesResponse\x124\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x16.memos.api.v1.TemplateR\ttemplates\"c\n" +
+	"\x15CreateTemplateRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x122\n" +
+	"\btemplate\x18\x02 \x01(\v2\x16.memos.api.v1.TemplateR\btemplate\"\xa0\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x122\n" +
+	"\btemplate\x18\x02 \x01(\v2\x16.memos.api.v1.TemplateR\btemplate\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"?\n" +
+	"\x15DeleteTemplateRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xfb\x01\n" +
+	"\x1dCreateMemoFromTemplateRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12X\n" +
+	"\tvariables\x18\x03 \x03(\v2:.memos.api.v1.CreateMemoFromTemplateRequest.VariablesEntryR\tvariables\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\x9f\x06\n" +
+	"\x0fTemplateService\x12\x8d\x01\n" +
+	"\rListTemplates\x12\".memos.api.v1.ListTemplatesRequest\x1a#.memos.api.v1.ListTemplatesResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=users/*}/templates\x12\x95\x01\n" +
+	"\x0eCreateTemplate\x12#.memos.api.v1.CreateTemplateRequest\x1a\x16.memos.api.v1.Template\"F\xdaA\x0fparent,template\x82\xd3\xe4\x93\x02.:\btemplate\"\"/api/v1/{parent=users/*}/templates\x12\xaf\x01\n" +
+	"\x0eUpdateTemplate\x12#.memos.api.v1.UpdateTemplateRequest\x1a\x16.memos.api.v1.Template\"`\xdaA\x1bparent,template,update_mask\x82\xd3\xe4\x93\x02<:\btemplate20/api/v1/{parent=users/*}/templates/{template.id}\x12\x8a\x01\n" +
+	"\x0eDeleteTemplate\x12#.memos.api.v1.DeleteTemplateRequest\x1a\x16.google.protobuf.Empty\";\xdaA\tparent,id\x82\xd3\xe4\x93\x02)*'/api/v1/{parent=users/*}/templates/{id}\x12\xa4\x01\n" +
+	"\x16CreateMemoFromTemplate\x12+.memos.api.v1.CreateMemoFromTemplateRequest\x1a\x12.memos.api.v1.Memo\"I\xdaA\tparent,id\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/{parent=users/*}/templates/{id}:createMemoB\xac\x01\n" +
+	"\x10com.memos.api.v1B\x14TemplateServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_template_service_proto_rawDescOnce sync.Once
+	file_api_v1_template_service_proto_rawDescData []byte
+)
+
+func file_api_v1_template_service_proto_rawDescGZIP() []byte {
+	file_api_v1_template_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_template_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_template_service_proto_rawDesc), len(file_api_v1_template_service_proto_rawDesc)))
+	})
+	return file_api_v1_template_service_proto_rawDescData
+}
+
+var file_api_v1_template_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_template_service_proto_goTypes = []any{
+	(*Template)(nil),                      // 0: memos.api.v1.Template
+	(*ListTemplatesRequest)(nil),          // 1: memos.api.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),         // 2: memos.api.v1.ListTemplatesResponse
+	(*CreateTemplateRequest)(nil),         // 3: memos.api.v1.CreateTemplateRequest
+	(*UpdateTemplateRequest)(nil),         // 4: memos.api.v1.UpdateTemplateRequest
+	(*DeleteTemplateRequest)(nil),         // 5: memos.api.v1.DeleteTemplateRequest
+	(*CreateMemoFromTemplateRequest)(nil), // 6: memos.api.v1.CreateMemoFromTemplateRequest
+	nil,                                   // 7: memos.api.v1.CreateMemoFromTemplateRequest.VariablesEntry
+	(Visibility)(0),                       // 8: memos.api.v1.Visibility
+	(*fieldmaskpb.FieldMask)(nil),         // 9: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 10: google.protobuf.Empty
+	(*Memo)(nil),                          // 11: memos.api.v1.Memo
+}
+var file_api_v1_template_service_proto_depIdxs = []int32{
+	8,  // 0: memos.api.v1.Template.visibility:type_name -> memos.api.v1.Visibility
+	0,  // 1: memos.api.v1.ListTemplatesResponse.templates:type_name -> memos.api.v1.Template
+	0,  // 2: memos.api.v1.CreateTemplateRequest.template:type_name -> memos.api.v1.Template
+	0,  // 3: memos.api.v1.UpdateTemplateRequest.template:type_name -> memos.api.v1.Template
+	9,  // 4: memos.api.v1.UpdateTemplateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 5: memos.api.v1.CreateMemoFromTemplateRequest.variables:type_name -> memos.api.v1.CreateMemoFromTemplateRequest.VariablesEntry
+	1,  // 6: memos.api.v1.TemplateService.ListTemplates:input_type -> memos.api.v1.ListTemplatesRequest
+	3,  // 7: memos.api.v1.TemplateService.CreateTemplate:input_type -> memos.api.v1.CreateTemplateRequest
+	4,  // 8: memos.api.v1.TemplateService.UpdateTemplate:input_type -> memos.api.v1.UpdateTemplateRequest
+	5,  // 9: memos.api.v1.TemplateService.DeleteTemplate:input_type -> memos.api.v1.DeleteTemplateRequest
+	6,  // 10: memos.api.v1.TemplateService.CreateMemoFromTemplate:input_type -> memos.api.v1.CreateMemoFromTemplateRequest
+	2,  // 11: memos.api.v1.TemplateService.ListTemplates:output_type -> memos.api.v1.ListTemplatesResponse
+	0,  // 12: memos.api.v1.TemplateService.CreateTemplate:output_type -> memos.api.v1.Template
+	0,  // 13: memos.api.v1.TemplateService.UpdateTemplate:output_type -> memos.api.v1.Template
+	10, // 14: memos.api.v1.TemplateService.DeleteTemplate:output_type -> google.protobuf.Empty
+	11, // 15: memos.api.v1.TemplateService.CreateMemoFromTemplate:output_type -> memos.api.v1.Memo
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_template_service_proto_init() }
+func file_api_v1_template_service_proto_init() {
+	if File_api_v1_template_service_proto != nil {
+		return
+	}
+	file_api_v1_memo_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_template_service_proto_rawDesc), len(file_api_v1_template_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_template_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_template_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_template_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_template_service_proto = out.File
+	file_api_v1_template_service_proto_goTypes = nil
+	file_api_v1_template_service_proto_depIdxs = nil
+}
@@ -21,20 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_ListUsers_FullMethodName             = "/memos.api.v1.UserService/ListUsers"
-	UserService_GetUser_FullMethodName               = "/memos.api.v1.UserService/GetUser"
-	UserService_GetUserByUsername_FullMethodName     = "/memos.api.v1.UserService/GetUserByUsername"
-	UserService_GetUserAvatarBinary_FullMethodName   = "/memos.api.v1.UserService/GetUserAvatarBinary"
-	UserService_CreateUser_FullMethodName            = "/memos.api.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName            = "/memos.api.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName            = "/memos.api.v1.UserService/DeleteUser"
-	UserService_ListAllUserStats_FullMethodName      = "/memos.api.v1.UserService/ListAllUserStats"
-	UserService_GetUserStats_FullMethodName          = "/memos.api.v1.UserService/GetUserStats"
-	UserService_GetUserSetting_FullMethodName        = "/memos.api.v1.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName     = "/memos.api.v1.UserService/UpdateUserSetting"
-	UserService_ListUserAccessTokens_FullMethodName  = "/memos.api.v1.UserService/ListUserAccessTokens"
-	UserService_CreateUserAccessToken_FullMethodName = "/memos.api.v1.UserService/CreateUserAccessToken"
-	UserService_DeleteUserAccessToken_FullMethodName = "/memos.api.v1.UserService/DeleteUserAccessToken"
+	UserService_ListUsers_FullMethodName                = "/memos.api.v1.UserService/ListUsers"
+	UserService_GetUser_FullMethodName                  = "/memos.api.v1.UserService/GetUser"
+	UserService_GetUserByUsername_FullMethodName        = "/memos.api.v1.UserService/GetUserByUsername"
+	UserService_GetUserAvatarBinary_FullMethodName      = "/memos.api.v1.UserService/GetUserAvatarBinary"
+	UserService_CreateUser_FullMethodName               = "/memos.api.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName               = "/memos.api.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName               = "/memos.api.v1.UserService/DeleteUser"
+	UserService_ListAllUserStats_FullMethodName         = "/memos.api.v1.UserService/ListAllUserStats"
+	UserService_GetUserStats_FullMethodName             = "/memos.api.v1.UserService/GetUserStats"
+	UserService_GetUserSetting_FullMethodName           = "/memos.api.v1.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName        = "/memos.api.v1.UserService/UpdateUserSetting"
+	UserService_ListUserAccessTokens_FullMethodName     = "/memos.api.v1.UserService/ListUserAccessTokens"
+	UserService_CreateUserAccessToken_FullMethodName    = "/memos.api.v1.UserService/CreateUserAccessToken"
+	UserService_DeleteUserAccessToken_FullMethodName    = "/memos.api.v1.UserService/DeleteUserAccessToken"
+	UserService_RegisterPushSubscription_FullMethodName = "/memos.api.v1.UserService/RegisterPushSubscription"
+	UserService_DeletePushSubscription_FullMethodName   = "/memos.api.v1.UserService/DeletePushSubscription"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -69,6 +71,11 @@ type UserServiceClient interface {
 	CreateUserAccessToken(ctx context.Context, in *CreateUserAccessTokenRequest, opts ...grpc.CallOption) (*UserAccessToken, error)
 	// DeleteUserAccessToken deletes an access token for a user.
 	DeleteUserAccessToken(ctx context.Context, in *DeleteUserAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RegisterPushSubscription registers a Web Push subscription of a browser for a user.
+	// Registering the endpoint of an existing subscription updates its keys.
+	RegisterPushSubscription(ctx context.Context, in *RegisterPushSubscriptionRequest, opts ...grpc.CallOption) (*PushSubscription, error)
+	// DeletePushSubscription deletes a Web Push subscription of a user.
+	DeletePushSubscription(ctx context.Context, in *DeletePushSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userServiceClient struct {
@@ -219,6 +226,26 @@ func (c *userServiceClient) DeleteUserAccessToken(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *userServiceClient) RegisterPushSubscription(ctx context.Context, in *RegisterPushSubscriptionRequest, opts ...grpc.CallOption) (*PushSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushSubscription)
+	err := c.cc.Invoke(ctx, UserService_RegisterPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeletePushSubscription(ctx context.Context, in *DeletePushSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeletePushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -251,6 +278,11 @@ type UserServiceServer interface {
 	CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*UserAccessToken, error)
 	// DeleteUserAccessToken deletes an access token for a user.
 	DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error)
+	// RegisterPushSubscription registers a Web Push subscription of a browser for a user.
+	// Registering the endpoint of an existing subscription updates its keys.
+	RegisterPushSubscription(context.Context, *RegisterPushSubscriptionRequest) (*PushSubscription, error)
+	// DeletePushSubscription deletes a Web Push subscription of a user.
+	DeletePushSubscription(context.Context, *DeletePushSubscriptionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -303,6 +335,12 @@ func (UnimplementedUserServiceServer) CreateUserAccessToken(context.Context, *Cr
 func (UnimplementedUserServiceServer) DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAccessToken not implemented")
 }
+func (UnimplementedUserServiceServer) RegisterPushSubscription(context.Context, *RegisterPushSubscriptionRequest) (*PushSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPushSubscription not implemented")
+}
+func (UnimplementedUserServiceServer) DeletePushSubscription(context.Context, *DeletePushSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePushSubscription not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -576,6 +614,42 @@ func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RegisterPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterPushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterPushSubscription(ctx, req.(*RegisterPushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeletePushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeletePushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeletePushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeletePushSubscription(ctx, req.(*DeletePushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -639,6 +713,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserAccessToken",
 			Handler:    _UserService_DeleteUserAccessToken_Handler,
 		},
+		{
+			MethodName: "RegisterPushSubscription",
+			Handler:    _UserService_RegisterPushSubscription_Handler,
+		},
+		{
+			MethodName: "DeletePushSubscription",
+			Handler:    _UserService_DeletePushSubscription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user_service.proto",
@@ -32,9 +32,12 @@ type WorkspaceProfile struct {
 	// mode is the instance mode (e.g. "prod", "dev" or "demo").
 	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	// instance_url is the URL of the instance.
-	InstanceUrl   string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	InstanceUrl string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// vapid_public_key is the application server key to subscribe to the Web Push notifications with.
+	// It's base64url encoded.
+	VapidPublicKey string `protobuf:"bytes,7,opt,name=vapid_public_key,json=vapidPublicKey,proto3" json:"vapid_public_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *WorkspaceProfile) Reset() {
@@ -95,6 +98,13 @@ func (x *WorkspaceProfile) GetInstanceUrl() string {
 	return ""
 }
 
+func (x *WorkspaceProfile) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
 type GetWorkspaceProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -135,12 +145,13 @@ var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/v1/workspace_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\"y\n" +
+	"\x1eapi/v1/workspace_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\"\xa3\x01\n" +
 	"\x10WorkspaceProfile\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12!\n" +
-	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\"\x1c\n" +
+	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12(\n" +
+	"\x10vapid_public_key\x18\a \x01(\tR\x0evapidPublicKey\"\x1c\n" +
 	"\x1aGetWorkspaceProfileRequest2\x97\x01\n" +
 	"\x10WorkspaceService\x12\x82\x01\n" +
 	"\x13GetWorkspaceProfile\x12(.memos.api.v1.GetWorkspaceProfileRequest\x1a\x1e.memos.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profileB\xad\x01\n" +
@@ -77,6 +77,120 @@ func (WorkspaceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{3, 0}
 }
 
+type LifecycleRule_Action int32
+
+const (
+	LifecycleRule_ACTION_UNSPECIFIED LifecycleRule_Action = 0
+	// ARCHIVE archives the memos.
+	LifecycleRule_ARCHIVE LifecycleRule_Action = 1
+	// CHANGE_VISIBILITY changes the visibility of the memos.
+	LifecycleRule_CHANGE_VISIBILITY LifecycleRule_Action = 2
+	// ADD_TAG adds a tag to the memos.
+	LifecycleRule_ADD_TAG LifecycleRule_Action = 3
+	// DELETE moves the memos to the trash.
+	LifecycleRule_DELETE LifecycleRule_Action = 4
+)
+
+// Enum value maps for LifecycleRule_Action.
+var (
+	LifecycleRule_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ARCHIVE",
+		2: "CHANGE_VISIBILITY",
+		3: "ADD_TAG",
+		4: "DELETE",
+	}
+	LifecycleRule_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ARCHIVE":            1,
+		"CHANGE_VISIBILITY":  2,
+		"ADD_TAG":            3,
+		"DELETE":             4,
+	}
+)
+
+func (x LifecycleRule_Action) Enum() *LifecycleRule_Action {
+	p := new(LifecycleRule_Action)
+	*p = x
+	return p
+}
+
+func (x LifecycleRule_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LifecycleRule_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_setting_service_proto_enumTypes[1].Descriptor()
+}
+
+func (LifecycleRule_Action) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_setting_service_proto_enumTypes[1]
+}
+
+func (x LifecycleRule_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LifecycleRule_Action.Descriptor instead.
+func (LifecycleRule_Action) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type WorkspaceNotificationSetting_EmailSetting_Security int32
+
+const (
+	WorkspaceNotificationSetting_EmailSetting_SECURITY_UNSPECIFIED WorkspaceNotificationSetting_EmailSetting_Security = 0
+	// NONE sends the emails over a plain connection.
+	WorkspaceNotificationSetting_EmailSetting_NONE WorkspaceNotificationSetting_EmailSetting_Security = 1
+	// STARTTLS upgrades the plain connection with the STARTTLS command.
+	WorkspaceNotificationSetting_EmailSetting_STARTTLS WorkspaceNotificationSetting_EmailSetting_Security = 2
+	// TLS connects with implicit TLS, usually on port 465.
+	WorkspaceNotificationSetting_EmailSetting_TLS WorkspaceNotificationSetting_EmailSetting_Security = 3
+)
+
+// Enum value maps for WorkspaceNotificationSetting_EmailSetting_Security.
+var (
+	WorkspaceNotificationSetting_EmailSetting_Security_name = map[int32]string{
+		0: "SECURITY_UNSPECIFIED",
+		1: "NONE",
+		2: "STARTTLS",
+		3: "TLS",
+	}
+	WorkspaceNotificationSetting_EmailSetting_Security_value = map[string]int32{
+		"SECURITY_UNSPECIFIED": 0,
+		"NONE":                 1,
+		"STARTTLS":             2,
+		"TLS":                  3,
+	}
+)
+
+func (x WorkspaceNotificationSetting_EmailSetting_Security) Enum() *WorkspaceNotificationSetting_EmailSetting_Security {
+	p := new(WorkspaceNotificationSetting_EmailSetting_Security)
+	*p = x
+	return p
+}
+
+func (x WorkspaceNotificationSetting_EmailSetting_Security) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceNotificationSetting_EmailSetting_Security) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_setting_service_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkspaceNotificationSetting_EmailSetting_Security) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_setting_service_proto_enumTypes[2]
+}
+
+func (x WorkspaceNotificationSetting_EmailSetting_Security) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceNotificationSetting_EmailSetting_Security.Descriptor instead.
+func (WorkspaceNotificationSetting_EmailSetting_Security) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{10, 0, 0}
+}
+
 type WorkspaceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the name of the setting.
@@ -88,6 +202,8 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_StorageSetting
 	//	*WorkspaceSetting_MemoRelatedSetting
 	//	*WorkspaceSetting_AiModelSetting
+	//	*WorkspaceSetting_LifecycleSetting
+	//	*WorkspaceSetting_NotificationSetting
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -173,6 +289,24 @@ func (x *WorkspaceSetting) GetAiModelSetting() *WorkspaceAIModelSetting {
 	return nil
 }
 
+func (x *WorkspaceSetting) GetLifecycleSetting() *WorkspaceLifecycleSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_LifecycleSetting); ok {
+			return x.LifecycleSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetNotificationSetting() *WorkspaceNotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_NotificationSetting); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -193,6 +327,14 @@ type WorkspaceSetting_AiModelSetting struct {
 	AiModelSetting *WorkspaceAIModelSetting `protobuf:"bytes,5,opt,name=ai_model_setting,json=aiModelSetting,proto3,oneof"`
 }
 
+type WorkspaceSetting_LifecycleSetting struct {
+	LifecycleSetting *WorkspaceLifecycleSetting `protobuf:"bytes,6,opt,name=lifecycle_setting,json=lifecycleSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_NotificationSetting struct {
+	NotificationSetting *WorkspaceNotificationSetting `protobuf:"bytes,7,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_StorageSetting) isWorkspaceSetting_Value() {}
@@ -201,6 +343,10 @@ func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_AiModelSetting) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_LifecycleSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_NotificationSetting) isWorkspaceSetting_Value() {}
+
 type WorkspaceGeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// disallow_user_registration disallows user registration.
@@ -481,9 +627,12 @@ type WorkspaceMemoRelatedSetting struct {
 	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
 	EnableBlurNsfwContent bool `protobuf:"varint,12,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
 	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,13,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	NsfwTags []string `protobuf:"bytes,13,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// trash_retention_days is the number of days a deleted memo stays in the trash before it's purged.
+	// Default is 30 days.
+	TrashRetentionDays int32 `protobuf:"varint,14,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *WorkspaceMemoRelatedSetting) Reset() {
@@ -586,6 +735,13 @@ func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
 	return nil
 }
 
+func (x *WorkspaceMemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
 type WorkspaceAIModelSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// model is the AI model name.
@@ -649,6 +805,149 @@ func (x *WorkspaceAIModelSetting) GetBaseUrl() string {
 	return ""
 }
 
+type WorkspaceLifecycleSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rules are applied to the memos of all users.
+	Rules         []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceLifecycleSetting) Reset() {
+	*x = WorkspaceLifecycleSetting{}
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceLifecycleSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceLifecycleSetting) ProtoMessage() {}
+
+func (x *WorkspaceLifecycleSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceLifecycleSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceLifecycleSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WorkspaceLifecycleSetting) GetRules() []*LifecycleRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// LifecycleRule applies an action to the memos matching a filter once they are old enough.
+type LifecycleRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the rule, generated if empty.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The CEL filter of the memos, e.g. `"scratch" in tags`. Empty matches all memos.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The number of days since the memo was last updated before the rule applies.
+	AgeDays int32                `protobuf:"varint,4,opt,name=age_days,json=ageDays,proto3" json:"age_days,omitempty"`
+	Action  LifecycleRule_Action `protobuf:"varint,5,opt,name=action,proto3,enum=memos.api.v1.LifecycleRule_Action" json:"action,omitempty"`
+	// The visibility for CHANGE_VISIBILITY.
+	Visibility Visibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// The tag for ADD_TAG, without the leading "#".
+	Tag           string `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifecycleRule) Reset() {
+	*x = LifecycleRule{}
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleRule) ProtoMessage() {}
+
+func (x *LifecycleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleRule.ProtoReflect.Descriptor instead.
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LifecycleRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetAgeDays() int32 {
+	if x != nil {
+		return x.AgeDays
+	}
+	return 0
+}
+
+func (x *LifecycleRule) GetAction() LifecycleRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return LifecycleRule_ACTION_UNSPECIFIED
+}
+
+func (x *LifecycleRule) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *LifecycleRule) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
 type GetWorkspaceSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The resource name of the workspace setting.
@@ -660,7 +959,7 @@ type GetWorkspaceSettingRequest struct {
 
 func (x *GetWorkspaceSettingRequest) Reset() {
 	*x = GetWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[6]
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +971,7 @@ func (x *GetWorkspaceSettingRequest) String() string {
 func (*GetWorkspaceSettingRequest) ProtoMessage() {}
 
 func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[6]
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +984,7 @@ func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetWorkspaceSettingRequest) GetName() string {
@@ -705,7 +1004,7 @@ type SetWorkspaceSettingRequest struct {
 
 func (x *SetWorkspaceSettingRequest) Reset() {
 	*x = SetWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[7]
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +1016,7 @@ func (x *SetWorkspaceSettingRequest) String() string {
 func (*SetWorkspaceSettingRequest) ProtoMessage() {}
 
 func (x *SetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[7]
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +1029,7 @@ func (x *SetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
 func (*SetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SetWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
@@ -740,6 +1039,51 @@ func (x *SetWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
 	return nil
 }
 
+type WorkspaceNotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email is the SMTP configuration of the email notifications.
+	Email         *WorkspaceNotificationSetting_EmailSetting `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceNotificationSetting) Reset() {
+	*x = WorkspaceNotificationSetting{}
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceNotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceNotificationSetting) ProtoMessage() {}
+
+func (x *WorkspaceNotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceNotificationSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceNotificationSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceNotificationSetting) GetEmail() *WorkspaceNotificationSetting_EmailSetting {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
 // Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
 type WorkspaceStorageSetting_S3Config struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -755,7 +1099,7 @@ type WorkspaceStorageSetting_S3Config struct {
 
 func (x *WorkspaceStorageSetting_S3Config) Reset() {
 	*x = WorkspaceStorageSetting_S3Config{}
-	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[8]
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -767,7 +1111,7 @@ func (x *WorkspaceStorageSetting_S3Config) String() string {
 func (*WorkspaceStorageSetting_S3Config) ProtoMessage() {}
 
 func (x *WorkspaceStorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[8]
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,17 +1169,123 @@ func (x *WorkspaceStorageSetting_S3Config) GetUsePathStyle() bool {
 	return false
 }
 
+type WorkspaceNotificationSetting_EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables sending the notifications by email.
+	Enabled  bool                                               `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SmtpHost string                                             `protobuf:"bytes,2,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort int32                                              `protobuf:"varint,3,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	Security WorkspaceNotificationSetting_EmailSetting_Security `protobuf:"varint,4,opt,name=security,proto3,enum=memos.api.v1.WorkspaceNotificationSetting_EmailSetting_Security" json:"security,omitempty"`
+	// smtp_username is used for the authentication if it's set.
+	SmtpUsername string `protobuf:"bytes,5,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword string `protobuf:"bytes,6,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// from_email is the address the emails are sent from.
+	FromEmail string `protobuf:"bytes,7,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name the emails are sent from.
+	FromName      string `protobuf:"bytes,8,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) Reset() {
+	*x = WorkspaceNotificationSetting_EmailSetting{}
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceNotificationSetting_EmailSetting) ProtoMessage() {}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_setting_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceNotificationSetting_EmailSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceNotificationSetting_EmailSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_setting_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSecurity() WorkspaceNotificationSetting_EmailSetting_Security {
+	if x != nil {
+		return x.Security
+	}
+	return WorkspaceNotificationSetting_EmailSetting_SECURITY_UNSPECIFIED
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
 var File_api_v1_workspace_setting_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_workspace_setting_service_proto_rawDesc = "" +
 	"\n" +
-	"&api/v1/workspace_setting_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\"\x85\x03\n" +
+	"&api/v1/workspace_setting_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\"\xbe\x04\n" +
 	"\x10WorkspaceSetting\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12P\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2%.memos.api.v1.WorkspaceGeneralSettingH\x00R\x0egeneralSetting\x12P\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2%.memos.api.v1.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12]\n" +
 	"\x14memo_related_setting\x18\x04 \x01(\v2).memos.api.v1.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12Q\n" +
-	"\x10ai_model_setting\x18\x05 \x01(\v2%.memos.api.v1.WorkspaceAIModelSettingH\x00R\x0eaiModelSettingB\a\n" +
+	"\x10ai_model_setting\x18\x05 \x01(\v2%.memos.api.v1.WorkspaceAIModelSettingH\x00R\x0eaiModelSetting\x12V\n" +
+	"\x11lifecycle_setting\x18\x06 \x01(\v2'.memos.api.v1.WorkspaceLifecycleSettingH\x00R\x10lifecycleSetting\x12_\n" +
+	"\x14notification_setting\x18\a \x01(\v2*.memos.api.v1.WorkspaceNotificationSettingH\x00R\x13notificationSettingB\a\n" +
 	"\x05value\"\xd9\x03\n" +
 	"\x17WorkspaceGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x01 \x01(\bR\x18disallowUserRegistration\x124\n" +
@@ -870,7 +1320,7 @@ const file_api_v1_workspace_setting_service_proto_rawDesc = "" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\"\x94\x04\n" +
+	"\x02S3\x10\x03\"\xc6\x04\n" +
 	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -882,15 +1332,52 @@ const file_api_v1_workspace_setting_service_proto_rawDesc = "" +
 	" \x03(\tR\treactions\x12<\n" +
 	"\x1adisable_markdown_shortcuts\x18\v \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\f \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
-	"\tnsfw_tags\x18\r \x03(\tR\bnsfwTagsJ\x04\b\x04\x10\x05J\x04\b\b\x10\t\"c\n" +
+	"\tnsfw_tags\x18\r \x03(\tR\bnsfwTags\x120\n" +
+	"\x14trash_retention_days\x18\x0e \x01(\x05R\x12trashRetentionDaysJ\x04\b\x04\x10\x05J\x04\b\b\x10\t\"c\n" +
 	"\x17WorkspaceAIModelSetting\x12\x14\n" +
 	"\x05model\x18\x01 \x01(\tR\x05model\x12\x17\n" +
 	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x19\n" +
-	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\"5\n" +
+	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\"N\n" +
+	"\x19WorkspaceLifecycleSetting\x121\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1b.memos.api.v1.LifecycleRuleR\x05rules\"\xcf\x02\n" +
+	"\rLifecycleRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\bage_days\x18\x04 \x01(\x05R\aageDays\x12:\n" +
+	"\x06action\x18\x05 \x01(\x0e2\".memos.api.v1.LifecycleRule.ActionR\x06action\x128\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\x12\x10\n" +
+	"\x03tag\x18\a \x01(\tR\x03tag\"]\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aARCHIVE\x10\x01\x12\x15\n" +
+	"\x11CHANGE_VISIBILITY\x10\x02\x12\v\n" +
+	"\aADD_TAG\x10\x03\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x04\"5\n" +
 	"\x1aGetWorkspaceSettingRequest\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"V\n" +
 	"\x1aSetWorkspaceSettingRequest\x128\n" +
-	"\asetting\x18\x01 \x01(\v2\x1e.memos.api.v1.WorkspaceSettingR\asetting2\xd9\x02\n" +
+	"\asetting\x18\x01 \x01(\v2\x1e.memos.api.v1.WorkspaceSettingR\asetting\"\xfd\x03\n" +
+	"\x1cWorkspaceNotificationSetting\x12M\n" +
+	"\x05email\x18\x01 \x01(\v27.memos.api.v1.WorkspaceNotificationSetting.EmailSettingR\x05email\x1a\x8d\x03\n" +
+	"\fEmailSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tsmtp_host\x18\x02 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x03 \x01(\x05R\bsmtpPort\x12\\\n" +
+	"\bsecurity\x18\x04 \x01(\x0e2@.memos.api.v1.WorkspaceNotificationSetting.EmailSetting.SecurityR\bsecurity\x12#\n" +
+	"\rsmtp_username\x18\x05 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x06 \x01(\tR\fsmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\a \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\b \x01(\tR\bfromName\"E\n" +
+	"\bSecurity\x12\x18\n" +
+	"\x14SECURITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NONE\x10\x01\x12\f\n" +
+	"\bSTARTTLS\x10\x02\x12\a\n" +
+	"\x03TLS\x10\x032\xd9\x02\n" +
 	"\x17WorkspaceSettingService\x12\x93\x01\n" +
 	"\x13GetWorkspaceSetting\x12(.memos.api.v1.GetWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%\x12#/api/v1/workspace/{name=settings/*}\x12\xa7\x01\n" +
 	"\x13SetWorkspaceSetting\x12(.memos.api.v1.SetWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"F\xdaA\asetting\x82\xd3\xe4\x93\x026:\asetting2+/api/v1/workspace/{setting.name=settings/*}B\xb4\x01\n" +
@@ -908,38 +1395,52 @@ func file_api_v1_workspace_setting_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_workspace_setting_service_proto_rawDescData
 }
 
-var file_api_v1_workspace_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_workspace_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_workspace_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_workspace_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_v1_workspace_setting_service_proto_goTypes = []any{
-	(WorkspaceStorageSetting_StorageType)(0), // 0: memos.api.v1.WorkspaceStorageSetting.StorageType
-	(*WorkspaceSetting)(nil),                 // 1: memos.api.v1.WorkspaceSetting
-	(*WorkspaceGeneralSetting)(nil),          // 2: memos.api.v1.WorkspaceGeneralSetting
-	(*WorkspaceCustomProfile)(nil),           // 3: memos.api.v1.WorkspaceCustomProfile
-	(*WorkspaceStorageSetting)(nil),          // 4: memos.api.v1.WorkspaceStorageSetting
-	(*WorkspaceMemoRelatedSetting)(nil),      // 5: memos.api.v1.WorkspaceMemoRelatedSetting
-	(*WorkspaceAIModelSetting)(nil),          // 6: memos.api.v1.WorkspaceAIModelSetting
-	(*GetWorkspaceSettingRequest)(nil),       // 7: memos.api.v1.GetWorkspaceSettingRequest
-	(*SetWorkspaceSettingRequest)(nil),       // 8: memos.api.v1.SetWorkspaceSettingRequest
-	(*WorkspaceStorageSetting_S3Config)(nil), // 9: memos.api.v1.WorkspaceStorageSetting.S3Config
+	(WorkspaceStorageSetting_StorageType)(0),                // 0: memos.api.v1.WorkspaceStorageSetting.StorageType
+	(LifecycleRule_Action)(0),                               // 1: memos.api.v1.LifecycleRule.Action
+	(WorkspaceNotificationSetting_EmailSetting_Security)(0), // 2: memos.api.v1.WorkspaceNotificationSetting.EmailSetting.Security
+	(*WorkspaceSetting)(nil),                                // 3: memos.api.v1.WorkspaceSetting
+	(*WorkspaceGeneralSetting)(nil),                         // 4: memos.api.v1.WorkspaceGeneralSetting
+	(*WorkspaceCustomProfile)(nil),                          // 5: memos.api.v1.WorkspaceCustomProfile
+	(*WorkspaceStorageSetting)(nil),                         // 6: memos.api.v1.WorkspaceStorageSetting
+	(*WorkspaceMemoRelatedSetting)(nil),                     // 7: memos.api.v1.WorkspaceMemoRelatedSetting
+	(*WorkspaceAIModelSetting)(nil),                         // 8: memos.api.v1.WorkspaceAIModelSetting
+	(*WorkspaceLifecycleSetting)(nil),                       // 9: memos.api.v1.WorkspaceLifecycleSetting
+	(*LifecycleRule)(nil),                                   // 10: memos.api.v1.LifecycleRule
+	(*GetWorkspaceSettingRequest)(nil),                      // 11: memos.api.v1.GetWorkspaceSettingRequest
+	(*SetWorkspaceSettingRequest)(nil),                      // 12: memos.api.v1.SetWorkspaceSettingRequest
+	(*WorkspaceNotificationSetting)(nil),                    // 13: memos.api.v1.WorkspaceNotificationSetting
+	(*WorkspaceStorageSetting_S3Config)(nil),                // 14: memos.api.v1.WorkspaceStorageSetting.S3Config
+	(*WorkspaceNotificationSetting_EmailSetting)(nil),       // 15: memos.api.v1.WorkspaceNotificationSetting.EmailSetting
+	(Visibility)(0),                                         // 16: memos.api.v1.Visibility
 }
 var file_api_v1_workspace_setting_service_proto_depIdxs = []int32{
-	2,  // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceGeneralSetting
-	4,  // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceStorageSetting
-	5,  // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceMemoRelatedSetting
-	6,  // 3: memos.api.v1.WorkspaceSetting.ai_model_setting:type_name -> memos.api.v1.WorkspaceAIModelSetting
-	3,  // 4: memos.api.v1.WorkspaceGeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceCustomProfile
-	0,  // 5: memos.api.v1.WorkspaceStorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceStorageSetting.StorageType
-	9,  // 6: memos.api.v1.WorkspaceStorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceStorageSetting.S3Config
-	1,  // 7: memos.api.v1.SetWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
-	7,  // 8: memos.api.v1.WorkspaceSettingService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
-	8,  // 9: memos.api.v1.WorkspaceSettingService.SetWorkspaceSetting:input_type -> memos.api.v1.SetWorkspaceSettingRequest
-	1,  // 10: memos.api.v1.WorkspaceSettingService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	1,  // 11: memos.api.v1.WorkspaceSettingService.SetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceGeneralSetting
+	6,  // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceStorageSetting
+	7,  // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceMemoRelatedSetting
+	8,  // 3: memos.api.v1.WorkspaceSetting.ai_model_setting:type_name -> memos.api.v1.WorkspaceAIModelSetting
+	9,  // 4: memos.api.v1.WorkspaceSetting.lifecycle_setting:type_name -> memos.api.v1.WorkspaceLifecycleSetting
+	13, // 5: memos.api.v1.WorkspaceSetting.notification_setting:type_name -> memos.api.v1.WorkspaceNotificationSetting
+	5,  // 6: memos.api.v1.WorkspaceGeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceCustomProfile
+	0,  // 7: memos.api.v1.WorkspaceStorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceStorageSetting.StorageType
+	14, // 8: memos.api.v1.WorkspaceStorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceStorageSetting.S3Config
+	10, // 9: memos.api.v1.WorkspaceLifecycleSetting.rules:type_name -> memos.api.v1.LifecycleRule
+	1,  // 10: memos.api.v1.LifecycleRule.action:type_name -> memos.api.v1.LifecycleRule.Action
+	16, // 11: memos.api.v1.LifecycleRule.visibility:type_name -> memos.api.v1.Visibility
+	3,  // 12: memos.api.v1.SetWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
+	15, // 13: memos.api.v1.WorkspaceNotificationSetting.email:type_name -> memos.api.v1.WorkspaceNotificationSetting.EmailSetting
+	2,  // 14: memos.api.v1.WorkspaceNotificationSetting.EmailSetting.security:type_name -> memos.api.v1.WorkspaceNotificationSetting.EmailSetting.Security
+	11, // 15: memos.api.v1.WorkspaceSettingService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
+	12, // 16: memos.api.v1.WorkspaceSettingService.SetWorkspaceSetting:input_type -> memos.api.v1.SetWorkspaceSettingRequest
+	3,  // 17: memos.api.v1.WorkspaceSettingService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	3,  // 18: memos.api.v1.WorkspaceSettingService.SetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	17, // [17:19] is the sub-list for method output_type
+	15, // [15:17] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_setting_service_proto_init() }
@@ -947,19 +1448,22 @@ func file_api_v1_workspace_setting_service_proto_init() {
 	if File_api_v1_workspace_setting_service_proto != nil {
 		return
 	}
+	file_api_v1_memo_service_proto_init()
 	file_api_v1_workspace_setting_service_proto_msgTypes[0].OneofWrappers = []any{
 		(*WorkspaceSetting_GeneralSetting)(nil),
 		(*WorkspaceSetting_StorageSetting)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting)(nil),
 		(*WorkspaceSetting_AiModelSetting)(nil),
+		(*WorkspaceSetting_LifecycleSetting)(nil),
+		(*WorkspaceSetting_NotificationSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_setting_service_proto_rawDesc), len(file_api_v1_workspace_setting_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -73,16 +73,391 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemoId() int32 {
 	return 0
 }
 
+type ActivityMemoLifecyclePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the applied lifecycle rule.
+	RuleId    string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleTitle string               `protobuf:"bytes,2,opt,name=rule_title,json=ruleTitle,proto3" json:"rule_title,omitempty"`
+	Action    LifecycleRule_Action `protobuf:"varint,3,opt,name=action,proto3,enum=memos.store.LifecycleRule_Action" json:"action,omitempty"`
+	// The ids of the memos changed by the rule.
+	MemoIds       []int32 `protobuf:"varint,4,rep,packed,name=memo_ids,json=memoIds,proto3" json:"memo_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoLifecyclePayload) Reset() {
+	*x = ActivityMemoLifecyclePayload{}
+	mi := &file_store_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoLifecyclePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoLifecyclePayload) ProtoMessage() {}
+
+func (x *ActivityMemoLifecyclePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoLifecyclePayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoLifecyclePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityMemoLifecyclePayload) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ActivityMemoLifecyclePayload) GetRuleTitle() string {
+	if x != nil {
+		return x.RuleTitle
+	}
+	return ""
+}
+
+func (x *ActivityMemoLifecyclePayload) GetAction() LifecycleRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return LifecycleRule_ACTION_UNSPECIFIED
+}
+
+func (x *ActivityMemoLifecyclePayload) GetMemoIds() []int32 {
+	if x != nil {
+		return x.MemoIds
+	}
+	return nil
+}
+
+type ActivityMemoReminderPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityMemoMentionPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityMemoReactionPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	ReactionType  string                 `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReactionPayload) Reset() {
+	*x = ActivityMemoReactionPayload{}
+	mi := &file_store_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReactionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityMemoReactionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+type ActivityMemoReferencePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the memo referencing the related memo.
+	MemoId        int32 `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	RelatedMemoId int32 `protobuf:"varint,2,opt,name=related_memo_id,json=relatedMemoId,proto3" json:"related_memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReferencePayload) Reset() {
+	*x = ActivityMemoReferencePayload{}
+	mi := &file_store_activity_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReferencePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReferencePayload) ProtoMessage() {}
+
+func (x *ActivityMemoReferencePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReferencePayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReferencePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoReferencePayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoReferencePayload) GetRelatedMemoId() int32 {
+	if x != nil {
+		return x.RelatedMemoId
+	}
+	return 0
+}
+
+type ActivityShortcutMatchPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	ShortcutId    string                 `protobuf:"bytes,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	ShortcutTitle string                 `protobuf:"bytes,3,opt,name=shortcut_title,json=shortcutTitle,proto3" json:"shortcut_title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutMatchPayload) Reset() {
+	*x = ActivityShortcutMatchPayload{}
+	mi := &file_store_activity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutMatchPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutMatchPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutMatchPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutMatchPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutMatchPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityShortcutMatchPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutMatchPayload) GetShortcutId() string {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return ""
+}
+
+func (x *ActivityShortcutMatchPayload) GetShortcutTitle() string {
+	if x != nil {
+		return x.ShortcutTitle
+	}
+	return ""
+}
+
+type ActivityDigestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ids of the activities of the inbox messages rolled into the digest.
+	ActivityIds   []int32 `protobuf:"varint,1,rep,packed,name=activity_ids,json=activityIds,proto3" json:"activity_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityDigestPayload) Reset() {
+	*x = ActivityDigestPayload{}
+	mi := &file_store_activity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityDigestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDigestPayload) ProtoMessage() {}
+
+func (x *ActivityDigestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDigestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityDigestPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActivityDigestPayload) GetActivityIds() []int32 {
+	if x != nil {
+		return x.ActivityIds
+	}
+	return nil
+}
+
 type ActivityPayload struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	MemoComment   *ActivityMemoCommentPayload   `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	MemoLifecycle *ActivityMemoLifecyclePayload `protobuf:"bytes,2,opt,name=memo_lifecycle,json=memoLifecycle,proto3" json:"memo_lifecycle,omitempty"`
+	MemoReminder  *ActivityMemoReminderPayload  `protobuf:"bytes,3,opt,name=memo_reminder,json=memoReminder,proto3" json:"memo_reminder,omitempty"`
+	MemoMention   *ActivityMemoMentionPayload   `protobuf:"bytes,4,opt,name=memo_mention,json=memoMention,proto3" json:"memo_mention,omitempty"`
+	MemoReaction  *ActivityMemoReactionPayload  `protobuf:"bytes,5,opt,name=memo_reaction,json=memoReaction,proto3" json:"memo_reaction,omitempty"`
+	MemoReference *ActivityMemoReferencePayload `protobuf:"bytes,6,opt,name=memo_reference,json=memoReference,proto3" json:"memo_reference,omitempty"`
+	ShortcutMatch *ActivityShortcutMatchPayload `protobuf:"bytes,7,opt,name=shortcut_match,json=shortcutMatch,proto3" json:"shortcut_match,omitempty"`
+	Digest        *ActivityDigestPayload        `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActivityPayload) Reset() {
 	*x = ActivityPayload{}
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +469,7 @@ func (x *ActivityPayload) String() string {
 func (*ActivityPayload) ProtoMessage() {}
 
 func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +482,7 @@ func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityPayload.ProtoReflect.Descriptor instead.
 func (*ActivityPayload) Descriptor() ([]byte, []int) {
-	return file_store_activity_proto_rawDescGZIP(), []int{1}
+	return file_store_activity_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
@@ -117,16 +492,95 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoLifecycle() *ActivityMemoLifecyclePayload {
+	if x != nil {
+		return x.MemoLifecycle
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		return x.MemoReminder
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		return x.MemoMention
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReaction() *ActivityMemoReactionPayload {
+	if x != nil {
+		return x.MemoReaction
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReference() *ActivityMemoReferencePayload {
+	if x != nil {
+		return x.MemoReference
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetShortcutMatch() *ActivityShortcutMatchPayload {
+	if x != nil {
+		return x.ShortcutMatch
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetDigest() *ActivityDigestPayload {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
 var File_store_activity_proto protoreflect.FileDescriptor
 
 const file_store_activity_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/activity.proto\x12\vmemos.store\"]\n" +
+	"\x14store/activity.proto\x12\vmemos.store\x1a\x1dstore/workspace_setting.proto\"]\n" +
 	"\x1aActivityMemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
-	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"]\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"\xac\x01\n" +
+	"\x1cActivityMemoLifecyclePayload\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1d\n" +
+	"\n" +
+	"rule_title\x18\x02 \x01(\tR\truleTitle\x129\n" +
+	"\x06action\x18\x03 \x01(\x0e2!.memos.store.LifecycleRule.ActionR\x06action\x12\x19\n" +
+	"\bmemo_ids\x18\x04 \x03(\x05R\amemoIds\"6\n" +
+	"\x1bActivityMemoReminderPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"5\n" +
+	"\x1aActivityMemoMentionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"[\n" +
+	"\x1bActivityMemoReactionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\"_\n" +
+	"\x1cActivityMemoReferencePayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"\x7f\n" +
+	"\x1cActivityShortcutMatchPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\tR\n" +
+	"shortcutId\x12%\n" +
+	"\x0eshortcut_title\x18\x03 \x01(\tR\rshortcutTitle\":\n" +
+	"\x15ActivityDigestPayload\x12!\n" +
+	"\factivity_ids\x18\x01 \x03(\x05R\vactivityIds\"\xf9\x04\n" +
 	"\x0fActivityPayload\x12J\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoCommentB\x98\x01\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoComment\x12P\n" +
+	"\x0ememo_lifecycle\x18\x02 \x01(\v2).memos.store.ActivityMemoLifecyclePayloadR\rmemoLifecycle\x12M\n" +
+	"\rmemo_reminder\x18\x03 \x01(\v2(.memos.store.ActivityMemoReminderPayloadR\fmemoReminder\x12J\n" +
+	"\fmemo_mention\x18\x04 \x01(\v2'.memos.store.ActivityMemoMentionPayloadR\vmemoMention\x12M\n" +
+	"\rmemo_reaction\x18\x05 \x01(\v2(.memos.store.ActivityMemoReactionPayloadR\fmemoReaction\x12P\n" +
+	"\x0ememo_reference\x18\x06 \x01(\v2).memos.store.ActivityMemoReferencePayloadR\rmemoReference\x12P\n" +
+	"\x0eshortcut_match\x18\a \x01(\v2).memos.store.ActivityShortcutMatchPayloadR\rshortcutMatch\x12:\n" +
+	"\x06digest\x18\b \x01(\v2\".memos.store.ActivityDigestPayloadR\x06digestB\x98\x01\n" +
 	"\x0fcom.memos.storeB\rActivityProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -141,18 +595,34 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityMemoCommentPayload)(nil), // 0: memos.store.ActivityMemoCommentPayload
-	(*ActivityPayload)(nil),            // 1: memos.store.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),   // 0: memos.store.ActivityMemoCommentPayload
+	(*ActivityMemoLifecyclePayload)(nil), // 1: memos.store.ActivityMemoLifecyclePayload
+	(*ActivityMemoReminderPayload)(nil),  // 2: memos.store.ActivityMemoReminderPayload
+	(*ActivityMemoMentionPayload)(nil),   // 3: memos.store.ActivityMemoMentionPayload
+	(*ActivityMemoReactionPayload)(nil),  // 4: memos.store.ActivityMemoReactionPayload
+	(*ActivityMemoReferencePayload)(nil), // 5: memos.store.ActivityMemoReferencePayload
+	(*ActivityShortcutMatchPayload)(nil), // 6: memos.store.ActivityShortcutMatchPayload
+	(*ActivityDigestPayload)(nil),        // 7: memos.store.ActivityDigestPayload
+	(*ActivityPayload)(nil),              // 8: memos.store.ActivityPayload
+	(LifecycleRule_Action)(0),            // 9: memos.store.LifecycleRule.Action
 }
 var file_store_activity_proto_depIdxs = []int32{
-	0, // 0: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9, // 0: memos.store.ActivityMemoLifecyclePayload.action:type_name -> memos.store.LifecycleRule.Action
+	0, // 1: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
+	1, // 2: memos.store.ActivityPayload.memo_lifecycle:type_name -> memos.store.ActivityMemoLifecyclePayload
+	2, // 3: memos.store.ActivityPayload.memo_reminder:type_name -> memos.store.ActivityMemoReminderPayload
+	3, // 4: memos.store.ActivityPayload.memo_mention:type_name -> memos.store.ActivityMemoMentionPayload
+	4, // 5: memos.store.ActivityPayload.memo_reaction:type_name -> memos.store.ActivityMemoReactionPayload
+	5, // 6: memos.store.ActivityPayload.memo_reference:type_name -> memos.store.ActivityMemoReferencePayload
+	6, // 7: memos.store.ActivityPayload.shortcut_match:type_name -> memos.store.ActivityShortcutMatchPayload
+	7, // 8: memos.store.ActivityPayload.digest:type_name -> memos.store.ActivityDigestPayload
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -160,13 +630,14 @@ func file_store_activity_proto_init() {
 	if File_store_activity_proto != nil {
 		return
 	}
+	file_store_workspace_setting_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
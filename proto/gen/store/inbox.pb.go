@@ -27,6 +27,13 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	InboxMessage_MEMO_COMMENT     InboxMessage_Type = 1
 	InboxMessage_VERSION_UPDATE   InboxMessage_Type = 2
+	InboxMessage_MEMO_LIFECYCLE   InboxMessage_Type = 3
+	InboxMessage_REMINDER         InboxMessage_Type = 4
+	InboxMessage_MEMO_MENTION     InboxMessage_Type = 5
+	InboxMessage_MEMO_REACTION    InboxMessage_Type = 6
+	InboxMessage_MEMO_REFERENCE   InboxMessage_Type = 7
+	InboxMessage_SHORTCUT_MATCH   InboxMessage_Type = 8
+	InboxMessage_DIGEST           InboxMessage_Type = 9
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -35,11 +42,25 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_LIFECYCLE",
+		4: "REMINDER",
+		5: "MEMO_MENTION",
+		6: "MEMO_REACTION",
+		7: "MEMO_REFERENCE",
+		8: "SHORTCUT_MATCH",
+		9: "DIGEST",
 	}
 	InboxMessage_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
 		"VERSION_UPDATE":   2,
+		"MEMO_LIFECYCLE":   3,
+		"REMINDER":         4,
+		"MEMO_MENTION":     5,
+		"MEMO_REACTION":    6,
+		"MEMO_REFERENCE":   7,
+		"SHORTCUT_MATCH":   8,
+		"DIGEST":           9,
 	}
 )
 
@@ -71,9 +92,13 @@ func (InboxMessage_Type) EnumDescriptor() ([]byte, []int) {
 }
 
 type InboxMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
-	ActivityId    *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Type       InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
+	ActivityId *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// emailed_ts is the time the message was sent by email, it's 0 if the message is not sent yet.
+	EmailedTs int64 `protobuf:"varint,3,opt,name=emailed_ts,json=emailedTs,proto3" json:"emailed_ts,omitempty"`
+	// pushed_ts is the time the message was pushed to the browsers, it's 0 if the message is not pushed yet.
+	PushedTs      int64 `protobuf:"varint,4,opt,name=pushed_ts,json=pushedTs,proto3" json:"pushed_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,19 +147,44 @@ func (x *InboxMessage) GetActivityId() int32 {
 	return 0
 }
 
+func (x *InboxMessage) GetEmailedTs() int64 {
+	if x != nil {
+		return x.EmailedTs
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetPushedTs() int64 {
+	if x != nil {
+		return x.PushedTs
+	}
+	return 0
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xbc\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xf4\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\"B\n" +
+	"activityId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"emailed_ts\x18\x03 \x01(\x03R\temailedTs\x12\x1b\n" +
+	"\tpushed_ts\x18\x04 \x01(\x03R\bpushedTs\"\xbd\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02B\x0e\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x12\n" +
+	"\x0eMEMO_LIFECYCLE\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x05\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x06\x12\x12\n" +
+	"\x0eMEMO_REFERENCE\x10\a\x12\x12\n" +
+	"\x0eSHORTCUT_MATCH\x10\b\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\tB\x0e\n" +
 	"\f_activity_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,10 +23,19 @@ const (
 )
 
 type MemoPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
-	Location      *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Property *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Location *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The time when the memo is scheduled to be published.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// The visibility applied to the memo at publish_time, e.g. "PUBLIC".
+	TargetVisibility string                  `protobuf:"bytes,5,opt,name=target_visibility,json=targetVisibility,proto3" json:"target_visibility,omitempty"`
+	Reminders        []*MemoPayload_Reminder `protobuf:"bytes,6,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	// The calendar day of a daily memo, e.g. "2025-01-02".
+	DailyDate string `protobuf:"bytes,7,opt,name=daily_date,json=dailyDate,proto3" json:"daily_date,omitempty"`
+	// The task list items of the memo in document order, indexed for listing the tasks across memos.
+	Tasks         []*MemoPayload_Task `protobuf:"bytes,8,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +91,41 @@ func (x *MemoPayload) GetTags() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetTargetVisibility() string {
+	if x != nil {
+		return x.TargetVisibility
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetReminders() []*MemoPayload_Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetDailyDate() string {
+	if x != nil {
+		return x.DailyDate
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetTasks() []*MemoPayload_Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
 // The calculated properties from the memo content.
 type MemoPayload_Property struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -89,7 +134,9 @@ type MemoPayload_Property struct {
 	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
 	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
 	// The references of the memo. Should be a list of uuid.
-	References    []string `protobuf:"bytes,5,rep,name=references,proto3" json:"references,omitempty"`
+	References []string `protobuf:"bytes,5,rep,name=references,proto3" json:"references,omitempty"`
+	// The usernames mentioned in the memo with `@username`.
+	Mentions      []string `protobuf:"bytes,6,rep,name=mentions,proto3" json:"mentions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -159,6 +206,13 @@ func (x *MemoPayload_Property) GetReferences() []string {
 	return nil
 }
 
+func (x *MemoPayload_Property) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
 type MemoPayload_Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -219,15 +273,153 @@ func (x *MemoPayload_Location) GetLongitude() float64 {
 	return 0
 }
 
+type MemoPayload_Task struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Content  string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Complete bool                   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	// The due date of the task from `@due(2006-01-02)` in its content, e.g. "2006-01-02".
+	DueDate       string   `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Task) Reset() {
+	*x = MemoPayload_Task{}
+	mi := &file_store_memo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Task) ProtoMessage() {}
+
+func (x *MemoPayload_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Task.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Task) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MemoPayload_Task) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoPayload_Task) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *MemoPayload_Task) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *MemoPayload_Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MemoPayload_Reminder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of a one-off reminder, or the start of a recurring reminder.
+	RemindTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
+	// The cron expression of a recurring reminder, e.g. "0 9 * * MON".
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	// The time when the reminder was last delivered.
+	LastRemindTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_remind_time,json=lastRemindTime,proto3" json:"last_remind_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Reminder) Reset() {
+	*x = MemoPayload_Reminder{}
+	mi := &file_store_memo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Reminder) ProtoMessage() {}
+
+func (x *MemoPayload_Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Reminder.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Reminder) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *MemoPayload_Reminder) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
+func (x *MemoPayload_Reminder) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *MemoPayload_Reminder) GetLastRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRemindTime
+	}
+	return nil
+}
+
 var File_store_memo_proto protoreflect.FileDescriptor
 
 const file_store_memo_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xc0\x03\n" +
+	"\x10store/memo.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\a\n" +
 	"\vMemoPayload\x12=\n" +
 	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
 	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a\xb6\x01\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12=\n" +
+	"\fpublish_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTime\x12+\n" +
+	"\x11target_visibility\x18\x05 \x01(\tR\x10targetVisibility\x12?\n" +
+	"\treminders\x18\x06 \x03(\v2!.memos.store.MemoPayload.ReminderR\treminders\x12\x1d\n" +
+	"\n" +
+	"daily_date\x18\a \x01(\tR\tdailyDate\x123\n" +
+	"\x05tasks\x18\b \x03(\v2\x1d.memos.store.MemoPayload.TaskR\x05tasks\x1a\xd2\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
@@ -235,11 +427,22 @@ const file_store_memo_proto_rawDesc = "" +
 	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12\x1e\n" +
 	"\n" +
 	"references\x18\x05 \x03(\tR\n" +
-	"references\x1af\n" +
+	"references\x12\x1a\n" +
+	"\bmentions\x18\x06 \x03(\tR\bmentions\x1af\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x03 \x01(\x01R\tlongitudeB\x94\x01\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x1ak\n" +
+	"\x04Task\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1a\n" +
+	"\bcomplete\x18\x02 \x01(\bR\bcomplete\x12\x19\n" +
+	"\bdue_date\x18\x03 \x01(\tR\adueDate\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x1a\xa1\x01\n" +
+	"\bReminder\x12;\n" +
+	"\vremind_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"remindTime\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12D\n" +
+	"\x10last_remind_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastRemindTimeB\x94\x01\n" +
 	"\x0fcom.memos.storeB\tMemoProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -254,20 +457,28 @@ func file_store_memo_proto_rawDescGZIP() []byte {
 	return file_store_memo_proto_rawDescData
 }
 
-var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_memo_proto_goTypes = []any{
-	(*MemoPayload)(nil),          // 0: memos.store.MemoPayload
-	(*MemoPayload_Property)(nil), // 1: memos.store.MemoPayload.Property
-	(*MemoPayload_Location)(nil), // 2: memos.store.MemoPayload.Location
+	(*MemoPayload)(nil),           // 0: memos.store.MemoPayload
+	(*MemoPayload_Property)(nil),  // 1: memos.store.MemoPayload.Property
+	(*MemoPayload_Location)(nil),  // 2: memos.store.MemoPayload.Location
+	(*MemoPayload_Task)(nil),      // 3: memos.store.MemoPayload.Task
+	(*MemoPayload_Reminder)(nil),  // 4: memos.store.MemoPayload.Reminder
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_store_memo_proto_depIdxs = []int32{
 	1, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
 	2, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: memos.store.MemoPayload.publish_time:type_name -> google.protobuf.Timestamp
+	4, // 3: memos.store.MemoPayload.reminders:type_name -> memos.store.MemoPayload.Reminder
+	3, // 4: memos.store.MemoPayload.tasks:type_name -> memos.store.MemoPayload.Task
+	5, // 5: memos.store.MemoPayload.Reminder.remind_time:type_name -> google.protobuf.Timestamp
+	5, // 6: memos.store.MemoPayload.Reminder.last_remind_time:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_store_memo_proto_init() }
@@ -281,7 +492,7 @@ func file_store_memo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_memo_proto_rawDesc), len(file_store_memo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
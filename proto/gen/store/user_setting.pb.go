@@ -35,17 +35,32 @@ const (
 	UserSettingKey_MEMO_VISIBILITY UserSettingKey = 4
 	// The shortcuts of the user.
 	UserSettingKey_SHORTCUTS UserSettingKey = 5
+	// The memo templates of the user.
+	UserSettingKey_TEMPLATES UserSettingKey = 6
+	// The memo lifecycle rules of the user.
+	UserSettingKey_LIFECYCLE_RULES UserSettingKey = 7
+	// The notification preferences of the user.
+	UserSettingKey_NOTIFICATIONS UserSettingKey = 8
+	// The Web Push subscriptions of the user.
+	UserSettingKey_PUSH_SUBSCRIPTIONS UserSettingKey = 9
+	// The notification delivery state of the user, it's internal and not exposed in the API.
+	UserSettingKey_NOTIFICATION_STATE UserSettingKey = 10
 )
 
 // Enum value maps for UserSettingKey.
 var (
 	UserSettingKey_name = map[int32]string{
-		0: "USER_SETTING_KEY_UNSPECIFIED",
-		1: "ACCESS_TOKENS",
-		2: "LOCALE",
-		3: "APPEARANCE",
-		4: "MEMO_VISIBILITY",
-		5: "SHORTCUTS",
+		0:  "USER_SETTING_KEY_UNSPECIFIED",
+		1:  "ACCESS_TOKENS",
+		2:  "LOCALE",
+		3:  "APPEARANCE",
+		4:  "MEMO_VISIBILITY",
+		5:  "SHORTCUTS",
+		6:  "TEMPLATES",
+		7:  "LIFECYCLE_RULES",
+		8:  "NOTIFICATIONS",
+		9:  "PUSH_SUBSCRIPTIONS",
+		10: "NOTIFICATION_STATE",
 	}
 	UserSettingKey_value = map[string]int32{
 		"USER_SETTING_KEY_UNSPECIFIED": 0,
@@ -54,6 +69,11 @@ var (
 		"APPEARANCE":                   3,
 		"MEMO_VISIBILITY":              4,
 		"SHORTCUTS":                    5,
+		"TEMPLATES":                    6,
+		"LIFECYCLE_RULES":              7,
+		"NOTIFICATIONS":                8,
+		"PUSH_SUBSCRIPTIONS":           9,
+		"NOTIFICATION_STATE":           10,
 	}
 )
 
@@ -95,6 +115,11 @@ type UserSetting struct {
 	//	*UserSetting_Appearance
 	//	*UserSetting_MemoVisibility
 	//	*UserSetting_Shortcuts
+	//	*UserSetting_Templates
+	//	*UserSetting_LifecycleRules
+	//	*UserSetting_Notifications
+	//	*UserSetting_PushSubscriptions
+	//	*UserSetting_NotificationState
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -196,6 +221,51 @@ func (x *UserSetting) GetShortcuts() *ShortcutsUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetTemplates() *TemplatesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Templates); ok {
+			return x.Templates
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetLifecycleRules() *LifecycleRulesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_LifecycleRules); ok {
+			return x.LifecycleRules
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNotifications() *NotificationsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Notifications); ok {
+			return x.Notifications
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetPushSubscriptions() *PushSubscriptionsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_PushSubscriptions); ok {
+			return x.PushSubscriptions
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNotificationState() *NotificationStateUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_NotificationState); ok {
+			return x.NotificationState
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -220,6 +290,26 @@ type UserSetting_Shortcuts struct {
 	Shortcuts *ShortcutsUserSetting `protobuf:"bytes,7,opt,name=shortcuts,proto3,oneof"`
 }
 
+type UserSetting_Templates struct {
+	Templates *TemplatesUserSetting `protobuf:"bytes,8,opt,name=templates,proto3,oneof"`
+}
+
+type UserSetting_LifecycleRules struct {
+	LifecycleRules *LifecycleRulesUserSetting `protobuf:"bytes,9,opt,name=lifecycle_rules,json=lifecycleRules,proto3,oneof"`
+}
+
+type UserSetting_Notifications struct {
+	Notifications *NotificationsUserSetting `protobuf:"bytes,10,opt,name=notifications,proto3,oneof"`
+}
+
+type UserSetting_PushSubscriptions struct {
+	PushSubscriptions *PushSubscriptionsUserSetting `protobuf:"bytes,11,opt,name=push_subscriptions,json=pushSubscriptions,proto3,oneof"`
+}
+
+type UserSetting_NotificationState struct {
+	NotificationState *NotificationStateUserSetting `protobuf:"bytes,12,opt,name=notification_state,json=notificationState,proto3,oneof"`
+}
+
 func (*UserSetting_AccessTokens) isUserSetting_Value() {}
 
 func (*UserSetting_Locale) isUserSetting_Value() {}
@@ -230,6 +320,16 @@ func (*UserSetting_MemoVisibility) isUserSetting_Value() {}
 
 func (*UserSetting_Shortcuts) isUserSetting_Value() {}
 
+func (*UserSetting_Templates) isUserSetting_Value() {}
+
+func (*UserSetting_LifecycleRules) isUserSetting_Value() {}
+
+func (*UserSetting_Notifications) isUserSetting_Value() {}
+
+func (*UserSetting_PushSubscriptions) isUserSetting_Value() {}
+
+func (*UserSetting_NotificationState) isUserSetting_Value() {}
+
 type AccessTokensUserSetting struct {
 	state         protoimpl.MessageState                 `protogen:"open.v1"`
 	AccessTokens  []*AccessTokensUserSetting_AccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
@@ -318,6 +418,258 @@ func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
 	return nil
 }
 
+type TemplatesUserSetting struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Templates     []*TemplatesUserSetting_Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatesUserSetting) Reset() {
+	*x = TemplatesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesUserSetting) ProtoMessage() {}
+
+func (x *TemplatesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesUserSetting.ProtoReflect.Descriptor instead.
+func (*TemplatesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TemplatesUserSetting) GetTemplates() []*TemplatesUserSetting_Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type LifecycleRulesUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rules are applied to the memos of the user.
+	Rules         []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifecycleRulesUserSetting) Reset() {
+	*x = LifecycleRulesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleRulesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleRulesUserSetting) ProtoMessage() {}
+
+func (x *LifecycleRulesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleRulesUserSetting.ProtoReflect.Descriptor instead.
+func (*LifecycleRulesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LifecycleRulesUserSetting) GetRules() []*LifecycleRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type NotificationsUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The inbox message types not to be sent to the user.
+	MutedTypes []InboxMessage_Type `protobuf:"varint,1,rep,packed,name=muted_types,json=mutedTypes,proto3,enum=memos.store.InboxMessage_Type" json:"muted_types,omitempty"`
+	// The ids of the shortcuts to alert the user about the new matching memos.
+	AlertShortcutIds []string `protobuf:"bytes,2,rep,name=alert_shortcut_ids,json=alertShortcutIds,proto3" json:"alert_shortcut_ids,omitempty"`
+	// daily_digest rolls the unread inbox messages into a single message every day.
+	DailyDigest bool `protobuf:"varint,3,opt,name=daily_digest,json=dailyDigest,proto3" json:"daily_digest,omitempty"`
+	// email sends the inbox messages to the email of the user as well.
+	// With daily_digest, only the digests are sent by email.
+	Email         bool `protobuf:"varint,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsUserSetting) Reset() {
+	*x = NotificationsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsUserSetting) ProtoMessage() {}
+
+func (x *NotificationsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NotificationsUserSetting) GetMutedTypes() []InboxMessage_Type {
+	if x != nil {
+		return x.MutedTypes
+	}
+	return nil
+}
+
+func (x *NotificationsUserSetting) GetAlertShortcutIds() []string {
+	if x != nil {
+		return x.AlertShortcutIds
+	}
+	return nil
+}
+
+func (x *NotificationsUserSetting) GetDailyDigest() bool {
+	if x != nil {
+		return x.DailyDigest
+	}
+	return false
+}
+
+func (x *NotificationsUserSetting) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+type PushSubscriptionsUserSetting struct {
+	state         protoimpl.MessageState                           `protogen:"open.v1"`
+	Subscriptions []*PushSubscriptionsUserSetting_PushSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushSubscriptionsUserSetting) Reset() {
+	*x = PushSubscriptionsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushSubscriptionsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSubscriptionsUserSetting) ProtoMessage() {}
+
+func (x *PushSubscriptionsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSubscriptionsUserSetting.ProtoReflect.Descriptor instead.
+func (*PushSubscriptionsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PushSubscriptionsUserSetting) GetSubscriptions() []*PushSubscriptionsUserSetting_PushSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type NotificationStateUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// alert_updated_ts is the update time of the memos last checked against the alerting shortcuts,
+	// the memos updated since then are checked.
+	AlertUpdatedTs int64 `protobuf:"varint,1,opt,name=alert_updated_ts,json=alertUpdatedTs,proto3" json:"alert_updated_ts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationStateUserSetting) Reset() {
+	*x = NotificationStateUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationStateUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationStateUserSetting) ProtoMessage() {}
+
+func (x *NotificationStateUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationStateUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationStateUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NotificationStateUserSetting) GetAlertUpdatedTs() int64 {
+	if x != nil {
+		return x.AlertUpdatedTs
+	}
+	return 0
+}
+
 type AccessTokensUserSetting_AccessToken struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The access token is a JWT token.
@@ -331,7 +683,7 @@ type AccessTokensUserSetting_AccessToken struct {
 
 func (x *AccessTokensUserSetting_AccessToken) Reset() {
 	*x = AccessTokensUserSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +695,7 @@ func (x *AccessTokensUserSetting_AccessToken) String() string {
 func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
 
 func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +736,7 @@ type ShortcutsUserSetting_Shortcut struct {
 
 func (x *ShortcutsUserSetting_Shortcut) Reset() {
 	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +748,7 @@ func (x *ShortcutsUserSetting_Shortcut) String() string {
 func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,11 +785,160 @@ func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
 	return ""
 }
 
+type TemplatesUserSetting_Template struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memos created from the template, e.g. "PRIVATE".
+	Visibility    string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatesUserSetting_Template) Reset() {
+	*x = TemplatesUserSetting_Template{}
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatesUserSetting_Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesUserSetting_Template) ProtoMessage() {}
+
+func (x *TemplatesUserSetting_Template) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesUserSetting_Template.ProtoReflect.Descriptor instead.
+func (*TemplatesUserSetting_Template) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *TemplatesUserSetting_Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type PushSubscriptionsUserSetting_PushSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The push service URL of the subscription.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The P-256 public key of the browser, base64url encoded.
+	P256Dh string `protobuf:"bytes,3,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	// The authentication secret of the browser, base64url encoded.
+	Auth          string `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	CreatedTs     int64  `protobuf:"varint,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) Reset() {
+	*x = PushSubscriptionsUserSetting_PushSubscription{}
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSubscriptionsUserSetting_PushSubscription) ProtoMessage() {}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSubscriptionsUserSetting_PushSubscription.ProtoReflect.Descriptor instead.
+func (*PushSubscriptionsUserSetting_PushSubscription) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\"\xd5\x02\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x11store/inbox.proto\x1a\x1dstore/workspace_setting.proto\"\xf2\x05\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12-\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1b.memos.store.UserSettingKeyR\x03key\x12K\n" +
@@ -447,7 +948,13 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"appearance\x18\x05 \x01(\tH\x00R\n" +
 	"appearance\x12)\n" +
 	"\x0fmemo_visibility\x18\x06 \x01(\tH\x00R\x0ememoVisibility\x12A\n" +
-	"\tshortcuts\x18\a \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcutsB\a\n" +
+	"\tshortcuts\x18\a \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12A\n" +
+	"\ttemplates\x18\b \x01(\v2!.memos.store.TemplatesUserSettingH\x00R\ttemplates\x12Q\n" +
+	"\x0flifecycle_rules\x18\t \x01(\v2&.memos.store.LifecycleRulesUserSettingH\x00R\x0elifecycleRules\x12M\n" +
+	"\rnotifications\x18\n" +
+	" \x01(\v2%.memos.store.NotificationsUserSettingH\x00R\rnotifications\x12Z\n" +
+	"\x12push_subscriptions\x18\v \x01(\v2).memos.store.PushSubscriptionsUserSettingH\x00R\x11pushSubscriptions\x12Z\n" +
+	"\x12notification_state\x18\f \x01(\v2).memos.store.NotificationStateUserSettingH\x00R\x11notificationStateB\a\n" +
 	"\x05value\"\xc4\x01\n" +
 	"\x17AccessTokensUserSetting\x12U\n" +
 	"\raccess_tokens\x18\x01 \x03(\v20.memos.store.AccessTokensUserSetting.AccessTokenR\faccessTokens\x1aR\n" +
@@ -459,7 +966,35 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter*\x85\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\xcc\x01\n" +
+	"\x14TemplatesUserSetting\x12H\n" +
+	"\ttemplates\x18\x01 \x03(\v2*.memos.store.TemplatesUserSetting.TemplateR\ttemplates\x1aj\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\"M\n" +
+	"\x19LifecycleRulesUserSetting\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.memos.store.LifecycleRuleR\x05rules\"\xc2\x01\n" +
+	"\x18NotificationsUserSetting\x12?\n" +
+	"\vmuted_types\x18\x01 \x03(\x0e2\x1e.memos.store.InboxMessage.TypeR\n" +
+	"mutedTypes\x12,\n" +
+	"\x12alert_shortcut_ids\x18\x02 \x03(\tR\x10alertShortcutIds\x12!\n" +
+	"\fdaily_digest\x18\x03 \x01(\bR\vdailyDigest\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\bR\x05email\"\x8c\x02\n" +
+	"\x1cPushSubscriptionsUserSetting\x12`\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2:.memos.store.PushSubscriptionsUserSetting.PushSubscriptionR\rsubscriptions\x1a\x89\x01\n" +
+	"\x10PushSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06p256dh\x18\x03 \x01(\tR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x04 \x01(\tR\x04auth\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x05 \x01(\x03R\tcreatedTs\"H\n" +
+	"\x1cNotificationStateUserSetting\x12(\n" +
+	"\x10alert_updated_ts\x18\x01 \x01(\x03R\x0ealertUpdatedTs*\xec\x01\n" +
 	"\x0eUserSettingKey\x12 \n" +
 	"\x1cUSER_SETTING_KEY_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x01\x12\n" +
@@ -468,7 +1003,13 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
 	"APPEARANCE\x10\x03\x12\x13\n" +
 	"\x0fMEMO_VISIBILITY\x10\x04\x12\r\n" +
-	"\tSHORTCUTS\x10\x05B\x9b\x01\n" +
+	"\tSHORTCUTS\x10\x05\x12\r\n" +
+	"\tTEMPLATES\x10\x06\x12\x13\n" +
+	"\x0fLIFECYCLE_RULES\x10\a\x12\x11\n" +
+	"\rNOTIFICATIONS\x10\b\x12\x16\n" +
+	"\x12PUSH_SUBSCRIPTIONS\x10\t\x12\x16\n" +
+	"\x12NOTIFICATION_STATE\x10\n" +
+	"B\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -484,26 +1025,44 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_store_user_setting_proto_goTypes = []any{
-	(UserSettingKey)(0),                         // 0: memos.store.UserSettingKey
-	(*UserSetting)(nil),                         // 1: memos.store.UserSetting
-	(*AccessTokensUserSetting)(nil),             // 2: memos.store.AccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                // 3: memos.store.ShortcutsUserSetting
-	(*AccessTokensUserSetting_AccessToken)(nil), // 4: memos.store.AccessTokensUserSetting.AccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),       // 5: memos.store.ShortcutsUserSetting.Shortcut
+	(UserSettingKey)(0),                                   // 0: memos.store.UserSettingKey
+	(*UserSetting)(nil),                                   // 1: memos.store.UserSetting
+	(*AccessTokensUserSetting)(nil),                       // 2: memos.store.AccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                          // 3: memos.store.ShortcutsUserSetting
+	(*TemplatesUserSetting)(nil),                          // 4: memos.store.TemplatesUserSetting
+	(*LifecycleRulesUserSetting)(nil),                     // 5: memos.store.LifecycleRulesUserSetting
+	(*NotificationsUserSetting)(nil),                      // 6: memos.store.NotificationsUserSetting
+	(*PushSubscriptionsUserSetting)(nil),                  // 7: memos.store.PushSubscriptionsUserSetting
+	(*NotificationStateUserSetting)(nil),                  // 8: memos.store.NotificationStateUserSetting
+	(*AccessTokensUserSetting_AccessToken)(nil),           // 9: memos.store.AccessTokensUserSetting.AccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),                 // 10: memos.store.ShortcutsUserSetting.Shortcut
+	(*TemplatesUserSetting_Template)(nil),                 // 11: memos.store.TemplatesUserSetting.Template
+	(*PushSubscriptionsUserSetting_PushSubscription)(nil), // 12: memos.store.PushSubscriptionsUserSetting.PushSubscription
+	(*LifecycleRule)(nil),                                 // 13: memos.store.LifecycleRule
+	(InboxMessage_Type)(0),                                // 14: memos.store.InboxMessage.Type
 }
 var file_store_user_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSettingKey
-	2, // 1: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
-	3, // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	4, // 3: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
-	5, // 4: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSettingKey
+	2,  // 1: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
+	3,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	4,  // 3: memos.store.UserSetting.templates:type_name -> memos.store.TemplatesUserSetting
+	5,  // 4: memos.store.UserSetting.lifecycle_rules:type_name -> memos.store.LifecycleRulesUserSetting
+	6,  // 5: memos.store.UserSetting.notifications:type_name -> memos.store.NotificationsUserSetting
+	7,  // 6: memos.store.UserSetting.push_subscriptions:type_name -> memos.store.PushSubscriptionsUserSetting
+	8,  // 7: memos.store.UserSetting.notification_state:type_name -> memos.store.NotificationStateUserSetting
+	9,  // 8: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
+	10, // 9: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	11, // 10: memos.store.TemplatesUserSetting.templates:type_name -> memos.store.TemplatesUserSetting.Template
+	13, // 11: memos.store.LifecycleRulesUserSetting.rules:type_name -> memos.store.LifecycleRule
+	14, // 12: memos.store.NotificationsUserSetting.muted_types:type_name -> memos.store.InboxMessage.Type
+	12, // 13: memos.store.PushSubscriptionsUserSetting.subscriptions:type_name -> memos.store.PushSubscriptionsUserSetting.PushSubscription
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -511,12 +1070,19 @@ func file_store_user_setting_proto_init() {
 	if File_store_user_setting_proto != nil {
 		return
 	}
+	file_store_inbox_proto_init()
+	file_store_workspace_setting_proto_init()
 	file_store_user_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*UserSetting_AccessTokens)(nil),
 		(*UserSetting_Locale)(nil),
 		(*UserSetting_Appearance)(nil),
 		(*UserSetting_MemoVisibility)(nil),
 		(*UserSetting_Shortcuts)(nil),
+		(*UserSetting_Templates)(nil),
+		(*UserSetting_LifecycleRules)(nil),
+		(*UserSetting_Notifications)(nil),
+		(*UserSetting_PushSubscriptions)(nil),
+		(*UserSetting_NotificationState)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -524,7 +1090,7 @@ func file_store_user_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
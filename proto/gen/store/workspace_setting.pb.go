@@ -35,6 +35,10 @@ const (
 	WorkspaceSettingKey_MEMO_RELATED WorkspaceSettingKey = 4
 	// AI_MODEL is the key for AI model settings.
 	WorkspaceSettingKey_AI_MODEL WorkspaceSettingKey = 5
+	// LIFECYCLE is the key for memo lifecycle settings.
+	WorkspaceSettingKey_LIFECYCLE WorkspaceSettingKey = 6
+	// NOTIFICATION is the key for notification settings.
+	WorkspaceSettingKey_NOTIFICATION WorkspaceSettingKey = 7
 )
 
 // Enum value maps for WorkspaceSettingKey.
@@ -46,6 +50,8 @@ var (
 		3: "STORAGE",
 		4: "MEMO_RELATED",
 		5: "AI_MODEL",
+		6: "LIFECYCLE",
+		7: "NOTIFICATION",
 	}
 	WorkspaceSettingKey_value = map[string]int32{
 		"WORKSPACE_SETTING_KEY_UNSPECIFIED": 0,
@@ -54,6 +60,8 @@ var (
 		"STORAGE":                           3,
 		"MEMO_RELATED":                      4,
 		"AI_MODEL":                          5,
+		"LIFECYCLE":                         6,
+		"NOTIFICATION":                      7,
 	}
 )
 
@@ -139,6 +147,120 @@ func (WorkspaceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type LifecycleRule_Action int32
+
+const (
+	LifecycleRule_ACTION_UNSPECIFIED LifecycleRule_Action = 0
+	// ARCHIVE archives the memos.
+	LifecycleRule_ARCHIVE LifecycleRule_Action = 1
+	// CHANGE_VISIBILITY changes the visibility of the memos.
+	LifecycleRule_CHANGE_VISIBILITY LifecycleRule_Action = 2
+	// ADD_TAG adds a tag to the memos.
+	LifecycleRule_ADD_TAG LifecycleRule_Action = 3
+	// DELETE moves the memos to the trash.
+	LifecycleRule_DELETE LifecycleRule_Action = 4
+)
+
+// Enum value maps for LifecycleRule_Action.
+var (
+	LifecycleRule_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ARCHIVE",
+		2: "CHANGE_VISIBILITY",
+		3: "ADD_TAG",
+		4: "DELETE",
+	}
+	LifecycleRule_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ARCHIVE":            1,
+		"CHANGE_VISIBILITY":  2,
+		"ADD_TAG":            3,
+		"DELETE":             4,
+	}
+)
+
+func (x LifecycleRule_Action) Enum() *LifecycleRule_Action {
+	p := new(LifecycleRule_Action)
+	*p = x
+	return p
+}
+
+func (x LifecycleRule_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LifecycleRule_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (LifecycleRule_Action) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[2]
+}
+
+func (x LifecycleRule_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LifecycleRule_Action.Descriptor instead.
+func (LifecycleRule_Action) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type WorkspaceNotificationSetting_EmailSetting_Security int32
+
+const (
+	WorkspaceNotificationSetting_EmailSetting_SECURITY_UNSPECIFIED WorkspaceNotificationSetting_EmailSetting_Security = 0
+	// NONE sends the emails over a plain connection.
+	WorkspaceNotificationSetting_EmailSetting_NONE WorkspaceNotificationSetting_EmailSetting_Security = 1
+	// STARTTLS upgrades the plain connection with the STARTTLS command.
+	WorkspaceNotificationSetting_EmailSetting_STARTTLS WorkspaceNotificationSetting_EmailSetting_Security = 2
+	// TLS connects with implicit TLS, usually on port 465.
+	WorkspaceNotificationSetting_EmailSetting_TLS WorkspaceNotificationSetting_EmailSetting_Security = 3
+)
+
+// Enum value maps for WorkspaceNotificationSetting_EmailSetting_Security.
+var (
+	WorkspaceNotificationSetting_EmailSetting_Security_name = map[int32]string{
+		0: "SECURITY_UNSPECIFIED",
+		1: "NONE",
+		2: "STARTTLS",
+		3: "TLS",
+	}
+	WorkspaceNotificationSetting_EmailSetting_Security_value = map[string]int32{
+		"SECURITY_UNSPECIFIED": 0,
+		"NONE":                 1,
+		"STARTTLS":             2,
+		"TLS":                  3,
+	}
+)
+
+func (x WorkspaceNotificationSetting_EmailSetting_Security) Enum() *WorkspaceNotificationSetting_EmailSetting_Security {
+	p := new(WorkspaceNotificationSetting_EmailSetting_Security)
+	*p = x
+	return p
+}
+
+func (x WorkspaceNotificationSetting_EmailSetting_Security) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceNotificationSetting_EmailSetting_Security) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[3].Descriptor()
+}
+
+func (WorkspaceNotificationSetting_EmailSetting_Security) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[3]
+}
+
+func (x WorkspaceNotificationSetting_EmailSetting_Security) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceNotificationSetting_EmailSetting_Security.Descriptor instead.
+func (WorkspaceNotificationSetting_EmailSetting_Security) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10, 0, 0}
+}
+
 type WorkspaceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   WorkspaceSettingKey    `protobuf:"varint,1,opt,name=key,proto3,enum=memos.store.WorkspaceSettingKey" json:"key,omitempty"`
@@ -149,6 +271,8 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_StorageSetting
 	//	*WorkspaceSetting_MemoRelatedSetting
 	//	*WorkspaceSetting_AiModelSetting
+	//	*WorkspaceSetting_LifecycleSetting
+	//	*WorkspaceSetting_NotificationSetting
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -243,6 +367,24 @@ func (x *WorkspaceSetting) GetAiModelSetting() *WorkspaceAIModelSetting {
 	return nil
 }
 
+func (x *WorkspaceSetting) GetLifecycleSetting() *WorkspaceLifecycleSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_LifecycleSetting); ok {
+			return x.LifecycleSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetNotificationSetting() *WorkspaceNotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_NotificationSetting); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -267,6 +409,14 @@ type WorkspaceSetting_AiModelSetting struct {
 	AiModelSetting *WorkspaceAIModelSetting `protobuf:"bytes,6,opt,name=ai_model_setting,json=aiModelSetting,proto3,oneof"`
 }
 
+type WorkspaceSetting_LifecycleSetting struct {
+	LifecycleSetting *WorkspaceLifecycleSetting `protobuf:"bytes,7,opt,name=lifecycle_setting,json=lifecycleSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_NotificationSetting struct {
+	NotificationSetting *WorkspaceNotificationSetting `protobuf:"bytes,8,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_BasicSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
@@ -277,14 +427,21 @@ func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_AiModelSetting) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_LifecycleSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_NotificationSetting) isWorkspaceSetting_Value() {}
+
 type WorkspaceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for workspace. Mainly used for session management.
 	SecretKey string `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
 	// The current schema version of database.
 	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The VAPID key pair of the Web Push notifications, base64url encoded.
+	VapidPublicKey  string `protobuf:"bytes,3,opt,name=vapid_public_key,json=vapidPublicKey,proto3" json:"vapid_public_key,omitempty"`
+	VapidPrivateKey string `protobuf:"bytes,4,opt,name=vapid_private_key,json=vapidPrivateKey,proto3" json:"vapid_private_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *WorkspaceBasicSetting) Reset() {
@@ -331,6 +488,20 @@ func (x *WorkspaceBasicSetting) GetSchemaVersion() string {
 	return ""
 }
 
+func (x *WorkspaceBasicSetting) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
+func (x *WorkspaceBasicSetting) GetVapidPrivateKey() string {
+	if x != nil {
+		return x.VapidPrivateKey
+	}
+	return ""
+}
+
 type WorkspaceGeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// disallow_user_registration disallows user registration.
@@ -696,9 +867,12 @@ type WorkspaceMemoRelatedSetting struct {
 	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
 	EnableBlurNsfwContent bool `protobuf:"varint,12,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
 	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,13,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	NsfwTags []string `protobuf:"bytes,13,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// trash_retention_days is the number of days a deleted memo stays in the trash before it's purged.
+	// Default is 30 days.
+	TrashRetentionDays int32 `protobuf:"varint,14,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *WorkspaceMemoRelatedSetting) Reset() {
@@ -801,6 +975,13 @@ func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
 	return nil
 }
 
+func (x *WorkspaceMemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
 type WorkspaceAIModelSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// model is the AI model name.
@@ -864,23 +1045,318 @@ func (x *WorkspaceAIModelSetting) GetBaseUrl() string {
 	return ""
 }
 
+type WorkspaceLifecycleSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rules are applied to the memos of all users.
+	Rules         []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceLifecycleSetting) Reset() {
+	*x = WorkspaceLifecycleSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceLifecycleSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceLifecycleSetting) ProtoMessage() {}
+
+func (x *WorkspaceLifecycleSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceLifecycleSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceLifecycleSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkspaceLifecycleSetting) GetRules() []*LifecycleRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// LifecycleRule applies an action to the memos matching a filter once they are old enough.
+type LifecycleRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The CEL filter of the memos, e.g. `"scratch" in tags`. Empty matches all memos.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The number of days since the memo was last updated before the rule applies.
+	AgeDays int32                `protobuf:"varint,4,opt,name=age_days,json=ageDays,proto3" json:"age_days,omitempty"`
+	Action  LifecycleRule_Action `protobuf:"varint,5,opt,name=action,proto3,enum=memos.store.LifecycleRule_Action" json:"action,omitempty"`
+	// The visibility for CHANGE_VISIBILITY, e.g. "PRIVATE".
+	Visibility string `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// The tag for ADD_TAG, without the leading "#".
+	Tag           string `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifecycleRule) Reset() {
+	*x = LifecycleRule{}
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleRule) ProtoMessage() {}
+
+func (x *LifecycleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleRule.ProtoReflect.Descriptor instead.
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LifecycleRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetAgeDays() int32 {
+	if x != nil {
+		return x.AgeDays
+	}
+	return 0
+}
+
+func (x *LifecycleRule) GetAction() LifecycleRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return LifecycleRule_ACTION_UNSPECIFIED
+}
+
+func (x *LifecycleRule) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *LifecycleRule) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type WorkspaceNotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email is the SMTP configuration of the email notifications.
+	Email         *WorkspaceNotificationSetting_EmailSetting `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceNotificationSetting) Reset() {
+	*x = WorkspaceNotificationSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceNotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceNotificationSetting) ProtoMessage() {}
+
+func (x *WorkspaceNotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceNotificationSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceNotificationSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceNotificationSetting) GetEmail() *WorkspaceNotificationSetting_EmailSetting {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+type WorkspaceNotificationSetting_EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables sending the notifications by email.
+	Enabled  bool                                               `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SmtpHost string                                             `protobuf:"bytes,2,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort int32                                              `protobuf:"varint,3,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	Security WorkspaceNotificationSetting_EmailSetting_Security `protobuf:"varint,4,opt,name=security,proto3,enum=memos.store.WorkspaceNotificationSetting_EmailSetting_Security" json:"security,omitempty"`
+	// smtp_username is used for the authentication if it's set.
+	SmtpUsername string `protobuf:"bytes,5,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword string `protobuf:"bytes,6,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// from_email is the address the emails are sent from.
+	FromEmail string `protobuf:"bytes,7,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name the emails are sent from.
+	FromName      string `protobuf:"bytes,8,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) Reset() {
+	*x = WorkspaceNotificationSetting_EmailSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceNotificationSetting_EmailSetting) ProtoMessage() {}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceNotificationSetting_EmailSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceNotificationSetting_EmailSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSecurity() WorkspaceNotificationSetting_EmailSetting_Security {
+	if x != nil {
+		return x.Security
+	}
+	return WorkspaceNotificationSetting_EmailSetting_SECURITY_UNSPECIFIED
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *WorkspaceNotificationSetting_EmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
 var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 const file_store_workspace_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\xec\x03\n" +
+	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\xa3\x05\n" +
 	"\x10WorkspaceSetting\x122\n" +
 	"\x03key\x18\x01 \x01(\x0e2 .memos.store.WorkspaceSettingKeyR\x03key\x12I\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2\".memos.store.WorkspaceBasicSettingH\x00R\fbasicSetting\x12O\n" +
 	"\x0fgeneral_setting\x18\x03 \x01(\v2$.memos.store.WorkspaceGeneralSettingH\x00R\x0egeneralSetting\x12O\n" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2$.memos.store.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12\\\n" +
 	"\x14memo_related_setting\x18\x05 \x01(\v2(.memos.store.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12P\n" +
-	"\x10ai_model_setting\x18\x06 \x01(\v2$.memos.store.WorkspaceAIModelSettingH\x00R\x0eaiModelSettingB\a\n" +
-	"\x05value\"]\n" +
+	"\x10ai_model_setting\x18\x06 \x01(\v2$.memos.store.WorkspaceAIModelSettingH\x00R\x0eaiModelSetting\x12U\n" +
+	"\x11lifecycle_setting\x18\a \x01(\v2&.memos.store.WorkspaceLifecycleSettingH\x00R\x10lifecycleSetting\x12^\n" +
+	"\x14notification_setting\x18\b \x01(\v2).memos.store.WorkspaceNotificationSettingH\x00R\x13notificationSettingB\a\n" +
+	"\x05value\"\xb3\x01\n" +
 	"\x15WorkspaceBasicSetting\x12\x1d\n" +
 	"\n" +
 	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
-	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xd8\x03\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\x12(\n" +
+	"\x10vapid_public_key\x18\x03 \x01(\tR\x0evapidPublicKey\x12*\n" +
+	"\x11vapid_private_key\x18\x04 \x01(\tR\x0fvapidPrivateKey\"\xd8\x03\n" +
 	"\x17WorkspaceGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x01 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -914,7 +1390,7 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\x94\x04\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xc6\x04\n" +
 	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -926,18 +1402,57 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	" \x03(\tR\treactions\x12<\n" +
 	"\x1adisable_markdown_shortcuts\x18\v \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\f \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
-	"\tnsfw_tags\x18\r \x03(\tR\bnsfwTagsJ\x04\b\x04\x10\x05J\x04\b\b\x10\t\"c\n" +
+	"\tnsfw_tags\x18\r \x03(\tR\bnsfwTags\x120\n" +
+	"\x14trash_retention_days\x18\x0e \x01(\x05R\x12trashRetentionDaysJ\x04\b\x04\x10\x05J\x04\b\b\x10\t\"c\n" +
 	"\x17WorkspaceAIModelSetting\x12\x14\n" +
 	"\x05model\x18\x01 \x01(\tR\x05model\x12\x17\n" +
 	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x19\n" +
-	"\bbase_url\x18\x03 \x01(\tR\abaseUrl*\x81\x01\n" +
+	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\"M\n" +
+	"\x19WorkspaceLifecycleSetting\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.memos.store.LifecycleRuleR\x05rules\"\xb4\x02\n" +
+	"\rLifecycleRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\bage_days\x18\x04 \x01(\x05R\aageDays\x129\n" +
+	"\x06action\x18\x05 \x01(\x0e2!.memos.store.LifecycleRule.ActionR\x06action\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\x12\x10\n" +
+	"\x03tag\x18\a \x01(\tR\x03tag\"]\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aARCHIVE\x10\x01\x12\x15\n" +
+	"\x11CHANGE_VISIBILITY\x10\x02\x12\v\n" +
+	"\aADD_TAG\x10\x03\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x04\"\xfb\x03\n" +
+	"\x1cWorkspaceNotificationSetting\x12L\n" +
+	"\x05email\x18\x01 \x01(\v26.memos.store.WorkspaceNotificationSetting.EmailSettingR\x05email\x1a\x8c\x03\n" +
+	"\fEmailSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tsmtp_host\x18\x02 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x03 \x01(\x05R\bsmtpPort\x12[\n" +
+	"\bsecurity\x18\x04 \x01(\x0e2?.memos.store.WorkspaceNotificationSetting.EmailSetting.SecurityR\bsecurity\x12#\n" +
+	"\rsmtp_username\x18\x05 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x06 \x01(\tR\fsmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\a \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\b \x01(\tR\bfromName\"E\n" +
+	"\bSecurity\x12\x18\n" +
+	"\x14SECURITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NONE\x10\x01\x12\f\n" +
+	"\bSTARTTLS\x10\x02\x12\a\n" +
+	"\x03TLS\x10\x03*\xa2\x01\n" +
 	"\x13WorkspaceSettingKey\x12%\n" +
 	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
 	"\fMEMO_RELATED\x10\x04\x12\f\n" +
-	"\bAI_MODEL\x10\x05B\xa0\x01\n" +
+	"\bAI_MODEL\x10\x05\x12\r\n" +
+	"\tLIFECYCLE\x10\x06\x12\x10\n" +
+	"\fNOTIFICATION\x10\aB\xa0\x01\n" +
 	"\x0fcom.memos.storeB\x15WorkspaceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -952,35 +1467,47 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                 // 0: memos.store.WorkspaceSettingKey
-	(WorkspaceStorageSetting_StorageType)(0), // 1: memos.store.WorkspaceStorageSetting.StorageType
-	(*WorkspaceSetting)(nil),                 // 2: memos.store.WorkspaceSetting
-	(*WorkspaceBasicSetting)(nil),            // 3: memos.store.WorkspaceBasicSetting
-	(*WorkspaceGeneralSetting)(nil),          // 4: memos.store.WorkspaceGeneralSetting
-	(*WorkspaceCustomProfile)(nil),           // 5: memos.store.WorkspaceCustomProfile
-	(*WorkspaceStorageSetting)(nil),          // 6: memos.store.WorkspaceStorageSetting
-	(*StorageS3Config)(nil),                  // 7: memos.store.StorageS3Config
-	(*WorkspaceMemoRelatedSetting)(nil),      // 8: memos.store.WorkspaceMemoRelatedSetting
-	(*WorkspaceAIModelSetting)(nil),          // 9: memos.store.WorkspaceAIModelSetting
+	(WorkspaceSettingKey)(0),                                // 0: memos.store.WorkspaceSettingKey
+	(WorkspaceStorageSetting_StorageType)(0),                // 1: memos.store.WorkspaceStorageSetting.StorageType
+	(LifecycleRule_Action)(0),                               // 2: memos.store.LifecycleRule.Action
+	(WorkspaceNotificationSetting_EmailSetting_Security)(0), // 3: memos.store.WorkspaceNotificationSetting.EmailSetting.Security
+	(*WorkspaceSetting)(nil),                                // 4: memos.store.WorkspaceSetting
+	(*WorkspaceBasicSetting)(nil),                           // 5: memos.store.WorkspaceBasicSetting
+	(*WorkspaceGeneralSetting)(nil),                         // 6: memos.store.WorkspaceGeneralSetting
+	(*WorkspaceCustomProfile)(nil),                          // 7: memos.store.WorkspaceCustomProfile
+	(*WorkspaceStorageSetting)(nil),                         // 8: memos.store.WorkspaceStorageSetting
+	(*StorageS3Config)(nil),                                 // 9: memos.store.StorageS3Config
+	(*WorkspaceMemoRelatedSetting)(nil),                     // 10: memos.store.WorkspaceMemoRelatedSetting
+	(*WorkspaceAIModelSetting)(nil),                         // 11: memos.store.WorkspaceAIModelSetting
+	(*WorkspaceLifecycleSetting)(nil),                       // 12: memos.store.WorkspaceLifecycleSetting
+	(*LifecycleRule)(nil),                                   // 13: memos.store.LifecycleRule
+	(*WorkspaceNotificationSetting)(nil),                    // 14: memos.store.WorkspaceNotificationSetting
+	(*WorkspaceNotificationSetting_EmailSetting)(nil),       // 15: memos.store.WorkspaceNotificationSetting.EmailSetting
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
-	3, // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
-	4, // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
-	6, // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
-	8, // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
-	9, // 5: memos.store.WorkspaceSetting.ai_model_setting:type_name -> memos.store.WorkspaceAIModelSetting
-	5, // 6: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
-	1, // 7: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
-	7, // 8: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
+	5,  // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
+	6,  // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
+	8,  // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
+	10, // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
+	11, // 5: memos.store.WorkspaceSetting.ai_model_setting:type_name -> memos.store.WorkspaceAIModelSetting
+	12, // 6: memos.store.WorkspaceSetting.lifecycle_setting:type_name -> memos.store.WorkspaceLifecycleSetting
+	14, // 7: memos.store.WorkspaceSetting.notification_setting:type_name -> memos.store.WorkspaceNotificationSetting
+	7,  // 8: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
+	1,  // 9: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
+	9,  // 10: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	13, // 11: memos.store.WorkspaceLifecycleSetting.rules:type_name -> memos.store.LifecycleRule
+	2,  // 12: memos.store.LifecycleRule.action:type_name -> memos.store.LifecycleRule.Action
+	15, // 13: memos.store.WorkspaceNotificationSetting.email:type_name -> memos.store.WorkspaceNotificationSetting.EmailSetting
+	3,  // 14: memos.store.WorkspaceNotificationSetting.EmailSetting.security:type_name -> memos.store.WorkspaceNotificationSetting.EmailSetting.Security
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -994,14 +1521,16 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_StorageSetting)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting)(nil),
 		(*WorkspaceSetting_AiModelSetting)(nil),
+		(*WorkspaceSetting_LifecycleSetting)(nil),
+		(*WorkspaceSetting_NotificationSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      4,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
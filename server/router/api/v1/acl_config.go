@@ -11,6 +11,8 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.AuthService/SignInWithSSO":                     true,
 	"/memos.api.v1.AuthService/SignOut":                           true,
 	"/memos.api.v1.AuthService/SignUp":                            true,
+	"/memos.api.v1.AuthService/RequestPasswordReset":              true,
+	"/memos.api.v1.AuthService/ResetPassword":                     true,
 	"/memos.api.v1.UserService/GetUser":                           true,
 	"/memos.api.v1.UserService/GetUserByUsername":                 true,
 	"/memos.api.v1.UserService/GetUserAvatarBinary":               true,
@@ -19,6 +21,11 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.UserService/SearchUsers":                       true,
 	"/memos.api.v1.MemoService/GetMemo":                           true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
+	"/memos.api.v1.MemoService/SearchMemos":                       true,
+	"/memos.api.v1.MemoService/WatchMemos":                        true,
+	"/memos.api.v1.MemoService/GetMemoGraph":                      true,
+	"/memos.api.v1.MemoService/ListMemoLocations":                 true,
+	"/memos.api.v1.MemoService/GetSharedMemo":                     true,
 	"/memos.api.v1.MarkdownService/GetLinkMetadata":               true,
 	"/memos.api.v1.ResourceService/GetResourceBinary":             true,
 }
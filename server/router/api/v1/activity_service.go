@@ -70,5 +70,117 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			RelatedMemo: fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID),
 		}
 	}
+	if payload.MemoLifecycle != nil {
+		memoNames := []string{}
+		if len(payload.MemoLifecycle.MemoIds) > 0 {
+			// The memos purged from the trash are not found anymore.
+			memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+				IDList:         payload.MemoLifecycle.MemoIds,
+				ExcludeContent: true,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+			}
+			for _, memo := range memos {
+				memoNames = append(memoNames, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+			}
+		}
+		v2Payload.MemoLifecycle = &v1pb.ActivityMemoLifecyclePayload{
+			RuleId:    payload.MemoLifecycle.RuleId,
+			RuleTitle: payload.MemoLifecycle.RuleTitle,
+			Action:    v1pb.LifecycleRule_Action(payload.MemoLifecycle.Action),
+			Memos:     memoNames,
+		}
+	}
+	if payload.MemoReminder != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReminder.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo != nil {
+			v2Payload.MemoReminder = &v1pb.ActivityMemoReminderPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			}
+		}
+	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo != nil {
+			v2Payload.MemoMention = &v1pb.ActivityMemoMentionPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			}
+		}
+	}
+	if payload.MemoReaction != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReaction.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo != nil {
+			v2Payload.MemoReaction = &v1pb.ActivityMemoReactionPayload{
+				Memo:         fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				ReactionType: payload.MemoReaction.ReactionType,
+			}
+		}
+	}
+	if payload.MemoReference != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReference.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReference.RelatedMemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
+		}
+		if memo != nil && relatedMemo != nil {
+			v2Payload.MemoReference = &v1pb.ActivityMemoReferencePayload{
+				Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				RelatedMemo: fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID),
+			}
+		}
+	}
+	if payload.ShortcutMatch != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.ShortcutMatch.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo != nil {
+			v2Payload.ShortcutMatch = &v1pb.ActivityShortcutMatchPayload{
+				Memo:          fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				ShortcutId:    payload.ShortcutMatch.ShortcutId,
+				ShortcutTitle: payload.ShortcutMatch.ShortcutTitle,
+			}
+		}
+	}
+	if payload.Digest != nil {
+		activityNames := []string{}
+		for _, activityID := range payload.Digest.ActivityIds {
+			activityNames = append(activityNames, fmt.Sprintf("%s%d", ActivityNamePrefix, activityID))
+		}
+		v2Payload.Digest = &v1pb.ActivityDigestPayload{
+			Activities: activityNames,
+		}
+	}
 	return v2Payload, nil
 }
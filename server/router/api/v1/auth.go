@@ -22,6 +22,11 @@ const (
 	CookieExpDuration = AccessTokenDuration - 1*time.Minute
 	// AccessTokenCookieName is the cookie name of access token.
 	AccessTokenCookieName = "memos.access-token"
+	// MemoShareAudienceName is the audience name of the memo share token.
+	MemoShareAudienceName = "memo.share"
+	// PasswordResetAudienceName is the audience name of the password reset token.
+	PasswordResetAudienceName  = "user.password-reset"
+	PasswordResetTokenDuration = 1 * time.Hour
 )
 
 type ClaimsMessage struct {
@@ -34,6 +39,41 @@ func GenerateAccessToken(username string, userID int32, expirationTime time.Time
 	return generateToken(username, userID, AccessTokenAudienceName, expirationTime, secret)
 }
 
+// GenerateMemoShareToken generates a memo share token. The share UID is stored as the token ID.
+func GenerateMemoShareToken(shareUID string, expirationTime time.Time, secret []byte) (string, error) {
+	registeredClaims := jwt.RegisteredClaims{
+		Issuer:   Issuer,
+		Audience: jwt.ClaimStrings{MemoShareAudienceName},
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+		ID:       shareUID,
+	}
+	if !expirationTime.IsZero() {
+		registeredClaims.ExpiresAt = jwt.NewNumericDate(expirationTime)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &registeredClaims)
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
+// GeneratePasswordResetToken generates a password reset token. The fingerprint of the current password
+// is stored as the token ID, so the token can't be used anymore once the password has changed.
+func GeneratePasswordResetToken(username string, userID int32, passwordFingerprint string, expirationTime time.Time, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ClaimsMessage{
+		Name: username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{PasswordResetAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   fmt.Sprint(userID),
+			ID:        passwordFingerprint,
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
 // generateToken generates a jwt token.
 func generateToken(username string, userID int32, audience string, expirationTime time.Time, secret []byte) (string, error) {
 	registeredClaims := jwt.RegisteredClaims{
@@ -2,12 +2,16 @@ package v1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
@@ -18,6 +22,7 @@ import (
 
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/email"
 	"github.com/usememos/memos/plugin/idp"
 	"github.com/usememos/memos/plugin/idp/oauth2"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
@@ -27,6 +32,13 @@ import (
 
 const (
 	unmatchedUsernameAndPasswordError = "unmatched username and password"
+
+	// passwordResetEmailLimit is the number of the password resets requested for an email in the throttle period.
+	passwordResetEmailLimit = 3
+	// passwordResetIPLimit is the number of the password resets requested from a client IP in the throttle period.
+	passwordResetIPLimit = 20
+	// passwordResetThrottlePeriod is the period the password reset requests are throttled in.
+	passwordResetThrottlePeriod = time.Hour
 )
 
 func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusRequest) (*v1pb.User, error) {
@@ -314,3 +326,131 @@ func (s *APIV1Service) GetCurrentUser(ctx context.Context) (*store.User, error)
 	}
 	return user, nil
 }
+
+func (s *APIV1Service) RequestPasswordReset(ctx context.Context, request *v1pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if request.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	emailConfig, err := s.Store.GetEmailConfig(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get email config, error: %v", err)
+	}
+	if emailConfig == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "email is not configured")
+	}
+	// The requests are throttled whether the email belongs to a user or not, so the throttling doesn't tell either.
+	if !s.allowPasswordReset(ctx, request.Email) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many password reset requests, try again later")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email: &request.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return &emptypb.Empty{}, nil
+	}
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting, error: %v", err)
+	}
+	// The users who can't sign in with a password don't get a password reset either.
+	if workspaceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+		return &emptypb.Empty{}, nil
+	}
+
+	token, err := GeneratePasswordResetToken(user.Username, user.ID, getPasswordFingerprint(user.PasswordHash), time.Now().Add(PasswordResetTokenDuration), []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password reset token, error: %v", err)
+	}
+	data := &email.PasswordResetData{
+		Username:  user.Username,
+		Token:     token,
+		ExpiresIn: "1 hour",
+	}
+	if s.Profile.InstanceURL != "" {
+		data.URL = fmt.Sprintf("%s/auth/reset-password?token=%s", strings.TrimRight(s.Profile.InstanceURL, "/"), url.QueryEscape(token))
+	}
+	message, err := email.PasswordResetTemplate.Render([]string{user.Email}, data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render password reset email, error: %v", err)
+	}
+	// The email is sent asynchronously, so the response doesn't tell whether a user has the email.
+	email.SendAsync(emailConfig, message)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ResetPassword(ctx context.Context, request *v1pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if request.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password is required")
+	}
+	claims := &ClaimsMessage{}
+	if _, err := jwt.ParseWithClaims(request.Token, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, status.Errorf(codes.PermissionDenied, "unexpected password reset token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		if kid, ok := t.Header["kid"].(string); ok {
+			if kid == "v1" {
+				return []byte(s.Secret), nil
+			}
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "unexpected password reset token kid=%v", t.Header["kid"])
+	}, jwt.WithAudience(PasswordResetAudienceName), jwt.WithIssuer(Issuer), jwt.WithExpirationRequired()); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid or expired password reset token")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid password reset token")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	// The token is used up once the password has changed.
+	if user == nil || user.RowStatus == store.Archived || claims.ID != getPasswordFingerprint(user.PasswordHash) {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid or expired password reset token")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash, error: %v", err)
+	}
+	passwordHashStr := string(passwordHash)
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		PasswordHash: &passwordHashStr,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user, error: %v", err)
+	}
+	// The sessions and the access tokens are revoked, as the password may be reset for a compromised account.
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.AccessTokensUserSetting{},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens, error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// allowPasswordReset records the password reset request, it returns false if the email or the client IP
+// has requested too many password resets recently.
+func (s *APIV1Service) allowPasswordReset(ctx context.Context, emailAddress string) bool {
+	now := time.Now()
+	if clientIP := getClientIP(ctx); clientIP != "" && !s.passwordResetIPThrottle.allow(clientIP, now) {
+		return false
+	}
+	return s.passwordResetEmailThrottle.allow(strings.ToLower(emailAddress), now)
+}
+
+// getPasswordFingerprint returns a short digest of the password hash, which changes whenever the password changes.
+func getPasswordFingerprint(passwordHash string) string {
+	sum := sha256.Sum256([]byte(passwordHash))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	fingerprint := getPasswordFingerprint(user.PasswordHash)
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, "session", "user login"))
+
+	// The tokens of other audiences, expired or signed by others are rejected.
+	accessToken, err := GenerateAccessToken(user.Username, user.ID, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	expiredToken, err := GeneratePasswordResetToken(user.Username, user.ID, fingerprint, time.Now().Add(-time.Minute), []byte(s.Secret))
+	require.NoError(t, err)
+	forgedToken, err := GeneratePasswordResetToken(user.Username, user.ID, fingerprint, time.Now().Add(time.Hour), []byte("other-secret"))
+	require.NoError(t, err)
+	for _, token := range []string{accessToken, expiredToken, forgedToken, "invalid"} {
+		_, err := s.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, Password: "new-password"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	// The password is reset, and the sessions and the access tokens are revoked.
+	token, err := GeneratePasswordResetToken(user.Username, user.ID, fingerprint, time.Now().Add(PasswordResetTokenDuration), []byte(s.Secret))
+	require.NoError(t, err)
+	_, err = s.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, Password: "new-password"})
+	require.NoError(t, err)
+	user, err = s.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("new-password")))
+	accessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, accessTokens)
+
+	// The token is used up once the password has changed.
+	_, err = s.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, Password: "another-password"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	createTestingUser(ctx, t, s, "user", store.RoleUser)
+	_, err := s.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "user@test.com"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The emails are sent to a closed port, the response doesn't tell whether they are sent.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_NOTIFICATION,
+		Value: &storepb.WorkspaceSetting_NotificationSetting{
+			NotificationSetting: &storepb.WorkspaceNotificationSetting{
+				Email: &storepb.WorkspaceNotificationSetting_EmailSetting{
+					Enabled:   true,
+					SmtpHost:  "127.0.0.1",
+					SmtpPort:  int32(port),
+					Security:  storepb.WorkspaceNotificationSetting_EmailSetting_NONE,
+					FromEmail: "memos@test.com",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The response is the same whether the email belongs to a user or not.
+	for _, email := range []string{"user@test.com", "nobody@test.com"} {
+		response, err := s.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: email})
+		require.NoError(t, err)
+		require.NotNil(t, response)
+	}
+
+	// The requests of an email are throttled, whether it belongs to a user or not.
+	for _, email := range []string{"USER@test.com", "nobody@test.com"} {
+		for i := 1; i < passwordResetEmailLimit; i++ {
+			_, err := s.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: email})
+			require.NoError(t, err)
+		}
+		_, err := s.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: email})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+
+	// The requests of a client IP are throttled across the emails.
+	clientCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.1"))
+	for i := 0; i < passwordResetIPLimit; i++ {
+		_, err := s.RequestPasswordReset(clientCtx, &v1pb.RequestPasswordResetRequest{Email: "other@test.com"})
+		if i < passwordResetEmailLimit {
+			require.NoError(t, err)
+		} else {
+			require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		}
+	}
+	_, err = s.RequestPasswordReset(clientCtx, &v1pb.RequestPasswordResetRequest{Email: "another@test.com"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestRequestThrottle(t *testing.T) {
+	throttle := newRequestThrottle(2, time.Minute)
+	now := time.Now()
+	require.True(t, throttle.allow("a", now))
+	require.True(t, throttle.allow("a", now.Add(time.Second)))
+	require.False(t, throttle.allow("a", now.Add(2*time.Second)))
+	require.True(t, throttle.allow("b", now.Add(2*time.Second)))
+	// The period starts over once it ends.
+	require.True(t, throttle.allow("a", now.Add(time.Minute)))
+}
+
+func TestGetClientIP(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, "", getClientIP(ctx))
+
+	// The direct clients are identified by their address, the forwarded addresses are ignored.
+	remoteCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.1"), Port: 1234}})
+	require.Equal(t, "203.0.113.1", getClientIP(metadata.NewIncomingContext(remoteCtx, metadata.Pairs("x-forwarded-for", "10.0.0.1"))))
+
+	// The requests through the gateway are identified by the address the gateway appends.
+	gatewayCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}})
+	require.Equal(t, "127.0.0.1", getClientIP(gatewayCtx))
+	require.Equal(t, "198.51.100.1", getClientIP(metadata.NewIncomingContext(gatewayCtx, metadata.Pairs("x-forwarded-for", "10.0.0.1, 198.51.100.1"))))
+}
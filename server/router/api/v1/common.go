@@ -21,6 +21,8 @@ func convertStateFromStore(rowStatus store.RowStatus) v1pb.State {
 		return v1pb.State_NORMAL
 	case store.Archived:
 		return v1pb.State_ARCHIVED
+	case store.Deleted:
+		return v1pb.State_DELETED
 	default:
 		return v1pb.State_STATE_UNSPECIFIED
 	}
@@ -32,6 +34,8 @@ func convertStateToStore(state v1pb.State) store.RowStatus {
 		return store.Normal
 	case v1pb.State_ARCHIVED:
 		return store.Archived
+	case v1pb.State_DELETED:
+		return store.Deleted
 	default:
 		return store.Normal
 	}
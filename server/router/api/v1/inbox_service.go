@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -112,6 +114,79 @@ func (s *APIV1Service) DeleteInbox(ctx context.Context, request *v1pb.DeleteInbo
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) MarkAllInboxesRead(ctx context.Context, request *v1pb.MarkAllInboxesReadRequest) (*emptypb.Empty, error) {
+	user, err := s.getInboxReceiver(ctx, request.User)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadStatus := store.UNREAD
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Status:     &unreadStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list inbox: %v", err)
+	}
+	for _, inbox := range inboxes {
+		if _, err := s.Store.UpdateInbox(ctx, &store.UpdateInbox{
+			ID:     inbox.ID,
+			Status: store.ARCHIVED,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update inbox: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) GetUnreadInboxCount(ctx context.Context, request *v1pb.GetUnreadInboxCountRequest) (*v1pb.GetUnreadInboxCountResponse, error) {
+	user, err := s.getInboxReceiver(ctx, request.User)
+	if err != nil {
+		return nil, err
+	}
+
+	// The inboxes of unknown types are not listed, so they are not counted either.
+	messageTypes := []storepb.InboxMessage_Type{}
+	for value := range storepb.InboxMessage_Type_name {
+		if value != int32(storepb.InboxMessage_TYPE_UNSPECIFIED) {
+			messageTypes = append(messageTypes, storepb.InboxMessage_Type(value))
+		}
+	}
+	unreadStatus := store.UNREAD
+	unreadCount, err := s.Store.CountInboxes(ctx, &store.FindInbox{
+		ReceiverID:   &user.ID,
+		Status:       &unreadStatus,
+		MessageTypes: messageTypes,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count inbox: %v", err)
+	}
+	return &v1pb.GetUnreadInboxCountResponse{
+		UnreadCount: int32(unreadCount),
+	}, nil
+}
+
+// getInboxReceiver returns the current user if the name is empty, "users/-" or the name of the current user.
+func (s *APIV1Service) getInboxReceiver(ctx context.Context, name string) (*store.User, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if name != "" && name != "users/-" {
+		userID, err := ExtractUserIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		if userID != user.ID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+	return user, nil
+}
+
 func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
 	return &v1pb.Inbox{
 		Name:       fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
@@ -145,3 +220,24 @@ func convertInboxStatusToStore(status v1pb.Inbox_Status) store.InboxStatus {
 		return store.UNREAD
 	}
 }
+
+// sendActivityToInbox records the activity and sends it to the inbox of the receiver,
+// unless the receiver has muted the message type.
+func (s *APIV1Service) sendActivityToInbox(ctx context.Context, create *store.Activity, receiverID int32, messageType storepb.InboxMessage_Type) error {
+	activity, err := s.Store.CreateActivity(ctx, create)
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.Store.SendInboxMessage(ctx, &store.Inbox{
+		SenderID:   create.CreatorID,
+		ReceiverID: receiverID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       messageType,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoGrants(ctx context.Context, request *v1pb.ListMemoGrantsRequest) (*v1pb.ListMemoGrantsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForGrantAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	acls, err := s.Store.ListMemoACLs(ctx, &store.FindMemoACL{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo grants: %v", err)
+	}
+	response := &v1pb.ListMemoGrantsResponse{
+		Grants: []*v1pb.MemoGrant{},
+	}
+	for _, acl := range acls {
+		response.Grants = append(response.Grants, convertMemoGrantFromStore(memo, acl))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateMemoGrant(ctx context.Context, request *v1pb.CreateMemoGrantRequest) (*v1pb.MemoGrant, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	if request.Grant == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "grant is required")
+	}
+	memo, err := s.getMemoForGrantAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := ExtractUserIDFromName(request.Grant.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee: %v", err)
+	}
+	grantee, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if grantee == nil {
+		return nil, status.Errorf(codes.NotFound, "grantee not found")
+	}
+	if grantee.ID == memo.CreatorID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot grant access to the memo creator")
+	}
+	permission := store.MemoPermissionRead
+	if request.Grant.Permission != v1pb.MemoGrant_PERMISSION_UNSPECIFIED {
+		permission = convertMemoPermissionToStore(request.Grant.Permission)
+	}
+
+	acl, err := s.Store.UpsertMemoACL(ctx, &store.MemoACL{
+		MemoID:     memo.ID,
+		UserID:     grantee.ID,
+		Permission: permission,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo grant: %v", err)
+	}
+	return convertMemoGrantFromStore(memo, acl), nil
+}
+
+func (s *APIV1Service) DeleteMemoGrant(ctx context.Context, request *v1pb.DeleteMemoGrantRequest) (*emptypb.Empty, error) {
+	memoUID, grantID, err := ExtractMemoGrantIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo grant name: %v", err)
+	}
+	memo, err := s.getMemoForGrantAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	acl, err := s.Store.GetMemoACL(ctx, &store.FindMemoACL{
+		ID: &grantID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo grant: %v", err)
+	}
+	if acl == nil || acl.MemoID != memo.ID {
+		return nil, status.Errorf(codes.NotFound, "memo grant not found")
+	}
+	if err := s.Store.DeleteMemoACL(ctx, &store.DeleteMemoACL{ID: &acl.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo grant: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getMemoForGrantAccess returns the memo if the current user is allowed to manage its grants.
+func (s *APIV1Service) getMemoForGrantAccess(ctx context.Context, memoUID string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Only the creator or admin can manage the memo grants.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// getMemoGrant returns the grant of the user on the memo, or nil if there is none.
+func (s *APIV1Service) getMemoGrant(ctx context.Context, memo *store.Memo, user *store.User) (*store.MemoACL, error) {
+	if user == nil {
+		return nil, nil
+	}
+	return s.Store.GetMemoACL(ctx, &store.FindMemoACL{
+		MemoID: &memo.ID,
+		UserID: &user.ID,
+	})
+}
+
+// getGrantedMemosFilter returns a filter matching the memos the user has been granted access to.
+// The grants are joined by the store, so the filter stays the same however many memos are granted.
+func getGrantedMemosFilter(userID int32) string {
+	return fmt.Sprintf("granted_to(%d)", userID)
+}
+
+// getVisibleMemosFilter returns a filter matching the memos visible to the user, including the granted ones.
+func getVisibleMemosFilter(user *store.User) string {
+	if user == nil {
+		return `visibility == "PUBLIC"`
+	}
+	return fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || %s`, user.ID, getGrantedMemosFilter(user.ID))
+}
+
+// canReadMemo returns whether the user is allowed to read the memo, either by its visibility or by a grant.
+func (s *APIV1Service) canReadMemo(ctx context.Context, memo *store.Memo, user *store.User) (bool, error) {
+	if memo.Visibility == store.Public {
+		return true, nil
+	}
+	if user == nil {
+		return false, nil
+	}
+	if memo.Visibility == store.Protected || memo.CreatorID == user.ID {
+		return true, nil
+	}
+	acl, err := s.getMemoGrant(ctx, memo, user)
+	if err != nil {
+		return false, err
+	}
+	return acl != nil, nil
+}
+
+// canCommentOnMemo returns whether the user is allowed to comment on the memo.
+// Private memos require a COMMENT grant unless the user is the creator.
+func (s *APIV1Service) canCommentOnMemo(ctx context.Context, memo *store.Memo, user *store.User) (bool, error) {
+	if user == nil {
+		return false, nil
+	}
+	if memo.Visibility != store.Private || memo.CreatorID == user.ID {
+		return true, nil
+	}
+	acl, err := s.getMemoGrant(ctx, memo, user)
+	if err != nil {
+		return false, err
+	}
+	return acl != nil && acl.Permission == store.MemoPermissionComment, nil
+}
+
+func convertMemoGrantFromStore(memo *store.Memo, acl *store.MemoACL) *v1pb.MemoGrant {
+	return &v1pb.MemoGrant{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoGrantNamePrefix, acl.ID),
+		Grantee:    fmt.Sprintf("%s%d", UserNamePrefix, acl.UserID),
+		Permission: convertMemoPermissionFromStore(acl.Permission),
+		CreateTime: timestamppb.New(time.Unix(acl.CreatedTs, 0)),
+	}
+}
+
+func convertMemoPermissionFromStore(permission store.MemoPermission) v1pb.MemoGrant_Permission {
+	switch permission {
+	case store.MemoPermissionRead:
+		return v1pb.MemoGrant_READ
+	case store.MemoPermissionComment:
+		return v1pb.MemoGrant_COMMENT
+	default:
+		return v1pb.MemoGrant_PERMISSION_UNSPECIFIED
+	}
+}
+
+func convertMemoPermissionToStore(permission v1pb.MemoGrant_Permission) store.MemoPermission {
+	switch permission {
+	case v1pb.MemoGrant_COMMENT:
+		return store.MemoPermissionComment
+	default:
+		return store.MemoPermissionRead
+	}
+}
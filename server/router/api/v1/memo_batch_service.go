@@ -0,0 +1,274 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) BatchUpdateMemos(ctx context.Context, request *v1pb.BatchUpdateMemosRequest) (*v1pb.BatchUpdateMemosResponse, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	if request.Update == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update is required")
+	}
+	memos, err := s.listMemosForBatch(ctx, request.Filter, request.State)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := []*store.UpdateMemo{}
+	updatedMemos := []*store.Memo{}
+	for _, memo := range memos {
+		update, err := s.buildBatchMemoUpdate(ctx, memo, request.Update, request.UpdateMask.Paths)
+		if err != nil {
+			return nil, err
+		}
+		if update == nil {
+			continue
+		}
+		updates = append(updates, update)
+		updatedMemos = append(updatedMemos, memo)
+	}
+	if request.DryRun || len(updates) == 0 {
+		return &v1pb.BatchUpdateMemosResponse{
+			AffectedCount: int32(len(updates)),
+		}, nil
+	}
+
+	if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+	}
+	for _, memo := range updatedMemos {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		if err != nil || memo == nil {
+			continue
+		}
+		// Try to dispatch webhook when memo is updated.
+		if err := s.DispatchStoreMemoUpdatedWebhook(ctx, memo); err != nil {
+			slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+		}
+	}
+	return &v1pb.BatchUpdateMemosResponse{
+		AffectedCount: int32(len(updates)),
+	}, nil
+}
+
+func (s *APIV1Service) BatchDeleteMemos(ctx context.Context, request *v1pb.BatchDeleteMemosRequest) (*v1pb.BatchDeleteMemosResponse, error) {
+	if request.State == v1pb.State_DELETED {
+		return nil, status.Errorf(codes.InvalidArgument, "memos in the trash can only be purged")
+	}
+	memos, err := s.listMemosForBatch(ctx, request.Filter, request.State)
+	if err != nil {
+		return nil, err
+	}
+	if request.DryRun || len(memos) == 0 {
+		return &v1pb.BatchDeleteMemosResponse{
+			AffectedCount: int32(len(memos)),
+		}, nil
+	}
+
+	// Move the memos to the trash. They will be purged by the trash runner after the retention period.
+	deleted := store.Deleted
+	deletedTs := time.Now().Unix()
+	updates := []*store.UpdateMemo{}
+	for _, memo := range memos {
+		updates = append(updates, &store.UpdateMemo{
+			ID:        memo.ID,
+			RowStatus: &deleted,
+			UpdatedTs: &deletedTs,
+		})
+	}
+	if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memos: %v", err)
+	}
+	for _, memo := range memos {
+		if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
+			// Try to dispatch webhook when memo is deleted.
+			if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+				slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
+			}
+		}
+	}
+	return &v1pb.BatchDeleteMemosResponse{
+		AffectedCount: int32(len(memos)),
+	}, nil
+}
+
+// listMemosForBatch returns the memos of the current user that match the filter.
+func (s *APIV1Service) listMemosForBatch(ctx context.Context, filter string, state v1pb.State) ([]*store.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// A filter is required to avoid updating all the memos by accident.
+	if filter == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "filter is required")
+	}
+	if err := s.validateSelectiveFilter(ctx, filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	rowStatus := convertStateToStore(state)
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &rowStatus,
+		Filter:          &filter,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	return memos, nil
+}
+
+// buildBatchMemoUpdate builds the update of a memo. It returns nil if the memo is left unchanged.
+// The memo itself is left untouched, the tag edits are applied to a copy.
+func (s *APIV1Service) buildBatchMemoUpdate(ctx context.Context, memo *store.Memo, batchUpdate *v1pb.BatchUpdateMemosRequest_Update, paths []string) (*store.UpdateMemo, error) {
+	update := &store.UpdateMemo{
+		ID: memo.ID,
+	}
+	edited := *memo
+	edited.Payload = proto.Clone(memo.Payload).(*storepb.MemoPayload)
+	changed := false
+	for _, path := range paths {
+		switch path {
+		case "visibility":
+			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+			}
+			visibility := convertVisibilityToStore(batchUpdate.Visibility)
+			if workspaceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+			}
+			if memo.Visibility != visibility {
+				update.Visibility = &visibility
+				changed = true
+			}
+		case "pinned":
+			if memo.Pinned != batchUpdate.Pinned {
+				update.Pinned = &batchUpdate.Pinned
+				changed = true
+			}
+		case "state":
+			if batchUpdate.State == v1pb.State_DELETED {
+				return nil, status.Errorf(codes.InvalidArgument, "use BatchDeleteMemos to move memos to the trash")
+			}
+			rowStatus := convertStateToStore(batchUpdate.State)
+			if memo.RowStatus != rowStatus {
+				update.RowStatus = &rowStatus
+				changed = true
+			}
+		case "add_tag":
+			tag, err := normalizeBatchTag(batchUpdate.AddTag)
+			if err != nil {
+				return nil, err
+			}
+			if slices.Contains(edited.Payload.GetTags(), tag) {
+				continue
+			}
+			content := strings.TrimRight(edited.Content, "\n")
+			if content != "" {
+				content += "\n\n"
+			}
+			edited.Content = content + "#" + tag
+			contentLengthLimit, err := s.getContentLengthLimit(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(edited.Content) > contentLengthLimit {
+				return nil, status.Errorf(codes.InvalidArgument, "content of memo %s too long (max %d characters)", memo.UID, contentLengthLimit)
+			}
+			if err := memopayload.RebuildMemoPayload(&edited); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+			}
+			update.Content = &edited.Content
+			update.Payload = edited.Payload
+			changed = true
+		case "remove_tag":
+			tag, err := normalizeBatchTag(batchUpdate.RemoveTag)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(edited.Payload.GetTags(), tag) {
+				continue
+			}
+			nodes, err := parser.Parse(tokenizer.Tokenize(edited.Content))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
+			}
+			edited.Content = restore.Restore(removeTagNodes(nodes, tag))
+			if err := memopayload.RebuildMemoPayload(&edited); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+			}
+			update.Content = &edited.Content
+			update.Payload = edited.Payload
+			changed = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if !changed {
+		return nil, nil
+	}
+	return update, nil
+}
+
+func normalizeBatchTag(tag string) (string, error) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	if tag == "" || strings.ContainsAny(tag, " \t\n") {
+		return "", status.Errorf(codes.InvalidArgument, "invalid tag: %q", tag)
+	}
+	return tag, nil
+}
+
+// removeTagNodes removes the tag nodes with the given content from the AST.
+func removeTagNodes(nodes []ast.Node, tag string) []ast.Node {
+	result := make([]ast.Node, 0, len(nodes))
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *ast.Tag:
+			if n.Content == tag {
+				continue
+			}
+		case *ast.Paragraph:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.Heading:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.Blockquote:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.List:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.OrderedListItem:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.UnorderedListItem:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.TaskListItem:
+			n.Children = removeTagNodes(n.Children, tag)
+		case *ast.Bold:
+			n.Children = removeTagNodes(n.Children, tag)
+		}
+		result = append(result, node)
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func TestBatchMemosFilter(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	workMemo := createTestingBatchMemo(ctx, t, s, user, "work", "#work notes")
+	lifeMemo := createTestingBatchMemo(ctx, t, s, user, "life", "#life notes")
+
+	// The filters selecting all the memos, or failing to convert, change nothing.
+	for _, filter := range []string{`true`, `content.endsWith("notes")`, `has_link == true || content.endsWith("notes")`} {
+		_, err := s.BatchDeleteMemos(userCtx, &v1pb.BatchDeleteMemosRequest{Filter: filter})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+		_, err = s.BatchUpdateMemos(userCtx, &v1pb.BatchUpdateMemosRequest{
+			Filter:     filter,
+			Update:     &v1pb.BatchUpdateMemosRequest_Update{Pinned: true},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+	}
+	for _, memo := range []*store.Memo{workMemo, lifeMemo} {
+		memo = getTestingBatchMemo(ctx, t, s, memo.ID)
+		require.Equal(t, store.Normal, memo.RowStatus)
+		require.False(t, memo.Pinned)
+	}
+
+	// The memos matching the filter are changed.
+	response, err := s.BatchDeleteMemos(userCtx, &v1pb.BatchDeleteMemosRequest{Filter: `tag in ["work"]`})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), response.AffectedCount)
+	require.Equal(t, store.Deleted, getTestingBatchMemo(ctx, t, s, workMemo.ID).RowStatus)
+	require.Equal(t, store.Normal, getTestingBatchMemo(ctx, t, s, lifeMemo.ID).RowStatus)
+}
+
+func TestBatchUpdateMemosAddTag(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	// The content is 10 characters short of the default limit.
+	content := "#work " + strings.Repeat("x", store.DefaultContentLengthLimit-16)
+	memo := createTestingBatchMemo(ctx, t, s, user, "memo", content)
+	addTag := func(tag string) error {
+		_, err := s.BatchUpdateMemos(userCtx, &v1pb.BatchUpdateMemosRequest{
+			Filter:     `tag in ["work"]`,
+			Update:     &v1pb.BatchUpdateMemosRequest_Update{AddTag: tag},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"add_tag"}},
+		})
+		return err
+	}
+
+	// The tags are added within the content length limit.
+	require.NoError(t, addTag("a"))
+	require.Equal(t, content+"\n\n#a", getTestingBatchMemo(ctx, t, s, memo.ID).Content)
+	require.Equal(t, codes.InvalidArgument, status.Code(addTag("toolong")))
+	require.Equal(t, content+"\n\n#a", getTestingBatchMemo(ctx, t, s, memo.ID).Content)
+}
+
+func createTestingBatchMemo(ctx context.Context, t *testing.T, s *APIV1Service, user *store.User, uid, content string) *store.Memo {
+	memo := &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: store.Private,
+	}
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+	memo, err := s.Store.CreateMemo(ctx, memo)
+	require.NoError(t, err)
+	return memo
+}
+
+func getTestingBatchMemo(ctx context.Context, t *testing.T, s *APIV1Service, id int32) *store.Memo {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	require.NoError(t, err)
+	return memo
+}
@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) GetOrCreateDailyMemo(ctx context.Context, request *v1pb.GetOrCreateDailyMemoRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	location := time.Local
+	if request.Timezone != "" {
+		location, err = time.LoadLocation(request.Timezone)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timezone: %v", err)
+		}
+	}
+	now := time.Now().In(location)
+	day := now
+	if request.Date != "" {
+		day, err = time.ParseInLocation(time.DateOnly, request.Date, location)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date: %v", err)
+		}
+	}
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, location)
+	date := dayStart.Format(time.DateOnly)
+
+	memo, err := s.findDailyMemo(ctx, user.ID, date)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find daily memo: %v", err)
+	}
+	if memo != nil {
+		return s.convertMemoFromStore(ctx, memo)
+	}
+
+	content, visibility, err := s.getDailyMemoContent(ctx, user, request.TemplateId, now, dayStart)
+	if err != nil {
+		return nil, err
+	}
+	// The memo of another day is displayed on the day.
+	daily := &dailyMemoKey{Date: date}
+	if date != now.Format(time.DateOnly) {
+		daily.CreatedTs = dayStart.Unix()
+	}
+	memoMessage, err := s.createMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: convertVisibilityFromStore(visibility),
+		},
+	}, daily)
+	if err != nil {
+		// The daily memo is unique per user and day, it's created by a concurrent request then.
+		if memo, findErr := s.findDailyMemo(ctx, user.ID, date); findErr == nil && memo != nil {
+			return s.convertMemoFromStore(ctx, memo)
+		}
+		return nil, err
+	}
+	return memoMessage, nil
+}
+
+// dailyMemoKey keys a new memo as the daily memo of a day.
+type dailyMemoKey struct {
+	// Date is the calendar day, e.g. "2025-01-02".
+	Date string
+	// CreatedTs is the start of the day if it's not today, or zero otherwise.
+	CreatedTs int64
+}
+
+// findDailyMemo returns the memo keyed for the day, or the memo tagged with the day, e.g. `#2025-01-02` or `#daily/2025-01-02`.
+func (s *APIV1Service) findDailyMemo(ctx context.Context, userID int32, date string) (*store.Memo, error) {
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		ExcludeComments: true,
+		PayloadFind: &store.FindMemoPayload{
+			DailyDate: &date,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, memo := range memos {
+		if memo.RowStatus != store.Deleted {
+			return memo, nil
+		}
+		// The daily memo in the trash is replaced by a new one, so its key is released.
+		memo.Payload.DailyDate = ""
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Payload: memo.Payload,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	normalStatus := store.Normal
+	filter := fmt.Sprintf(`tag in [%q, %q]`, date, "daily/"+date)
+	memos, err = s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		Filter:          &filter,
+		OrderByTimeAsc:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(memos) == 0 {
+		return nil, nil
+	}
+	return memos[0], nil
+}
+
+// getDailyMemoContent returns the content and the visibility of a new daily memo, expanded from the template if any.
+func (s *APIV1Service) getDailyMemoContent(ctx context.Context, user *store.User, templateID string, now, dayStart time.Time) (string, store.Visibility, error) {
+	if templateID == "" {
+		return fmt.Sprintf("# %s", dayStart.Format(time.DateOnly)), store.Private, nil
+	}
+
+	templatesUserSetting, err := s.getTemplatesUserSetting(ctx, user.ID)
+	if err != nil {
+		return "", "", status.Errorf(codes.Internal, "failed to get templates user setting: %v", err)
+	}
+	var template *storepb.TemplatesUserSetting_Template
+	for _, t := range templatesUserSetting.GetTemplates() {
+		if t.GetId() == templateID {
+			template = t
+			break
+		}
+	}
+	if template == nil {
+		return "", "", status.Errorf(codes.NotFound, "template not found")
+	}
+
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", "", status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	// The time of another day is the start of the day.
+	dayTime := dayStart
+	if dayStart.Format(time.DateOnly) == now.Format(time.DateOnly) {
+		dayTime = now
+	}
+	weekStart := getWeekStart(dayStart, int(workspaceGeneralSetting.WeekStartDayOffset))
+	variables := getTemplateVariables(user, dayTime)
+	variables["weekday"] = dayStart.Weekday().String()
+	variables["week.start"] = weekStart.Format(time.DateOnly)
+	variables["week.end"] = weekStart.AddDate(0, 0, 6).Format(time.DateOnly)
+
+	visibility := store.Private
+	if template.Visibility != "" {
+		visibility = store.Visibility(template.Visibility)
+	}
+	return expandTemplateContent(template.Content, variables), visibility, nil
+}
+
+// getWeekStart returns the first day of the week of the day, with the week starting at the offset from Sunday.
+func getWeekStart(day time.Time, weekStartDayOffset int) time.Time {
+	days := (int(day.Weekday()) - weekStartDayOffset%7 + 7) % 7
+	return day.AddDate(0, 0, -days)
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetWeekStart(t *testing.T) {
+	// 2025-01-01 is a Wednesday.
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		offset int
+		want   string
+	}{
+		{offset: 0, want: "2024-12-29"},
+		{offset: 1, want: "2024-12-30"},
+		{offset: 3, want: "2025-01-01"},
+		{offset: 4, want: "2024-12-26"},
+		{offset: 6, want: "2024-12-28"},
+		{offset: -1, want: "2024-12-28"},
+		{offset: 8, want: "2024-12-30"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, getWeekStart(day, test.offset).Format(time.DateOnly), "offset %d", test.offset)
+	}
+	// The week start keeps the timezone of the day.
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	weekStart := getWeekStart(time.Date(2025, 1, 1, 0, 0, 0, 0, tokyo), 1)
+	require.Equal(t, time.Date(2024, 12, 30, 0, 0, 0, 0, tokyo), weekStart)
+}
+
+func TestGetOrCreateDailyMemo(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "journal", store.RoleUser)
+	userCtx := withUser(ctx, user)
+
+	// The day starts in the timezone of the request.
+	memo, err := s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-02", Timezone: "Asia/Tokyo"})
+	require.NoError(t, err)
+	require.Equal(t, "2025-01-02", memo.DailyDate)
+	require.Equal(t, "# 2025-01-02", memo.Content)
+	require.Equal(t, time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC), memo.CreateTime.AsTime())
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	require.NoError(t, err)
+	storeMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &storeMemo.ID})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+
+	// The daily memo is got again, in any timezone.
+	again, err := s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-02", Timezone: "America/New_York"})
+	require.NoError(t, err)
+	require.Equal(t, memo.Name, again.Name)
+	_, err = s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-02", Timezone: "Mars/Olympus"})
+	require.Error(t, err)
+
+	// Today is the current day in the timezone.
+	location, err := time.LoadLocation("Pacific/Kiritimati")
+	require.NoError(t, err)
+	today, err := s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Timezone: "Pacific/Kiritimati"})
+	require.NoError(t, err)
+	require.Equal(t, time.Now().In(location).Format(time.DateOnly), today.DailyDate)
+
+	// The memo tagged with the day is the daily memo.
+	tagged, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "notes #daily/2025-01-03", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	memo, err = s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-03"})
+	require.NoError(t, err)
+	require.Equal(t, tagged.Name, memo.Name)
+
+	// The daily memo in the trash is replaced by a new one.
+	deleted := store.Deleted
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, RowStatus: &deleted}))
+	replaced, err := s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-02", Timezone: "Asia/Tokyo"})
+	require.NoError(t, err)
+	require.NotEqual(t, again.Name, replaced.Name)
+	storeMemo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &storeMemo.ID})
+	require.NoError(t, err)
+	require.Empty(t, storeMemo.Payload.DailyDate)
+
+	// The daily memos of the other users are not used.
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	otherMemo, err := s.GetOrCreateDailyMemo(withUser(ctx, other), &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-02"})
+	require.NoError(t, err)
+	require.NotEqual(t, again.Name, otherMemo.Name)
+}
+
+func TestGetOrCreateDailyMemoConcurrently(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "journal", store.RoleUser)
+	userCtx := withUser(ctx, user)
+
+	names := make([]string, 5)
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			memo, err := s.GetOrCreateDailyMemo(userCtx, &v1pb.GetOrCreateDailyMemoRequest{Date: "2025-01-02"})
+			if err == nil {
+				names[i] = memo.Name
+			}
+		}()
+	}
+	wg.Wait()
+	require.NotEmpty(t, names[0])
+	for _, name := range names {
+		require.Equal(t, names[0], name)
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+}
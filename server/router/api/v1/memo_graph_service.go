@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// memoGraphTagNamePrefix is the name prefix of the tag nodes in the memo graph.
+	memoGraphTagNamePrefix = "tags/"
+	// maxMemoGraphMemos is the maximum number of the memos in the memo graph, the most recently created ones are kept.
+	maxMemoGraphMemos = 1000
+
+	graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+)
+
+func (s *APIV1Service) GetMemoGraph(ctx context.Context, request *v1pb.GetMemoGraphRequest) (*httpbody.HttpBody, error) {
+	state := store.Normal
+	limit := maxMemoGraphMemos
+	memoFind := &store.FindMemo{
+		RowStatus: &state,
+		Limit:     &limit,
+	}
+	if request.Parent != "" && request.Parent != "users/-" {
+		userID, err := ExtractUserIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		}
+		memoFind.CreatorID = &userID
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	visibleFilter := getVisibleMemosFilter(currentUser)
+	filter := visibleFilter
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		filter = fmt.Sprintf("(%s) && (%s)", request.Filter, visibleFilter)
+	}
+	memoFind.Filter = &filter
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	// Only the relations between the memos of the graph are listed.
+	relations := []*store.MemoRelation{}
+	if len(memos) > 0 {
+		memoIDs := []int32{}
+		for _, memo := range memos {
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		relations, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+			MemoIDList:        memoIDs,
+			RelatedMemoIDList: memoIDs,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+		}
+	}
+
+	graph, err := buildMemoGraph(memos, relations)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build memo graph: %v", err)
+	}
+	switch request.Format {
+	case v1pb.GetMemoGraphRequest_GRAPHML:
+		data, err := encodeMemoGraphToGraphML(graph)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode memo graph: %v", err)
+		}
+		return &httpbody.HttpBody{
+			ContentType: "application/graphml+xml",
+			Data:        data,
+		}, nil
+	case v1pb.GetMemoGraphRequest_DOT:
+		return &httpbody.HttpBody{
+			ContentType: "text/vnd.graphviz",
+			Data:        encodeMemoGraphToDOT(graph),
+		}, nil
+	default:
+		data, err := protojson.Marshal(graph)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode memo graph: %v", err)
+		}
+		return &httpbody.HttpBody{
+			ContentType: "application/json",
+			Data:        data,
+		}, nil
+	}
+}
+
+// buildMemoGraph builds the graph of the memos, the relations between them and their tags.
+// Relations to memos outside of the list are dropped.
+func buildMemoGraph(memos []*store.Memo, relations []*store.MemoRelation) (*v1pb.MemoGraph, error) {
+	graph := &v1pb.MemoGraph{
+		Nodes: []*v1pb.MemoGraph_Node{},
+		Edges: []*v1pb.MemoGraph_Edge{},
+	}
+	memoNames := map[int32]string{}
+	tags := []string{}
+	cooccurrences := map[[2]string]int32{}
+	for _, memo := range memos {
+		name := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+		memoNames[memo.ID] = name
+		snippet, err := getMemoContentSnippet(memo.Content)
+		if err != nil {
+			return nil, err
+		}
+		graph.Nodes = append(graph.Nodes, &v1pb.MemoGraph_Node{
+			Name:  name,
+			Type:  v1pb.MemoGraph_Node_MEMO,
+			Label: snippet,
+		})
+		if memo.Payload == nil {
+			continue
+		}
+		memoTags := slices.Clone(memo.Payload.Tags)
+		sort.Strings(memoTags)
+		memoTags = slices.Compact(memoTags)
+		for i, tag := range memoTags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+			graph.Edges = append(graph.Edges, &v1pb.MemoGraph_Edge{
+				Source: name,
+				Target: memoGraphTagNamePrefix + tag,
+				Type:   v1pb.MemoGraph_Edge_TAG,
+				Weight: 1,
+			})
+			// The tags are sorted so that each pair is counted once.
+			for _, other := range memoTags[i+1:] {
+				cooccurrences[[2]string{tag, other}]++
+			}
+		}
+	}
+
+	sort.Strings(tags)
+	for _, tag := range tags {
+		graph.Nodes = append(graph.Nodes, &v1pb.MemoGraph_Node{
+			Name:  memoGraphTagNamePrefix + tag,
+			Type:  v1pb.MemoGraph_Node_TAG,
+			Label: "#" + tag,
+		})
+	}
+	for _, relation := range relations {
+		source, ok := memoNames[relation.MemoID]
+		if !ok {
+			continue
+		}
+		target, ok := memoNames[relation.RelatedMemoID]
+		if !ok {
+			continue
+		}
+		edgeType := v1pb.MemoGraph_Edge_REFERENCE
+		if relation.Type == store.MemoRelationComment {
+			edgeType = v1pb.MemoGraph_Edge_COMMENT
+		}
+		graph.Edges = append(graph.Edges, &v1pb.MemoGraph_Edge{
+			Source: source,
+			Target: target,
+			Type:   edgeType,
+			Weight: 1,
+		})
+	}
+	pairs := make([][2]string, 0, len(cooccurrences))
+	for pair := range cooccurrences {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	for _, pair := range pairs {
+		graph.Edges = append(graph.Edges, &v1pb.MemoGraph_Edge{
+			Source: memoGraphTagNamePrefix + pair[0],
+			Target: memoGraphTagNamePrefix + pair[1],
+			Type:   v1pb.MemoGraph_Edge_TAG_COOCCURRENCE,
+			Weight: cooccurrences[pair],
+		})
+	}
+	return graph, nil
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed bool          `xml:"directed,attr"`
+	Data     []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func encodeMemoGraphToGraphML(graph *v1pb.MemoGraph) ([]byte, error) {
+	document := graphML{
+		Xmlns: graphMLNamespace,
+		Keys: []graphMLKey{
+			{ID: "node_type", For: "node", AttrName: "type", AttrType: "string"},
+			{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+			{ID: "edge_type", For: "edge", AttrName: "type", AttrType: "string"},
+			{ID: "weight", For: "edge", AttrName: "weight", AttrType: "int"},
+		},
+		Graph: graphMLGraph{
+			ID:          "memos",
+			EdgeDefault: "directed",
+		},
+	}
+	for _, node := range graph.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLNode{
+			ID: node.Name,
+			Data: []graphMLData{
+				{Key: "node_type", Value: node.Type.String()},
+				{Key: "label", Value: node.Label},
+			},
+		})
+	}
+	for _, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLEdge{
+			Source:   edge.Source,
+			Target:   edge.Target,
+			Directed: edge.Type != v1pb.MemoGraph_Edge_TAG_COOCCURRENCE,
+			Data: []graphMLData{
+				{Key: "edge_type", Value: edge.Type.String()},
+				{Key: "weight", Value: strconv.Itoa(int(edge.Weight))},
+			},
+		})
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buffer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	buffer.WriteString("\n")
+	return buffer.Bytes(), nil
+}
+
+func encodeMemoGraphToDOT(graph *v1pb.MemoGraph) []byte {
+	var builder strings.Builder
+	builder.WriteString("digraph memos {\n")
+	for _, node := range graph.Nodes {
+		shape := "box"
+		if node.Type == v1pb.MemoGraph_Node_TAG {
+			shape = "ellipse"
+		}
+		builder.WriteString(fmt.Sprintf("  %s [label=%s, shape=%s];\n", quoteDOTString(node.Name), quoteDOTString(node.Label), shape))
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{fmt.Sprintf("label=%s", quoteDOTString(edge.Type.String()))}
+		if edge.Type == v1pb.MemoGraph_Edge_TAG_COOCCURRENCE {
+			attributes = append(attributes, "dir=none", fmt.Sprintf("weight=%d", edge.Weight))
+		}
+		builder.WriteString(fmt.Sprintf("  %s -> %s [%s];\n", quoteDOTString(edge.Source), quoteDOTString(edge.Target), strings.Join(attributes, ", ")))
+	}
+	builder.WriteString("}\n")
+	return []byte(builder.String())
+}
+
+// quoteDOTString returns the string as a quoted DOT identifier.
+func quoteDOTString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestBuildMemoGraph(t *testing.T) {
+	memos := []*store.Memo{
+		{ID: 1, UID: "a", Content: "memo a", Payload: &storepb.MemoPayload{Tags: []string{"work", "idea", "work"}}},
+		{ID: 2, UID: "b", Content: "memo b", Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+		{ID: 3, UID: "c", Content: "memo c"},
+	}
+	relations := []*store.MemoRelation{
+		{MemoID: 1, RelatedMemoID: 2, Type: store.MemoRelationReference},
+		{MemoID: 3, RelatedMemoID: 1, Type: store.MemoRelationComment},
+		// The relations to the memos outside of the list are dropped.
+		{MemoID: 1, RelatedMemoID: 4, Type: store.MemoRelationReference},
+	}
+	graph, err := buildMemoGraph(memos, relations)
+	require.NoError(t, err)
+
+	nodes := []string{}
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, node.Type.String()+" "+node.Name+" "+node.Label)
+	}
+	require.Equal(t, []string{
+		"MEMO memos/a memo a\n",
+		"MEMO memos/b memo b\n",
+		"MEMO memos/c memo c\n",
+		"TAG tags/idea #idea",
+		"TAG tags/work #work",
+	}, nodes)
+	require.Equal(t, []*v1pb.MemoGraph_Edge{
+		{Source: "memos/a", Target: "tags/idea", Type: v1pb.MemoGraph_Edge_TAG, Weight: 1},
+		{Source: "memos/a", Target: "tags/work", Type: v1pb.MemoGraph_Edge_TAG, Weight: 1},
+		{Source: "memos/b", Target: "tags/work", Type: v1pb.MemoGraph_Edge_TAG, Weight: 1},
+		{Source: "memos/a", Target: "memos/b", Type: v1pb.MemoGraph_Edge_REFERENCE, Weight: 1},
+		{Source: "memos/c", Target: "memos/a", Type: v1pb.MemoGraph_Edge_COMMENT, Weight: 1},
+		{Source: "tags/idea", Target: "tags/work", Type: v1pb.MemoGraph_Edge_TAG_COOCCURRENCE, Weight: 1},
+	}, graph.Edges)
+}
+
+func TestEncodeMemoGraphToGraphML(t *testing.T) {
+	data, err := encodeMemoGraphToGraphML(newTestingMemoGraph())
+	require.NoError(t, err)
+
+	// The labels are escaped, and only the tag co-occurrences are undirected.
+	document := graphML{}
+	require.NoError(t, xml.Unmarshal(data, &document))
+	require.Equal(t, graphMLNamespace, document.Xmlns)
+	require.Equal(t, "directed", document.Graph.EdgeDefault)
+	require.Len(t, document.Graph.Nodes, 3)
+	require.Equal(t, "memos/a", document.Graph.Nodes[0].ID)
+	require.Equal(t, []graphMLData{{Key: "node_type", Value: "MEMO"}, {Key: "label", Value: "say <\"hi\">\n& bye"}}, document.Graph.Nodes[0].Data)
+	require.Len(t, document.Graph.Edges, 2)
+	require.True(t, document.Graph.Edges[0].Directed)
+	require.Equal(t, graphMLEdge{
+		Source:   "tags/idea",
+		Target:   "tags/work",
+		Directed: false,
+		Data:     []graphMLData{{Key: "edge_type", Value: "TAG_COOCCURRENCE"}, {Key: "weight", Value: "2"}},
+	}, document.Graph.Edges[1])
+}
+
+func TestEncodeMemoGraphToDOT(t *testing.T) {
+	require.Equal(t, `digraph memos {
+  "memos/a" [label="say <\"hi\">\n& bye", shape=box];
+  "tags/idea" [label="#idea", shape=ellipse];
+  "tags/work" [label="#work", shape=ellipse];
+  "memos/a" -> "tags/work" [label="TAG"];
+  "tags/idea" -> "tags/work" [label="TAG_COOCCURRENCE", dir=none, weight=2];
+}
+`, string(encodeMemoGraphToDOT(newTestingMemoGraph())))
+}
+
+func TestGetMemoGraph(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	public := createTestingWatchedMemo(ctx, t, s, owner, "public", "public", store.Public)
+	protected := createTestingWatchedMemo(ctx, t, s, owner, "protected", "protected", store.Protected)
+	private := createTestingWatchedMemo(ctx, t, s, other, "private", "private", store.Private)
+	for _, relation := range []*store.MemoRelation{
+		{MemoID: protected.ID, RelatedMemoID: public.ID, Type: store.MemoRelationReference},
+		{MemoID: private.ID, RelatedMemoID: public.ID, Type: store.MemoRelationReference},
+	} {
+		_, err := s.Store.UpsertMemoRelation(ctx, relation)
+		require.NoError(t, err)
+	}
+	getGraph := func(ctx context.Context, filter string) *v1pb.MemoGraph {
+		body, err := s.GetMemoGraph(ctx, &v1pb.GetMemoGraphRequest{Filter: filter})
+		require.NoError(t, err)
+		require.Equal(t, "application/json", body.ContentType)
+		graph := &v1pb.MemoGraph{}
+		require.NoError(t, protojson.Unmarshal(body.Data, graph))
+		return graph
+	}
+
+	// Only the relations between the memos of the graph are included.
+	for _, tc := range []struct {
+		ctx    context.Context
+		filter string
+		nodes  int
+		edges  int
+	}{
+		{ctx: ctx, nodes: 1, edges: 0},
+		{ctx: withUser(ctx, owner), nodes: 2, edges: 1},
+		{ctx: withUser(ctx, other), nodes: 3, edges: 2},
+		{ctx: withUser(ctx, other), filter: `visibility == "PRIVATE" || visibility == "PUBLIC"`, nodes: 2, edges: 1},
+	} {
+		graph := getGraph(tc.ctx, tc.filter)
+		require.Len(t, graph.Nodes, tc.nodes)
+		require.Len(t, graph.Edges, tc.edges)
+	}
+}
+
+func newTestingMemoGraph() *v1pb.MemoGraph {
+	return &v1pb.MemoGraph{
+		Nodes: []*v1pb.MemoGraph_Node{
+			{Name: "memos/a", Type: v1pb.MemoGraph_Node_MEMO, Label: "say <\"hi\">\n& bye"},
+			{Name: "tags/idea", Type: v1pb.MemoGraph_Node_TAG, Label: "#idea"},
+			{Name: "tags/work", Type: v1pb.MemoGraph_Node_TAG, Label: "#work"},
+		},
+		Edges: []*v1pb.MemoGraph_Edge{
+			{Source: "memos/a", Target: "tags/work", Type: v1pb.MemoGraph_Edge_TAG, Weight: 1},
+			{Source: "tags/idea", Target: "tags/work", Type: v1pb.MemoGraph_Edge_TAG_COOCCURRENCE, Weight: 2},
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const maxMapZoom = 22
+
+func (s *APIV1Service) ListMemoLocations(ctx context.Context, request *v1pb.ListMemoLocationsRequest) (*v1pb.ListMemoLocationsResponse, error) {
+	if request.Zoom != nil && (*request.Zoom < 0 || *request.Zoom > maxMapZoom) {
+		return nil, status.Errorf(codes.InvalidArgument, "zoom must be between 0 and %d", maxMapZoom)
+	}
+	state := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:       &state,
+		ExcludeContent:  true,
+		ExcludeComments: true,
+		PayloadFind: &store.FindMemoPayload{
+			HasLocation: true,
+		},
+	}
+	if request.Parent != "" && request.Parent != "users/-" {
+		userID, err := ExtractUserIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		}
+		memoFind.CreatorID = &userID
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	filter := getVisibleMemosFilter(currentUser)
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		filter = fmt.Sprintf("(%s) && (%s)", request.Filter, filter)
+	}
+	memoFind.Filter = &filter
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	return &v1pb.ListMemoLocationsResponse{
+		Clusters: clusterMemoLocations(memos, request.Zoom),
+	}, nil
+}
+
+// clusterMemoLocations groups the memos by the grid cell of their location at the zoom level.
+// Without a zoom level, only the memos at the same location are grouped.
+// The larger clusters come first.
+func clusterMemoLocations(memos []*store.Memo, zoom *int32) []*v1pb.ListMemoLocationsResponse_Cluster {
+	cellSize := 0.0
+	if zoom != nil {
+		cellSize = 360 / math.Pow(2, float64(*zoom+3))
+	}
+	clusters := []*v1pb.ListMemoLocationsResponse_Cluster{}
+	clusterMap := map[[2]float64]*v1pb.ListMemoLocationsResponse_Cluster{}
+	for _, memo := range memos {
+		location := memo.Payload.GetLocation()
+		if location == nil {
+			continue
+		}
+		key := [2]float64{location.Latitude, location.Longitude}
+		if cellSize > 0 {
+			key = [2]float64{math.Floor(location.Latitude / cellSize), math.Floor(location.Longitude / cellSize)}
+		}
+		cluster, ok := clusterMap[key]
+		if !ok {
+			cluster = &v1pb.ListMemoLocationsResponse_Cluster{
+				Memos: []string{},
+			}
+			clusterMap[key] = cluster
+			clusters = append(clusters, cluster)
+		}
+		// The coordinates are summed up here and averaged below.
+		cluster.Latitude += location.Latitude
+		cluster.Longitude += location.Longitude
+		cluster.Count++
+		cluster.Memos = append(cluster.Memos, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+	}
+	for _, cluster := range clusters {
+		cluster.Latitude /= float64(cluster.Count)
+		cluster.Longitude /= float64(cluster.Count)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Count > clusters[j].Count
+	})
+	return clusters
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -22,13 +24,36 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 	referenceType := store.MemoRelationReference
-	// Delete all reference relations first.
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+	// Delete the reference relations first, except the ones of the references in the content.
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
 		MemoID: &memo.ID,
 		Type:   &referenceType,
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relation")
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+	}
+	contentReferenceUIDs := getMemoReferenceUIDs(memo)
+	referencedMemoIDs := []int32{}
+	for _, relation := range relations {
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.RelatedMemoID, ExcludeContent: true})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get related memo")
+		}
+		if relatedMemo != nil && slices.Contains(contentReferenceUIDs, relatedMemo.UID) {
+			referencedMemoIDs = append(referencedMemoIDs, relation.RelatedMemoID)
+			continue
+		}
+		if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &relation.RelatedMemoID,
+			Type:          &referenceType,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete memo relation")
+		}
 	}
 
 	for _, relation := range request.Relations {
@@ -49,10 +74,21 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get related memo")
 		}
+		if relatedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "related memo not found")
+		}
+		relationType := convertMemoRelationTypeToStore(relation.Type)
+		// The references kept or set already are stored once.
+		if relationType == store.MemoRelationReference {
+			if slices.Contains(referencedMemoIDs, relatedMemo.ID) {
+				continue
+			}
+			referencedMemoIDs = append(referencedMemoIDs, relatedMemo.ID)
+		}
 		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
 			MemoID:        memo.ID,
 			RelatedMemoID: relatedMemo.ID,
-			Type:          convertMemoRelationTypeToStore(relation.Type),
+			Type:          relationType,
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
@@ -75,12 +111,7 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	var memoFilter string
-	if currentUser == nil {
-		memoFilter = `visibility == "PUBLIC"`
-	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-	}
+	memoFilter := getVisibleMemosFilter(currentUser)
 	relationList := []*v1pb.MemoRelation{}
 	tempList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
 		MemoID:     &memo.ID,
@@ -117,6 +148,207 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	return response, nil
 }
 
+func (s *APIV1Service) ListMemoBacklinks(ctx context.Context, request *v1pb.ListMemoBacklinksRequest) (*v1pb.ListMemoBacklinksResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	canRead, err := s.canReadMemo(ctx, memo, currentUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo permission: %v", err)
+	}
+	if !canRead {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	memoFilter := getVisibleMemosFilter(currentUser)
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &memo.ID,
+		Type:          &referenceType,
+		MemoFilter:    &memoFilter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	response := &v1pb.ListMemoBacklinksResponse{
+		Backlinks: []*v1pb.MemoRelation_Memo{},
+	}
+	for _, relation := range relations {
+		backlink, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo")
+		}
+		// Memos in the trash don't link to anything.
+		if backlink == nil || backlink.RowStatus == store.Deleted {
+			continue
+		}
+		snippet, err := getMemoContentSnippet(backlink.Content)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo content snippet")
+		}
+		response.Backlinks = append(response.Backlinks, &v1pb.MemoRelation_Memo{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, backlink.UID),
+			Uid:     backlink.UID,
+			Snippet: snippet,
+		})
+	}
+	return response, nil
+}
+
+// getMemoReferenceUIDs returns the UIDs of the memos referenced in the memo content,
+// i.e. `[[memos/{uid}]]` and `![[memos/{uid}]]`.
+func getMemoReferenceUIDs(memo *store.Memo) []string {
+	uids := []string{}
+	if memo.Payload == nil || memo.Payload.Property == nil {
+		return uids
+	}
+	for _, reference := range memo.Payload.Property.References {
+		uid, err := ExtractMemoUIDFromName(reference)
+		if err != nil || uid == memo.UID || slices.Contains(uids, uid) {
+			continue
+		}
+		uids = append(uids, uid)
+	}
+	return uids
+}
+
+// resolveMemoReferences returns the memos referenced in the memo content.
+// New references must point to memos readable by the memo creator, while the references
+// in previousUIDs are skipped once their memos are gone so that old memos stay editable.
+func (s *APIV1Service) resolveMemoReferences(ctx context.Context, memo *store.Memo, previousUIDs []string) ([]*store.Memo, error) {
+	uids := getMemoReferenceUIDs(memo)
+	if len(uids) == 0 {
+		return []*store.Memo{}, nil
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo creator")
+	}
+
+	referencedMemos := []*store.Memo{}
+	for _, uid := range uids {
+		referencedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get referenced memo")
+		}
+		canRead := false
+		if referencedMemo != nil && referencedMemo.RowStatus != store.Deleted {
+			canRead, err = s.canReadMemo(ctx, referencedMemo, creator)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check memo permission: %v", err)
+			}
+		}
+		if !canRead {
+			if slices.Contains(previousUIDs, uid) {
+				continue
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "referenced memo %s%s not found", MemoNamePrefix, uid)
+		}
+		referencedMemos = append(referencedMemos, referencedMemo)
+	}
+	return referencedMemos, nil
+}
+
+// syncMemoReferences stores the references in the memo content as reference relations,
+// and prunes the relations of the references removed from previousUIDs.
+func (s *APIV1Service) syncMemoReferences(ctx context.Context, memo *store.Memo, referencedMemos []*store.Memo, previousUIDs []string) error {
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID: &memo.ID,
+		Type:   &referenceType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, referencedMemo := range referencedMemos {
+		// The relations are unique, so the ones stored already are kept.
+		if !slices.ContainsFunc(relations, func(relation *store.MemoRelation) bool {
+			return relation.RelatedMemoID == referencedMemo.ID
+		}) {
+			if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        memo.ID,
+				RelatedMemoID: referencedMemo.ID,
+				Type:          store.MemoRelationReference,
+			}); err != nil {
+				return errors.Wrap(err, "failed to upsert memo relation")
+			}
+		}
+		if slices.Contains(previousUIDs, referencedMemo.UID) {
+			continue
+		}
+		if err := s.notifyMemoReference(ctx, memo, referencedMemo); err != nil {
+			return err
+		}
+	}
+
+	uids := getMemoReferenceUIDs(memo)
+	for _, uid := range previousUIDs {
+		if slices.Contains(uids, uid) {
+			continue
+		}
+		referencedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		if err != nil {
+			return errors.Wrap(err, "failed to get referenced memo")
+		}
+		if referencedMemo == nil {
+			continue
+		}
+		if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &referencedMemo.ID,
+			Type:          &referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+	return nil
+}
+
+// notifyMemoReference sends the new reference to the inbox of the creator of the referenced memo,
+// if the creator is someone else who can read the memo.
+func (s *APIV1Service) notifyMemoReference(ctx context.Context, memo *store.Memo, referencedMemo *store.Memo) error {
+	if referencedMemo.CreatorID == memo.CreatorID {
+		return nil
+	}
+	receiver, err := s.Store.GetUser(ctx, &store.FindUser{ID: &referencedMemo.CreatorID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if receiver == nil {
+		return nil
+	}
+	canRead, err := s.canReadMemo(ctx, memo, receiver)
+	if err != nil {
+		return errors.Wrap(err, "failed to check memo access")
+	}
+	if !canRead {
+		return nil
+	}
+	return s.sendActivityToInbox(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoReference,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReference: &storepb.ActivityMemoReferencePayload{
+				MemoId:        memo.ID,
+				RelatedMemoId: referencedMemo.ID,
+			},
+		},
+	}, receiver.ID, storepb.InboxMessage_MEMO_REFERENCE)
+}
+
 func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRelation *store.MemoRelation) (*v1pb.MemoRelation, error) {
 	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.MemoID})
 	if err != nil {
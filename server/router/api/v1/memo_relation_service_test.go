@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func TestResolveMemoReferences(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	target := createTestingWatchedMemo(ctx, t, s, owner, "target", "target", store.Private)
+	shared := createTestingWatchedMemo(ctx, t, s, other, "shared", "shared", store.Protected)
+	createTestingWatchedMemo(ctx, t, s, other, "secret", "secret", store.Private)
+	trashed := createTestingWatchedMemo(ctx, t, s, owner, "trashed", "trashed", store.Private)
+	rowStatus := store.Deleted
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: trashed.ID, RowStatus: &rowStatus}))
+	resolve := func(content string, previousUIDs []string) ([]*store.Memo, error) {
+		memo := &store.Memo{UID: "memo", CreatorID: owner.ID, Content: content}
+		require.NoError(t, memopayload.RebuildMemoPayload(memo))
+		return s.resolveMemoReferences(ctx, memo, previousUIDs)
+	}
+
+	// The references are resolved once, skipping the memo itself.
+	memos, err := resolve("[[memos/target]] [[memos/shared]] [[memos/target]] [[memos/memo]]", nil)
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+	require.Equal(t, []int32{target.ID, shared.ID}, []int32{memos[0].ID, memos[1].ID})
+
+	// The new references must point to the memos readable by the creator.
+	for _, uid := range []string{"missing", "secret", "trashed"} {
+		_, err := resolve("[[memos/"+uid+"]]", nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), uid)
+	}
+
+	// The previous references to the memos gone are skipped.
+	memos, err = resolve("[[memos/target]] [[memos/missing]] [[memos/trashed]]", []string{"missing", "trashed"})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, target.ID, memos[0].ID)
+}
+
+func TestSyncMemoReferences(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	ownerCtx := withUser(ctx, owner)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	target := createTestingWatchedMemo(ctx, t, s, owner, "target", "target", store.Public)
+	shared := createTestingWatchedMemo(ctx, t, s, other, "shared", "shared", store.Public)
+	extra := createTestingWatchedMemo(ctx, t, s, owner, "extra", "extra", store.Public)
+
+	// The references in the content are stored as relations, and the creators of the memos referenced are notified.
+	memo, err := s.CreateMemo(ownerCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "[[memos/target]] [[memos/shared]]",
+		Visibility: v1pb.Visibility_PUBLIC,
+	}})
+	require.NoError(t, err)
+	storeMemo := getTestingBatchMemo(ctx, t, s, getTestingMemoID(ctx, t, s, memo.Name))
+	require.ElementsMatch(t, []int32{target.ID, shared.ID}, listTestingReferencedMemoIDs(ctx, t, s, storeMemo.ID))
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &other.ID})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+	require.Equal(t, storepb.InboxMessage_MEMO_REFERENCE, inboxes[0].Message.Type)
+
+	// Setting the relations keeps the references in the content.
+	setRelations := func(relatedMemos ...*store.Memo) {
+		relations := []*v1pb.MemoRelation{}
+		for _, relatedMemo := range relatedMemos {
+			relations = append(relations, &v1pb.MemoRelation{
+				Memo:        &v1pb.MemoRelation_Memo{Name: memo.Name},
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: "memos/" + relatedMemo.UID},
+				Type:        v1pb.MemoRelation_REFERENCE,
+			})
+		}
+		_, err := s.SetMemoRelations(ownerCtx, &v1pb.SetMemoRelationsRequest{Name: memo.Name, Relations: relations})
+		require.NoError(t, err)
+	}
+	setRelations(target, extra, extra)
+	require.ElementsMatch(t, []int32{target.ID, shared.ID, extra.ID}, listTestingReferencedMemoIDs(ctx, t, s, storeMemo.ID))
+	setRelations()
+	require.ElementsMatch(t, []int32{target.ID, shared.ID}, listTestingReferencedMemoIDs(ctx, t, s, storeMemo.ID))
+
+	// The references removed from the content are pruned, and the ones kept aren't notified again.
+	_, err = s.UpdateMemo(ownerCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "[[memos/shared]]"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{shared.ID}, listTestingReferencedMemoIDs(ctx, t, s, storeMemo.ID))
+	inboxes, err = s.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &other.ID})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+}
+
+func TestListMemoBacklinks(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	reader := createTestingUser(ctx, t, s, "reader", store.RoleUser)
+	target := createTestingWatchedMemo(ctx, t, s, owner, "target", "target", store.Public)
+	secret := createTestingWatchedMemo(ctx, t, s, owner, "secret", "secret", store.Private)
+	for _, backlink := range []*store.Memo{
+		createTestingWatchedMemo(ctx, t, s, owner, "public", "public [[memos/target]]", store.Public),
+		createTestingWatchedMemo(ctx, t, s, other, "private", "private [[memos/target]]", store.Private),
+		createTestingWatchedMemo(ctx, t, s, owner, "trashed", "trashed [[memos/target]]", store.Public),
+	} {
+		_, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: backlink.ID, RelatedMemoID: target.ID, Type: store.MemoRelationReference})
+		require.NoError(t, err)
+	}
+	trashedUID := "trashed"
+	trashed, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &trashedUID})
+	require.NoError(t, err)
+	rowStatus := store.Deleted
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: trashed.ID, RowStatus: &rowStatus}))
+
+	// Only the backlinks visible to the user are listed, and the memos in the trash are skipped.
+	for _, tc := range []struct {
+		ctx       context.Context
+		backlinks []string
+	}{
+		{ctx: ctx, backlinks: []string{"memos/public"}},
+		{ctx: withUser(ctx, reader), backlinks: []string{"memos/public"}},
+		{ctx: withUser(ctx, other), backlinks: []string{"memos/public", "memos/private"}},
+	} {
+		response, err := s.ListMemoBacklinks(tc.ctx, &v1pb.ListMemoBacklinksRequest{Name: "memos/target"})
+		require.NoError(t, err)
+		names := []string{}
+		for _, backlink := range response.Backlinks {
+			names = append(names, backlink.Name)
+		}
+		require.ElementsMatch(t, tc.backlinks, names)
+	}
+
+	// The backlinks of the memos unreadable by the user are denied.
+	_, err = s.ListMemoBacklinks(withUser(ctx, reader), &v1pb.ListMemoBacklinksRequest{Name: "memos/" + secret.UID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ListMemoBacklinks(withUser(ctx, reader), &v1pb.ListMemoBacklinksRequest{Name: "memos/missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func getTestingMemoID(ctx context.Context, t *testing.T, s *APIV1Service, name string) int32 {
+	uid, err := ExtractMemoUIDFromName(name)
+	require.NoError(t, err)
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	require.NoError(t, err)
+	return memo.ID
+}
+
+func listTestingReferencedMemoIDs(ctx context.Context, t *testing.T, s *APIV1Service, memoID int32) []int32 {
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memoID, Type: &referenceType})
+	require.NoError(t, err)
+	ids := []int32{}
+	for _, relation := range relations {
+		ids = append(ids, relation.RelatedMemoID)
+	}
+	return ids
+}
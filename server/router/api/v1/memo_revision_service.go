@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForRevisionAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(revisions) == limitPlusOne {
+		revisions = revisions[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions:     []*v1pb.MemoRevision{},
+		NextPageToken: nextPageToken,
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memo, revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemoRevisionFromStore(memo, revision), nil
+}
+
+// RestoreMemoRevision restores the content, the visibility and the payload inputs of the revision,
+// i.e. the location, the publish time with its target visibility and the reminders.
+// The publish time passed already isn't restored, as the memo has been published since, and neither are
+// the one-off reminders delivered already, so they aren't delivered again. The daily date is kept.
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := &v1pb.Memo{
+		Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+		Location:   convertLocationFromStore(revision.Payload.GetLocation()),
+		UpdateTime: timestamppb.New(time.Now()),
+	}
+	if publishTime := revision.Payload.GetPublishTime(); publishTime != nil && publishTime.AsTime().After(time.Now()) {
+		restored.PublishTime = publishTime
+		restored.TargetVisibility = convertVisibilityFromStore(store.Visibility(revision.Payload.GetTargetVisibility()))
+	}
+	reminders := []*storepb.MemoPayload_Reminder{}
+	for _, reminder := range revision.Payload.GetReminders() {
+		delivered := reminder.Cron == "" && reminder.LastRemindTime != nil
+		if delivered && !slices.ContainsFunc(memo.Payload.GetReminders(), func(current *storepb.MemoPayload_Reminder) bool {
+			return current.Cron == "" && proto.Equal(current.RemindTime, reminder.RemindTime)
+		}) {
+			continue
+		}
+		reminders = append(reminders, reminder)
+	}
+	restored.Reminders = convertMemoRemindersFromStore(reminders)
+
+	// Restoring goes through UpdateMemo so that the current state is kept as a new revision.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: restored,
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"content", "visibility", "location", "publish_time", "reminders", "update_time"},
+		},
+	})
+}
+
+func (s *APIV1Service) DiffMemoRevisions(ctx context.Context, request *v1pb.DiffMemoRevisionsRequest) (*v1pb.DiffMemoRevisionsResponse, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	baseName, baseContent := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID), memo.Content
+	if request.Base != "" {
+		baseMemo, baseRevision, err := s.getMemoRevisionByName(ctx, request.Base)
+		if err != nil {
+			return nil, err
+		}
+		if baseMemo.ID != memo.ID {
+			return nil, status.Errorf(codes.InvalidArgument, "revisions belong to different memos")
+		}
+		baseName, baseContent = request.Base, baseRevision.Content
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(baseContent),
+		B:        difflib.SplitLines(revision.Content),
+		FromFile: baseName,
+		ToFile:   request.Name,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to diff memo revisions: %v", err)
+	}
+	return &v1pb.DiffMemoRevisionsResponse{
+		Diff: diff,
+	}, nil
+}
+
+// getMemoForRevisionAccess returns the memo if the current user is allowed to see its revisions.
+func (s *APIV1Service) getMemoForRevisionAccess(ctx context.Context, memoUID string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Only the creator or admin can access the memo revisions.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func (s *APIV1Service) getMemoRevisionByName(ctx context.Context, name string) (*store.Memo, *store.MemoRevision, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisionAccess(ctx, memoUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo revision")
+	}
+	if revision == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	return memo, revision, nil
+}
+
+func convertMemoRevisionFromStore(memo *store.Memo, revision *store.MemoRevision) *v1pb.MemoRevision {
+	return &v1pb.MemoRevision{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, revision.ID),
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+		Tags:       revision.Payload.GetTags(),
+		Location:   convertLocationFromStore(revision.Payload.GetLocation()),
+	}
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestRestoreMemoRevision(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	now := time.Now().Truncate(time.Second)
+	memo := createTestingBatchMemo(ctx, t, s, user, "memo", "current")
+	restore := func(payload *storepb.MemoPayload) *store.Memo {
+		revision, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+			MemoID:     memo.ID,
+			Content:    "restored",
+			Visibility: store.Protected,
+			Payload:    payload,
+		})
+		require.NoError(t, err)
+		_, err = s.RestoreMemoRevision(userCtx, &v1pb.RestoreMemoRevisionRequest{
+			Name: fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, revision.ID),
+		})
+		require.NoError(t, err)
+		return getTestingBatchMemo(ctx, t, s, memo.ID)
+	}
+
+	// The content, the visibility and the payload inputs are restored.
+	publishTime := timestamppb.New(now.Add(time.Hour))
+	upcoming := &storepb.MemoPayload_Reminder{RemindTime: timestamppb.New(now.Add(time.Hour))}
+	recurring := &storepb.MemoPayload_Reminder{Cron: "0 9 * * MON"}
+	delivered := &storepb.MemoPayload_Reminder{RemindTime: timestamppb.New(now.Add(-time.Hour)), LastRemindTime: timestamppb.New(now.Add(-time.Hour))}
+	restored := restore(&storepb.MemoPayload{
+		Location:         &storepb.MemoPayload_Location{Placeholder: "home", Latitude: 1, Longitude: 2},
+		PublishTime:      publishTime,
+		TargetVisibility: string(store.Public),
+		Reminders:        []*storepb.MemoPayload_Reminder{recurring, upcoming, delivered},
+	})
+	require.Equal(t, "restored", restored.Content)
+	require.Equal(t, store.Protected, restored.Visibility)
+	require.Equal(t, "home", restored.Payload.Location.Placeholder)
+	require.Equal(t, publishTime.AsTime(), restored.Payload.PublishTime.AsTime())
+	require.Equal(t, string(store.Public), restored.Payload.TargetVisibility)
+	// The one-off reminders delivered already aren't restored, so they aren't delivered again.
+	require.Len(t, restored.Payload.Reminders, 2)
+	require.Equal(t, recurring.Cron, restored.Payload.Reminders[0].Cron)
+	require.Equal(t, upcoming.RemindTime.AsTime(), restored.Payload.Reminders[1].RemindTime.AsTime())
+
+	// The publish time passed already isn't restored, and the inputs missing from the revision are cleared.
+	restored = restore(&storepb.MemoPayload{
+		PublishTime:      timestamppb.New(now.Add(-time.Hour)),
+		TargetVisibility: string(store.Public),
+	})
+	require.Nil(t, restored.Payload.Location)
+	require.Nil(t, restored.Payload.PublishTime)
+	require.Empty(t, restored.Payload.TargetVisibility)
+	require.Empty(t, restored.Payload.Reminders)
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	if len(store.SplitMemoSearchQuery(request.Query)) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	state := store.Normal
+	search := &store.SearchMemo{
+		Query:           strings.TrimSpace(request.Query),
+		RowStatus:       &state,
+		ExcludeComments: true,
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	filter := getVisibleMemosFilter(currentUser)
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		filter = fmt.Sprintf("(%s) && (%s)", request.Filter, filter)
+	}
+	search.Filter = &filter
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	search.Limit = &limitPlusOne
+	search.Offset = &offset
+	results, err := s.Store.SearchMemos(ctx, search)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(results) == limitPlusOne {
+		results = results[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.SearchMemosResponse{
+		Results:       []*v1pb.SearchMemosResponse_Result{},
+		NextPageToken: nextPageToken,
+	}
+	for _, result := range results {
+		memoMessage, err := s.convertMemoFromStore(ctx, result.Memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		response.Results = append(response.Results, &v1pb.SearchMemosResponse_Result{
+			Memo:    memoMessage,
+			Snippet: result.Snippet,
+			Score:   result.Rank,
+		})
+	}
+	return response, nil
+}
@@ -2,8 +2,13 @@ package v1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -15,7 +20,9 @@ import (
 	"github.com/usememos/gomark/renderer"
 	"github.com/usememos/gomark/restore"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -23,10 +30,16 @@ import (
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/server/runner/trash"
 	"github.com/usememos/memos/store"
 )
 
 func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	return s.createMemo(ctx, request, nil)
+}
+
+// createMemo creates the memo of the request, keyed as the daily memo of the day if daily is set.
+func (s *APIV1Service) createMemo(ctx context.Context, request *v1pb.CreateMemoRequest, daily *dailyMemoKey) (*v1pb.Memo, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -55,9 +68,32 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err := memopayload.RebuildMemoPayload(create); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 	}
+	referencedMemos, err := s.resolveMemoReferences(ctx, create, nil)
+	if err != nil {
+		return nil, err
+	}
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
+	if request.Memo.PublishTime != nil {
+		targetVisibility, err := s.getMemoTargetVisibility(ctx, request.Memo.TargetVisibility)
+		if err != nil {
+			return nil, err
+		}
+		create.Payload.PublishTime = request.Memo.PublishTime
+		create.Payload.TargetVisibility = targetVisibility.String()
+	}
+	if len(request.Memo.Reminders) > 0 {
+		reminders, err := convertMemoRemindersToStore(request.Memo.Reminders, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reminders: %v", err)
+		}
+		create.Payload.Reminders = reminders
+	}
+	if daily != nil {
+		create.Payload.DailyDate = daily.Date
+		create.CreatedTs = daily.CreatedTs
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -81,6 +117,14 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 			return nil, errors.Wrap(err, "failed to set memo relations")
 		}
 	}
+	if err := s.syncMemoReferences(ctx, memo, referencedMemos, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+	}
+
+	// The memo is created already, so the mentions failed to notify are only logged.
+	if err := s.notifyMemoMentions(ctx, memo, nil); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo)
 	if err != nil {
@@ -113,6 +157,9 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if request.State == v1pb.State_ARCHIVED {
 		state := store.Archived
 		memoFind.RowStatus = &state
+	} else if request.State == v1pb.State_DELETED {
+		state := store.Deleted
+		memoFind.RowStatus = &state
 	} else {
 		state := store.Normal
 		memoFind.RowStatus = &state
@@ -120,6 +167,13 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if request.Direction == v1pb.Direction_ASC {
 		memoFind.OrderByTimeAsc = true
 	}
+	if request.Sort != "" {
+		orderBy, err := s.parseMemoOrderBy(ctx, request.Sort)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
+		}
+		memoFind.OrderBy = orderBy
+	}
 	if request.Filter != "" {
 		if err := s.validateFilter(ctx, request.Filter); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
@@ -131,19 +185,34 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
+	// The trash is private to its owner.
+	if request.State == v1pb.State_DELETED {
+		if currentUser == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		if memoFind.CreatorID == nil {
+			memoFind.CreatorID = &currentUser.ID
+		} else if *memoFind.CreatorID != currentUser.ID && !isSuperUser(currentUser) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
 	if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else {
+		// Memos granted to the current user are visible regardless of their visibility.
+		var internalFilter string
 		if memoFind.CreatorID == nil {
-			internalFilter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+			internalFilter = getVisibleMemosFilter(currentUser)
+		} else if *memoFind.CreatorID != currentUser.ID {
+			internalFilter = fmt.Sprintf(`visibility in ["PUBLIC", "PROTECTED"] || %s`, getGrantedMemosFilter(currentUser.ID))
+		}
+		if internalFilter != "" {
 			if memoFind.Filter != nil {
 				filter := fmt.Sprintf("(%s) && (%s)", *memoFind.Filter, internalFilter)
 				memoFind.Filter = &filter
 			} else {
 				memoFind.Filter = &internalFilter
 			}
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
 		}
 	}
 
@@ -162,7 +231,23 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 		}
 		limit = int(pageToken.Limit)
-		offset = int(pageToken.Offset)
+		// The page tokens without a cursor are still paginated by the offset.
+		if cursor := pageToken.MemoCursor; cursor != nil {
+			// The position of the cursor is meaningless in another order, e.g. if the sort is changed between the pages.
+			if cursor.OrderBy != store.FormatMemoOrderBy(store.GetMemoOrderBy(memoFind)) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid page token: the sort order is changed")
+			}
+			memoFind.Cursor = &store.MemoCursor{
+				ID:            cursor.Id,
+				Pinned:        cursor.Pinned,
+				CreatedTs:     cursor.CreatedTs,
+				UpdatedTs:     cursor.UpdatedTs,
+				ReactionCount: cursor.ReactionCount,
+				Title:         cursor.Title,
+			}
+		} else {
+			offset = int(pageToken.Offset)
+		}
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -181,7 +266,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		nextPageToken, err = s.getMemoCursorPageToken(ctx, limit, memos[limit-1], store.GetMemoOrderBy(memoFind))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -201,6 +286,83 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	return response, nil
 }
 
+// memoOrderByFields maps the fields of the sort to the order fields of the memos.
+var memoOrderByFields = map[string]store.MemoOrderField{
+	"pinned":         store.MemoOrderFieldPinned,
+	"create_time":    store.MemoOrderFieldCreatedTs,
+	"update_time":    store.MemoOrderFieldUpdatedTs,
+	"reaction_count": store.MemoOrderFieldReactionCount,
+	"title":          store.MemoOrderFieldTitle,
+}
+
+// parseMemoOrderBy parses the AIP-132 order by, e.g. "update_time desc, pinned desc, title".
+func (s *APIV1Service) parseMemoOrderBy(ctx context.Context, orderBy string) ([]*store.MemoOrder, error) {
+	orders := []*store.MemoOrder{}
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, errors.Errorf("invalid order %q", strings.TrimSpace(item))
+		}
+		order := &store.MemoOrder{}
+		if parts[0] == "display_time" {
+			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace memo related setting")
+			}
+			order.Field = store.MemoOrderFieldCreatedTs
+			if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+				order.Field = store.MemoOrderFieldUpdatedTs
+			}
+		} else {
+			field, ok := memoOrderByFields[parts[0]]
+			if !ok {
+				return nil, errors.Errorf("unsupported field %q", parts[0])
+			}
+			order.Field = field
+		}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				order.Desc = true
+			default:
+				return nil, errors.Errorf("invalid direction %q", parts[1])
+			}
+		}
+		if slices.ContainsFunc(orders, func(o *store.MemoOrder) bool { return o.Field == order.Field }) {
+			return nil, errors.Errorf("duplicate field %q", parts[0])
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// getMemoCursorPageToken returns the page token of the memos after the last memo of the page.
+func (s *APIV1Service) getMemoCursorPageToken(ctx context.Context, limit int, lastMemo *store.Memo, orderBy []*store.MemoOrder) (string, error) {
+	cursor := &v1pb.PageToken_MemoCursor{
+		Id:        lastMemo.ID,
+		Pinned:    lastMemo.Pinned,
+		CreatedTs: lastMemo.CreatedTs,
+		UpdatedTs: lastMemo.UpdatedTs,
+		Title:     store.GetMemoTitle(lastMemo.Content),
+		OrderBy:   store.FormatMemoOrderBy(orderBy),
+	}
+	if slices.ContainsFunc(orderBy, func(o *store.MemoOrder) bool { return o.Field == store.MemoOrderFieldReactionCount }) {
+		contentID := fmt.Sprintf("%s%s", MemoNamePrefix, lastMemo.UID)
+		reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+			ContentID: &contentID,
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to list reactions")
+		}
+		cursor.ReactionCount = int32(len(reactions))
+	}
+	return marshalPageToken(&v1pb.PageToken{
+		Limit:      int32(limit),
+		MemoCursor: cursor,
+	})
+}
+
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -215,7 +377,19 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if memo.Visibility != store.Public {
+	// A valid share token grants access to the memo regardless of its visibility.
+	shared := s.isMemoSharedWithToken(ctx, memo, request.ShareToken)
+	if memo.RowStatus == store.Deleted {
+		// Memos in the trash are only visible to their creator and admins.
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if user == nil || (memo.CreatorID != user.ID && !isSuperUser(user)) {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		}
+	}
+	if !shared && memo.Visibility != store.Public {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -224,7 +398,13 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			acl, err := s.getMemoGrant(ctx, memo, user)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo grant: %v", err)
+			}
+			if acl == nil {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
 		}
 	}
 
@@ -260,10 +440,17 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	conditional, err := checkMemoEtag(ctx, memo, request.Etag)
+	if err != nil {
+		return nil, err
+	}
 
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
+	previousReferenceUIDs := getMemoReferenceUIDs(memo)
+	previousMentions := memo.Payload.GetProperty().GetMentions()
+	var referencedMemos []*store.Memo
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
@@ -279,6 +466,10 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			}
 			update.Content = &memo.Content
 			update.Payload = memo.Payload
+			referencedMemos, err = s.resolveMemoReferences(ctx, memo, previousReferenceUIDs)
+			if err != nil {
+				return nil, err
+			}
 		} else if path == "visibility" {
 			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 			if err != nil {
@@ -292,6 +483,9 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		} else if path == "pinned" {
 			update.Pinned = &request.Memo.Pinned
 		} else if path == "state" {
+			if request.Memo.State == v1pb.State_DELETED {
+				return nil, status.Errorf(codes.InvalidArgument, "use DeleteMemo to move a memo to the trash")
+			}
 			rowStatus := convertStateToStore(request.Memo.State)
 			update.RowStatus = &rowStatus
 		} else if path == "create_time" {
@@ -318,28 +512,73 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload := memo.Payload
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
-		} else if path == "resources" {
-			_, err := s.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
-				Name:      request.Memo.Name,
-				Resources: request.Memo.Resources,
-			})
+		} else if path == "publish_time" {
+			payload := memo.Payload
+			if request.Memo.PublishTime == nil {
+				payload.PublishTime = nil
+				payload.TargetVisibility = ""
+			} else {
+				targetVisibility, err := s.getMemoTargetVisibility(ctx, request.Memo.TargetVisibility)
+				if err != nil {
+					return nil, err
+				}
+				payload.PublishTime = request.Memo.PublishTime
+				payload.TargetVisibility = targetVisibility.String()
+			}
+			update.Payload = payload
+		} else if path == "target_visibility" {
+			payload := memo.Payload
+			if payload.PublishTime == nil && request.Memo.PublishTime == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "target visibility requires a publish time")
+			}
+			targetVisibility, err := s.getMemoTargetVisibility(ctx, request.Memo.TargetVisibility)
 			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo resources")
+				return nil, err
 			}
-		} else if path == "relations" {
-			_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-				Name:      request.Memo.Name,
-				Relations: request.Memo.Relations,
-			})
+			payload.TargetVisibility = targetVisibility.String()
+			update.Payload = payload
+		} else if path == "reminders" {
+			payload := memo.Payload
+			reminders, err := convertMemoRemindersToStore(request.Memo.Reminders, payload.Reminders)
 			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo relations")
+				return nil, status.Errorf(codes.InvalidArgument, "invalid reminders: %v", err)
 			}
+			payload.Reminders = reminders
+			update.Payload = payload
 		}
 	}
 
+	if conditional {
+		update.ExpectedUpdatedTs = &memo.UpdatedTs
+	}
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
+		if errors.Is(err, store.ErrMemoConflict) {
+			return nil, status.Errorf(codes.Aborted, "memo has been modified since it was read")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
+	// The resources and the relations are set once the precondition holds, so nothing is changed otherwise.
+	if slices.Contains(request.UpdateMask.Paths, "resources") {
+		if _, err := s.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
+			Name:      request.Memo.Name,
+			Resources: request.Memo.Resources,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to set memo resources")
+		}
+	}
+	if slices.Contains(request.UpdateMask.Paths, "relations") {
+		if _, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
+			Name:      request.Memo.Name,
+			Relations: request.Memo.Relations,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to set memo relations")
+		}
+	}
+	if slices.Contains(request.UpdateMask.Paths, "content") {
+		if err := s.syncMemoReferences(ctx, memo, referencedMemos, previousReferenceUIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &memo.ID,
@@ -347,6 +586,10 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	// The memo is updated already, so the mentions failed to notify are only logged.
+	if err := s.notifyMemoMentions(ctx, memo, previousMentions); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
 	memoMessage, err := s.convertMemoFromStore(ctx, memo)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
@@ -382,53 +625,106 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	conditional, err := checkMemoEtag(ctx, memo, request.Etag)
+	if err != nil {
+		return nil, err
+	}
 
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
+	// The memo is converted before it's moved to the trash, the webhook and the watchers get its last state.
+	memoMessage, convertErr := s.convertMemoFromStore(ctx, memo)
+
+	// Move the memo to the trash. It will be purged by the trash runner after the retention period.
+	deleted := store.Deleted
+	deletedTs := time.Now().Unix()
+	update := &store.UpdateMemo{
+		ID:        memo.ID,
+		RowStatus: &deleted,
+		UpdatedTs: &deletedTs,
+	}
+	if conditional {
+		update.ExpectedUpdatedTs = &memo.UpdatedTs
+	}
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		if errors.Is(err, store.ErrMemoConflict) {
+			return nil, status.Errorf(codes.Aborted, "memo has been modified since it was read")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete memo")
+	}
+	if convertErr == nil {
 		// Try to dispatch webhook when memo is deleted.
 		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
 			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
 		}
 	}
 
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) UndeleteMemo(ctx context.Context, request *v1pb.UndeleteMemoRequest) (*v1pb.Memo, error) {
+	memo, err := s.getDeletedMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
 	}
 
-	// Delete memo relation
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
+	normal := store.Normal
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		RowStatus: &normal,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to undelete memo")
 	}
 
-	// Delete related resources.
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list resources")
+		return nil, errors.Wrap(err, "failed to get memo")
 	}
-	for _, resource := range resources {
-		if err := s.Store.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete resource")
-		}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
 	}
+	// The memo is back from the trash, so the watchers get it as a new one.
+	s.publishMemoEvent(ctx, memoMessage, v1pb.MemoEvent_CREATED)
+	return memoMessage, nil
+}
 
-	// Delete memo comments
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
+func (s *APIV1Service) PurgeMemo(ctx context.Context, request *v1pb.PurgeMemoRequest) (*emptypb.Empty, error) {
+	memo, err := s.getDeletedMemo(ctx, request.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
+		return nil, err
 	}
-	for _, relation := range relations {
-		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
-		}
+
+	if err := trash.PurgeMemo(ctx, s.Store, memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge memo: %v", err)
 	}
+	return &emptypb.Empty{}, nil
+}
 
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
+// getDeletedMemo returns the memo in the trash if the current user is allowed to manage it.
+func (s *APIV1Service) getDeletedMemo(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
 
-	return &emptypb.Empty{}, nil
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	// Only the creator or admin can manage the memo in the trash.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if memo.RowStatus != store.Deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is not in the trash")
+	}
+	return memo, nil
 }
 
 func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.CreateMemoCommentRequest) (*v1pb.Memo, error) {
@@ -440,6 +736,20 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil || relatedMemo.RowStatus == store.Deleted {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	canComment, err := s.canCommentOnMemo(ctx, relatedMemo, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo permission: %v", err)
+	}
+	if !canComment {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 
 	// Create the memo comment first.
 	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: request.Comment})
@@ -469,7 +779,7 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
 	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
-		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		if err := s.sendActivityToInbox(ctx, &store.Activity{
 			CreatorID: creatorID,
 			Type:      store.ActivityTypeMemoComment,
 			Level:     store.ActivityLevelInfo,
@@ -479,20 +789,8 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 					RelatedMemoId: relatedMemo.ID,
 				},
 			},
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create activity")
-		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
-			SenderID:   creatorID,
-			ReceiverID: relatedMemo.CreatorID,
-			Status:     store.UNREAD,
-			Message: &storepb.InboxMessage{
-				Type:       storepb.InboxMessage_MEMO_COMMENT,
-				ActivityId: &activity.ID,
-			},
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create inbox")
+		}, relatedMemo.CreatorID, storepb.InboxMessage_MEMO_COMMENT); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to send memo comment to inbox: %v", err)
 		}
 	}
 
@@ -508,48 +806,97 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	var memoFilter string
-	if currentUser == nil {
-		memoFilter = `visibility == "PUBLIC"`
-	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-	}
-	memoRelationComment := store.MemoRelationComment
-	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &memo.ID,
-		Type:          &memoRelationComment,
-		MemoFilter:    &memoFilter,
-	})
+	threads, err := s.listMemoCommentThreads(ctx, memo.ID, getVisibleMemosFilter(currentUser))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+		return nil, err
+	}
+	memos := []*v1pb.Memo{}
+	for _, thread := range threads {
+		memos = append(memos, thread.Comment)
+	}
+
+	response := &v1pb.ListMemoCommentsResponse{
+		Memos:   memos,
+		Threads: threads,
 	}
+	return response, nil
+}
 
-	var memos []*v1pb.Memo
-	for _, memoRelation := range memoRelations {
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: &memoRelation.MemoID,
+// listMemoCommentThreads lists the comments of the memo matching the filter, with their replies nested.
+// The comments are loaded level by level, so the queries don't grow with the number of comments.
+func (s *APIV1Service) listMemoCommentThreads(ctx context.Context, memoID int32, memoFilter string) ([]*v1pb.MemoCommentThread, error) {
+	memoRelationComment := store.MemoRelationComment
+	visited := map[int32]bool{memoID: true}
+	threadsByMemoID := map[int32]*v1pb.MemoCommentThread{}
+	repliesByMemoID := map[int32][]*v1pb.MemoCommentThread{}
+	for parentIDs := []int32{memoID}; len(parentIDs) > 0; {
+		memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+			RelatedMemoIDList: parentIDs,
+			Type:              &memoRelationComment,
+			MemoFilter:        &memoFilter,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+		}
+		commentIDs := []int32{}
+		for _, memoRelation := range memoRelations {
+			if !visited[memoRelation.MemoID] {
+				visited[memoRelation.MemoID] = true
+				commentIDs = append(commentIDs, memoRelation.MemoID)
+			}
+		}
+		if len(commentIDs) == 0 {
+			break
+		}
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			IDList: commentIDs,
 		})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get memo")
+			return nil, status.Errorf(codes.Internal, "failed to list memos")
 		}
-		if memo != nil {
+		memosByID := map[int32]*store.Memo{}
+		for _, memo := range memos {
+			memosByID[memo.ID] = memo
+		}
+
+		parentIDs = []int32{}
+		// The comments keep the order of their relations.
+		for _, memoRelation := range memoRelations {
+			memo, ok := memosByID[memoRelation.MemoID]
+			if !ok || memo.RowStatus == store.Deleted || threadsByMemoID[memo.ID] != nil {
+				continue
+			}
 			memoMessage, err := s.convertMemoFromStore(ctx, memo)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to convert memo")
 			}
-			memos = append(memos, memoMessage)
+			thread := &v1pb.MemoCommentThread{
+				Comment: memoMessage,
+				Replies: []*v1pb.MemoCommentThread{},
+			}
+			threadsByMemoID[memo.ID] = thread
+			repliesByMemoID[memoRelation.RelatedMemoID] = append(repliesByMemoID[memoRelation.RelatedMemoID], thread)
+			parentIDs = append(parentIDs, memo.ID)
 		}
 	}
-
-	response := &v1pb.ListMemoCommentsResponse{
-		Memos: memos,
+	for id, thread := range threadsByMemoID {
+		if replies, ok := repliesByMemoID[id]; ok {
+			thread.Replies = replies
+		}
 	}
-	return response, nil
+	threads := repliesByMemoID[memoID]
+	if threads == nil {
+		threads = []*v1pb.MemoCommentThread{}
+	}
+	return threads, nil
 }
 
 func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMemoTagRequest) (*emptypb.Empty, error) {
@@ -571,35 +918,59 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 		memoFind.UID = &memoUID
 	}
 
+	rename := func(tag string) (string, bool) {
+		return request.NewTag, tag == request.OldTag
+	}
+	if err := s.renameMemoTags(ctx, memoFind, rename); err != nil {
+		return nil, err
+	}
+	// The attributes follow the tag if it's renamed in all memos, it's still used by the other memos otherwise.
+	if memoFind.UID == nil {
+		if err := s.moveTagAttributes(ctx, user.ID, rename); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// renameMemoTags rewrites the tags in the content of the memos found.
+// The rename function returns the new tag and whether the tag should be renamed.
+func (s *APIV1Service) renameMemoTags(ctx context.Context, memoFind *store.FindMemo, rename func(tag string) (string, bool)) error {
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memos")
+		return status.Errorf(codes.Internal, "failed to list memos")
 	}
 
 	for _, memo := range memos {
 		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
+			return status.Errorf(codes.Internal, "failed to parse memo: %v", err)
 		}
+		renamed := false
 		memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
-			if tag, ok := node.(*ast.Tag); ok && tag.Content == request.OldTag {
-				tag.Content = request.NewTag
+			if tag, ok := node.(*ast.Tag); ok {
+				if newTag, ok := rename(tag.Content); ok {
+					tag.Content = newTag
+					renamed = true
+				}
 			}
 		})
+		if !renamed {
+			continue
+		}
 		memo.Content = restore.Restore(nodes)
 		if err := memopayload.RebuildMemoPayload(memo); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+			return status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 		}
 		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
 			ID:      memo.ID,
 			Content: &memo.Content,
 			Payload: memo.Payload,
 		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+			return status.Errorf(codes.Internal, "failed to update memo: %v", err)
 		}
 	}
-
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMemoTagRequest) (*emptypb.Empty, error) {
@@ -629,7 +1000,13 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 
 	for _, memo := range memos {
 		if request.DeleteRelatedMemos {
-			err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+			deleted := store.Deleted
+			deletedTs := time.Now().Unix()
+			err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+				ID:        memo.ID,
+				RowStatus: &deleted,
+				UpdatedTs: &deletedTs,
+			})
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to delete memo")
 			}
@@ -648,6 +1025,62 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 	return &emptypb.Empty{}, nil
 }
 
+// getMemoEtag returns the etag of the memo, derived from its update time and a hash of its content.
+func getMemoEtag(memo *store.Memo) string {
+	hash := sha256.New()
+	hash.Write([]byte(memo.Content))
+	hash.Write([]byte{0})
+	hash.Write([]byte(memo.Visibility))
+	hash.Write([]byte{0})
+	hash.Write([]byte(memo.RowStatus))
+	hash.Write([]byte(strconv.FormatBool(memo.Pinned)))
+	if memo.Payload != nil {
+		payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(memo.Payload)
+		if err == nil {
+			hash.Write(payload)
+		}
+	}
+	return fmt.Sprintf("%d-%s", memo.UpdatedTs, hex.EncodeToString(hash.Sum(nil))[:16])
+}
+
+// checkMemoEtag returns an ABORTED error when the etag precondition doesn't match the current memo.
+// The `If-Match` header forwarded by the gateway is used if the etag is empty.
+// It reports whether a precondition is given, the update must then expect the update time of the memo,
+// so a concurrent update between the check and the write is detected by the store as well.
+func checkMemoEtag(ctx context.Context, memo *store.Memo, etag string) (bool, error) {
+	if etag == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for _, v := range md.Get(ifMatchMetadataKey) {
+				etag = v
+			}
+		}
+	}
+	etag = strings.Trim(strings.TrimPrefix(strings.TrimSpace(etag), "W/"), `"`)
+	if etag == "" || etag == "*" {
+		return false, nil
+	}
+	if etag != getMemoEtag(memo) {
+		return false, status.Errorf(codes.Aborted, "memo has been modified since it was read")
+	}
+	return true, nil
+}
+
+// getMemoTargetVisibility returns the visibility applied to a scheduled memo when it's published.
+func (s *APIV1Service) getMemoTargetVisibility(ctx context.Context, targetVisibility v1pb.Visibility) (store.Visibility, error) {
+	visibility := store.Public
+	if targetVisibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility = convertVisibilityToStore(targetVisibility)
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+		return "", status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	return visibility, nil
+}
+
 func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
@@ -656,18 +1089,79 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	return int(workspaceMemoRelatedSetting.ContentLengthLimit), nil
 }
 
-// DispatchMemoCreatedWebhook dispatches webhook when memo is created.
+// notifyMemoMentions sends the users newly mentioned in the memo to their inbox,
+// if they are allowed to read the memo.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, previousMentions []string) error {
+	for _, username := range memo.Payload.GetProperty().GetMentions() {
+		if slices.Contains(previousMentions, username) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+		if err != nil {
+			return errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.ID == memo.CreatorID {
+			continue
+		}
+		canRead, err := s.canReadMemo(ctx, memo, user)
+		if err != nil {
+			return errors.Wrap(err, "failed to check memo permission")
+		}
+		if !canRead {
+			continue
+		}
+		if err := s.sendActivityToInbox(ctx, &store.Activity{
+			CreatorID: memo.CreatorID,
+			Type:      store.ActivityTypeMemoMention,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				MemoMention: &storepb.ActivityMemoMentionPayload{
+					MemoId: memo.ID,
+				},
+			},
+		}, user.ID, storepb.InboxMessage_MEMO_MENTION); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DispatchMemoCreatedWebhook dispatches webhook when memo is created, and notifies the memo watchers.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	s.publishMemoEvent(ctx, memo, v1pb.MemoEvent_CREATED)
 	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
 }
 
-// DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
+// DispatchMemoUpdatedWebhook dispatches webhook when memo is updated, and notifies the memo watchers.
 func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	s.publishMemoEvent(ctx, memo, v1pb.MemoEvent_UPDATED)
 	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
 }
 
-// DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
+// DispatchStoreMemoUpdatedWebhook dispatches the memo updated webhook for a memo changed outside of the API,
+// e.g. by a background runner.
+func (s *APIV1Service) DispatchStoreMemoUpdatedWebhook(ctx context.Context, memo *store.Memo) error {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	return s.DispatchMemoUpdatedWebhook(ctx, memoMessage)
+}
+
+// DispatchStoreMemoRemindedWebhook dispatches webhook when a reminder of the memo comes due.
+// The delivered reminder is recorded in the memo, so the memo watchers are notified of the update.
+func (s *APIV1Service) DispatchStoreMemoRemindedWebhook(ctx context.Context, memo *store.Memo) error {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	s.publishMemoEvent(ctx, memoMessage, v1pb.MemoEvent_UPDATED)
+	return s.dispatchMemoRelatedWebhook(ctx, memoMessage, "memos.memo.reminded")
+}
+
+// DispatchMemoDeletedWebhook dispatches webhook when memo is deleted, and notifies the memo watchers.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	s.publishMemoEvent(ctx, memo, v1pb.MemoEvent_DELETED)
 	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
 }
 
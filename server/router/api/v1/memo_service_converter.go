@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/gomark/parser"
 	"github.com/usememos/gomark/parser/tokenizer"
 
+	"github.com/usememos/memos/plugin/cron"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -37,11 +39,18 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		Content:     memo.Content,
 		Visibility:  convertVisibilityFromStore(memo.Visibility),
 		Pinned:      memo.Pinned,
+		Etag:        getMemoEtag(memo),
 	}
 	if memo.Payload != nil {
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		memoMessage.Reminders = convertMemoRemindersFromStore(memo.Payload.Reminders)
+		memoMessage.DailyDate = memo.Payload.DailyDate
+		if memo.Payload.PublishTime != nil {
+			memoMessage.PublishTime = memo.Payload.PublishTime
+			memoMessage.TargetVisibility = convertVisibilityFromStore(store.Visibility(memo.Payload.TargetVisibility))
+		}
 	}
 	if memo.ParentID != nil {
 		parent, err := s.Store.GetMemo(ctx, &store.FindMemo{
@@ -97,6 +106,7 @@ func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.
 		HasTaskList:        property.HasTaskList,
 		HasCode:            property.HasCode,
 		HasIncompleteTasks: property.HasIncompleteTasks,
+		Mentions:           property.Mentions,
 	}
 }
 
@@ -122,6 +132,46 @@ func convertLocationToStore(location *v1pb.Location) *storepb.MemoPayload_Locati
 	}
 }
 
+func convertMemoRemindersFromStore(reminders []*storepb.MemoPayload_Reminder) []*v1pb.Memo_Reminder {
+	result := []*v1pb.Memo_Reminder{}
+	for _, reminder := range reminders {
+		result = append(result, &v1pb.Memo_Reminder{
+			RemindTime:     reminder.RemindTime,
+			Cron:           reminder.Cron,
+			LastRemindTime: reminder.LastRemindTime,
+		})
+	}
+	return result
+}
+
+// convertMemoRemindersToStore validates the reminders and keeps the last remind time of the unchanged ones,
+// so the delivered one-off reminders don't come due again.
+func convertMemoRemindersToStore(reminders []*v1pb.Memo_Reminder, previous []*storepb.MemoPayload_Reminder) ([]*storepb.MemoPayload_Reminder, error) {
+	result := []*storepb.MemoPayload_Reminder{}
+	for _, reminder := range reminders {
+		if reminder.RemindTime == nil && reminder.Cron == "" {
+			return nil, errors.New("reminder requires a remind time or a cron expression")
+		}
+		if reminder.Cron != "" {
+			if _, err := cron.ParseStandard(reminder.Cron); err != nil {
+				return nil, errors.Wrapf(err, "invalid cron expression %q", reminder.Cron)
+			}
+		}
+		memoReminder := &storepb.MemoPayload_Reminder{
+			RemindTime: reminder.RemindTime,
+			Cron:       reminder.Cron,
+		}
+		for _, previousReminder := range previous {
+			if previousReminder.Cron == memoReminder.Cron && proto.Equal(previousReminder.RemindTime, memoReminder.RemindTime) {
+				memoReminder.LastRemindTime = previousReminder.LastRemindTime
+				break
+			}
+		}
+		result = append(result, memoReminder)
+	}
+	return result, nil
+}
+
 func convertVisibilityFromStore(visibility store.Visibility) v1pb.Visibility {
 	switch visibility {
 	case store.Private:
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListMemosPageToken(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "lister", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	for i := 0; i < 5; i++ {
+		_, err := s.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", 4-i),
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+	}
+
+	names := []string{}
+	request := &v1pb.ListMemosRequest{PageSize: 2, Sort: "title"}
+	for {
+		response, err := s.ListMemos(userCtx, request)
+		require.NoError(t, err)
+		for _, memo := range response.Memos {
+			names = append(names, memo.Name)
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		request.PageToken = response.NextPageToken
+	}
+	require.Equal(t, []string{"memos/memo-4", "memos/memo-3", "memos/memo-2", "memos/memo-1", "memos/memo-0"}, names)
+
+	// The page token of one order can't continue the listing in another.
+	response, err := s.ListMemos(userCtx, &v1pb.ListMemosRequest{PageSize: 2, Sort: "title"})
+	require.NoError(t, err)
+	require.NotEmpty(t, response.NextPageToken)
+	for _, sort := range []string{"title desc", "create_time", ""} {
+		_, err := s.ListMemos(userCtx, &v1pb.ListMemosRequest{PageToken: response.NextPageToken, Sort: sort})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), sort)
+	}
+	_, err = s.ListMemos(userCtx, &v1pb.ListMemosRequest{PageToken: response.NextPageToken, Sort: "title asc"})
+	require.NoError(t, err)
+}
+
+func TestListMemoComments(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "author", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	otherCtx := withUser(ctx, other)
+
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	createComment := func(ctx context.Context, name, content string, visibility v1pb.Visibility) *v1pb.Memo {
+		comment, err := s.CreateMemoComment(ctx, &v1pb.CreateMemoCommentRequest{
+			Name:    name,
+			Comment: &v1pb.Memo{Content: content, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return comment
+	}
+	first := createComment(otherCtx, memo.Name, "first", v1pb.Visibility_PUBLIC)
+	second := createComment(userCtx, memo.Name, "second", v1pb.Visibility_PUBLIC)
+	reply := createComment(userCtx, first.Name, "reply", v1pb.Visibility_PUBLIC)
+	createComment(userCtx, reply.Name, "nested reply", v1pb.Visibility_PUBLIC)
+	privateReply := createComment(otherCtx, second.Name, "private reply", v1pb.Visibility_PRIVATE)
+
+	response, err := s.ListMemoComments(userCtx, &v1pb.ListMemoCommentsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, getCommentThreadContents(response.Threads))
+	require.Equal(t, []string{"reply"}, getCommentThreadContents(response.Threads[0].Replies))
+	require.Equal(t, []string{"nested reply"}, getCommentThreadContents(response.Threads[0].Replies[0].Replies))
+	require.Empty(t, response.Threads[0].Replies[0].Replies[0].Replies)
+	// The private replies are only listed for their creators and the users granted.
+	require.Empty(t, response.Threads[1].Replies)
+	response, err = s.ListMemoComments(otherCtx, &v1pb.ListMemoCommentsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{"private reply"}, getCommentThreadContents(response.Threads[1].Replies))
+	grantee := createTestingUser(ctx, t, s, "grantee", store.RoleUser)
+	_, err = s.Store.UpsertMemoACL(ctx, &store.MemoACL{MemoID: getTestingMemoID(ctx, t, s, privateReply.Name), UserID: grantee.ID, Permission: store.MemoPermissionRead})
+	require.NoError(t, err)
+	response, err = s.ListMemoComments(withUser(ctx, grantee), &v1pb.ListMemoCommentsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{"private reply"}, getCommentThreadContents(response.Threads[1].Replies))
+
+	_, err = s.ListMemoComments(userCtx, &v1pb.ListMemoCommentsRequest{Name: "memos/missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdateMemoPrecondition(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	related, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "related", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	resource, err := s.Store.CreateResource(ctx, &store.Resource{UID: "resource", CreatorID: user.ID, Filename: "file.txt", Type: "text/plain"})
+	require.NoError(t, err)
+	request := &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:      memo.Name,
+			Resources: []*v1pb.Resource{{Name: "resources/resource"}},
+			Relations: []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: related.Name}, Type: v1pb.MemoRelation_REFERENCE}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"resources", "relations"}},
+	}
+	listRelations := func() []*v1pb.MemoRelation {
+		response, err := s.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		return response.Relations
+	}
+
+	// The resources and the relations are not set if the memo has been modified since it was read.
+	request.Etag = `"stale"`
+	_, err = s.UpdateMemo(userCtx, request)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	resource, err = s.Store.GetResource(ctx, &store.FindResource{ID: &resource.ID})
+	require.NoError(t, err)
+	require.Nil(t, resource.MemoID)
+	require.Empty(t, listRelations())
+
+	request.Etag = memo.Etag
+	_, err = s.UpdateMemo(userCtx, request)
+	require.NoError(t, err)
+	resource, err = s.Store.GetResource(ctx, &store.FindResource{ID: &resource.ID})
+	require.NoError(t, err)
+	require.NotNil(t, resource.MemoID)
+	require.Len(t, listRelations(), 1)
+}
+
+func getCommentThreadContents(threads []*v1pb.MemoCommentThread) []string {
+	contents := []string{}
+	for _, thread := range threads {
+		contents = append(contents, thread.Comment.Content)
+	}
+	return contents
+}
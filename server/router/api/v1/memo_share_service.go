@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) CreateMemoShare(ctx context.Context, request *v1pb.CreateMemoShareRequest) (*v1pb.MemoShare, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForShareAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	create := &store.MemoShare{
+		UID:       shortuuid.New(),
+		MemoID:    memo.ID,
+		CreatorID: memo.CreatorID,
+	}
+	if request.ExpireTime != nil {
+		expireTime := request.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		expiresTs := expireTime.Unix()
+		create.ExpiresTs = &expiresTs
+	}
+	share, err := s.Store.CreateMemoShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share: %v", err)
+	}
+	return s.convertMemoShareFromStore(memo, share)
+}
+
+func (s *APIV1Service) ListMemoShares(ctx context.Context, request *v1pb.ListMemoSharesRequest) (*v1pb.ListMemoSharesResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForShareAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoSharesResponse{
+		Shares: []*v1pb.MemoShare{},
+	}
+	for _, share := range shares {
+		memoShare, err := s.convertMemoShareFromStore(memo, share)
+		if err != nil {
+			return nil, err
+		}
+		response.Shares = append(response.Shares, memoShare)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RevokeMemoShare(ctx context.Context, request *v1pb.RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	memoUID, shareUID, err := ExtractMemoShareUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo share name: %v", err)
+	}
+	memo, err := s.getMemoForShareAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		UID: &shareUID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if share == nil || share.MemoID != memo.ID {
+		return nil, status.Errorf(codes.NotFound, "memo share not found")
+	}
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &share.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) GetSharedMemo(ctx context.Context, request *v1pb.GetSharedMemoRequest) (*v1pb.Memo, error) {
+	share, err := s.getMemoShareByToken(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &share.MemoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil || memo.RowStatus == store.Deleted {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	return s.convertMemoFromStore(ctx, memo)
+}
+
+// getMemoForShareAccess returns the memo if the current user is allowed to manage its shares.
+func (s *APIV1Service) getMemoForShareAccess(ctx context.Context, memoUID string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Only the creator or admin can manage the memo shares.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// getMemoShareByToken verifies the share token and returns the memo share it refers to.
+func (s *APIV1Service) getMemoShareByToken(ctx context.Context, token string) (*store.MemoShare, error) {
+	if token == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "share token is required")
+	}
+	claims := &jwt.RegisteredClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, status.Errorf(codes.PermissionDenied, "unexpected share token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		if kid, ok := t.Header["kid"].(string); ok {
+			if kid == "v1" {
+				return []byte(s.Secret), nil
+			}
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "unexpected share token kid=%v", t.Header["kid"])
+	}, jwt.WithAudience(MemoShareAudienceName), jwt.WithIssuer(Issuer)); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid or expired share token")
+	}
+
+	// The share is looked up so that revoked shares are rejected.
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		UID: &claims.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "share has been revoked")
+	}
+	if share.ExpiresTs != nil && time.Now().Unix() >= *share.ExpiresTs {
+		return nil, status.Errorf(codes.PermissionDenied, "share has expired")
+	}
+	return share, nil
+}
+
+// isMemoSharedWithToken returns whether the share token grants access to the memo.
+// Memos in the trash are never shared.
+func (s *APIV1Service) isMemoSharedWithToken(ctx context.Context, memo *store.Memo, token string) bool {
+	if token == "" || memo.RowStatus == store.Deleted {
+		return false
+	}
+	share, err := s.getMemoShareByToken(ctx, token)
+	if err != nil {
+		return false
+	}
+	return share.MemoID == memo.ID
+}
+
+func (s *APIV1Service) convertMemoShareFromStore(memo *store.Memo, share *store.MemoShare) (*v1pb.MemoShare, error) {
+	var expirationTime time.Time
+	if share.ExpiresTs != nil {
+		expirationTime = time.Unix(*share.ExpiresTs, 0)
+	}
+	token, err := GenerateMemoShareToken(share.UID, expirationTime, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate share token: %v", err)
+	}
+	memoShare := &v1pb.MemoShare{
+		Name:       fmt.Sprintf("%s%s/%s%s", MemoNamePrefix, memo.UID, MemoShareNamePrefix, share.UID),
+		Token:      token,
+		CreateTime: timestamppb.New(time.Unix(share.CreatedTs, 0)),
+	}
+	if share.ExpiresTs != nil {
+		memoShare.ExpireTime = timestamppb.New(expirationTime)
+	}
+	return memoShare, nil
+}
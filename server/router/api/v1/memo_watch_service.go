@@ -0,0 +1,333 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// memoEventBufferSize is the number of the memo events buffered for a watcher.
+	// The watchers falling further behind are closed, the clients are expected to reconnect and reload.
+	memoEventBufferSize = 64
+	// sseHeartbeatInterval is the interval of the comments sent to keep the idle SSE connections alive.
+	sseHeartbeatInterval = 30 * time.Second
+)
+
+// memoEvent is a memo event with the memo it's about. It's shared by the watchers,
+// so the memo is loaded once and each filter is matched once for all of them.
+type memoEvent struct {
+	event *v1pb.MemoEvent
+	memo  *store.Memo
+
+	mu            sync.Mutex
+	filterMatches map[string]bool
+}
+
+func newMemoEvent(memo *store.Memo, memoMessage *v1pb.Memo, eventType v1pb.MemoEvent_Type) *memoEvent {
+	return &memoEvent{
+		event: &v1pb.MemoEvent{
+			Type:      eventType,
+			Memo:      memoMessage,
+			EventTime: timestamppb.Now(),
+		},
+		memo:          memo,
+		filterMatches: map[string]bool{},
+	}
+}
+
+// matchFilter returns whether the memo matches the filter, the result is kept for the other watchers.
+func (e *memoEvent) matchFilter(ctx context.Context, s *store.Store, filter string) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if matched, ok := e.filterMatches[filter]; ok {
+		return matched, nil
+	}
+	memos, err := s.ListMemos(ctx, &store.FindMemo{
+		ID:     &e.memo.ID,
+		Filter: &filter,
+	})
+	if err != nil {
+		return false, err
+	}
+	e.filterMatches[filter] = len(memos) > 0
+	return e.filterMatches[filter], nil
+}
+
+// memoWatcher fans out the memo events to the watching streams.
+type memoWatcher struct {
+	mu          sync.Mutex
+	closed      bool
+	nextID      int
+	subscribers map[int]chan *memoEvent
+}
+
+func newMemoWatcher() *memoWatcher {
+	return &memoWatcher{
+		subscribers: map[int]chan *memoEvent{},
+	}
+}
+
+// subscribe returns the channel of the memo events, it's closed if the watcher is closed or the subscriber falls behind.
+func (w *memoWatcher) subscribe() (int, <-chan *memoEvent, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return 0, nil, errors.New("memo watcher is closed")
+	}
+	w.nextID++
+	events := make(chan *memoEvent, memoEventBufferSize)
+	w.subscribers[w.nextID] = events
+	return w.nextID, events, nil
+}
+
+func (w *memoWatcher) unsubscribe(id int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if events, ok := w.subscribers[id]; ok {
+		delete(w.subscribers, id)
+		close(events)
+	}
+}
+
+// hasSubscribers returns whether anyone is watching, so the events nobody receives are not built.
+func (w *memoWatcher) hasSubscribers() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.subscribers) > 0
+}
+
+// publish sends the event to the subscribers without blocking.
+func (w *memoWatcher) publish(event *memoEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for id, events := range w.subscribers {
+		select {
+		case events <- event:
+		default:
+			delete(w.subscribers, id)
+			close(events)
+		}
+	}
+}
+
+// close closes the subscribers, so the streams end and the server can stop gracefully.
+func (w *memoWatcher) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	for id, events := range w.subscribers {
+		delete(w.subscribers, id)
+		close(events)
+	}
+}
+
+// publishMemoEvent notifies the memo watchers of the memo change.
+func (s *APIV1Service) publishMemoEvent(ctx context.Context, memo *v1pb.Memo, eventType v1pb.MemoEvent_Type) {
+	if !s.memoWatcher.hasSubscribers() {
+		return
+	}
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	if err != nil {
+		return
+	}
+	storeMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		UID: &memoUID,
+	})
+	if err != nil {
+		slog.Warn("Failed to get memo of memo event", slog.Any("err", err))
+		return
+	}
+	// The memo is purged already.
+	if storeMemo == nil {
+		return
+	}
+	s.memoWatcher.publish(newMemoEvent(storeMemo, memo, eventType))
+}
+
+// PublishStoreMemoEvents notifies the memo watchers of the memos changed outside of the API,
+// e.g. by a background runner.
+func (s *APIV1Service) PublishStoreMemoEvents(ctx context.Context, memoIDs []int32, eventType v1pb.MemoEvent_Type) error {
+	if len(memoIDs) == 0 || !s.memoWatcher.hasSubscribers() {
+		return nil
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList: memoIDs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert memo")
+		}
+		s.memoWatcher.publish(newMemoEvent(memo, memoMessage, eventType))
+	}
+	return nil
+}
+
+// CloseMemoWatches ends the memo watching streams, it's called before the server shuts down.
+func (s *APIV1Service) CloseMemoWatches() {
+	s.memoWatcher.close()
+}
+
+func (s *APIV1Service) WatchMemos(request *v1pb.WatchMemosRequest, stream grpc.ServerStreamingServer[v1pb.MemoEvent]) error {
+	ctx := stream.Context()
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	id, events, err := s.memoWatcher.subscribe()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to watch memos: %v", err)
+	}
+	defer s.memoWatcher.unsubscribe(id)
+	// Send the headers, so the clients know the stream is established before the first event.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Errorf(codes.Aborted, "memo watch is closed, reconnect to continue watching")
+			}
+			matched, err := s.matchMemoEvent(ctx, event, currentUser, request.Filter)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to match memo event: %v", err)
+			}
+			if !matched {
+				continue
+			}
+			if err := stream.Send(event.event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// matchMemoEvent returns whether the memo of the event is visible to the user and matches the filter.
+// The visibility is checked on the memo of the event, only the grants of the private memos are queried.
+func (s *APIV1Service) matchMemoEvent(ctx context.Context, event *memoEvent, user *store.User, filter string) (bool, error) {
+	readable, err := s.canReadMemo(ctx, event.memo, user)
+	if err != nil {
+		return false, err
+	}
+	if !readable {
+		return false, nil
+	}
+	if filter == "" {
+		return true, nil
+	}
+	return event.matchFilter(ctx, s.Store, filter)
+}
+
+// registerMemoEventsSSE serves WatchMemos as Server-Sent Events, for the browsers' `EventSource`.
+// The request is proxied to the gRPC server with its credentials, so it's authenticated as the stream.
+func (s *APIV1Service) registerMemoEventsSSE(echoServer *echo.Echo, conn *grpc.ClientConn) {
+	client := v1pb.NewMemoServiceClient(conn)
+	echoServer.GET("/api/v1/sse/memos", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		md := metadata.MD{}
+		if authorization := c.Request().Header.Get("Authorization"); authorization != "" {
+			md.Set("authorization", authorization)
+		}
+		if cookie := c.Request().Header.Get("Cookie"); cookie != "" {
+			md.Set("cookie", cookie)
+		}
+		stream, err := client.WatchMemos(metadata.NewOutgoingContext(ctx, md), &v1pb.WatchMemosRequest{
+			Filter: c.QueryParam("filter"),
+		})
+		if err == nil {
+			// Wait for the stream to be established, so the errors are returned as the HTTP status.
+			// The header is nil if the stream ends with an error before, the error is received then.
+			var header metadata.MD
+			if header, err = stream.Header(); err == nil && header == nil {
+				_, err = stream.Recv()
+			}
+		}
+		if err != nil {
+			return echo.NewHTTPError(runtime.HTTPStatusFromCode(status.Code(err)), status.Convert(err).Message())
+		}
+
+		response := c.Response()
+		response.Header().Set(echo.HeaderContentType, "text/event-stream")
+		response.Header().Set(echo.HeaderCacheControl, "no-cache")
+		response.Header().Set(echo.HeaderConnection, "keep-alive")
+		response.WriteHeader(http.StatusOK)
+		response.Flush()
+
+		type received struct {
+			event *v1pb.MemoEvent
+			err   error
+		}
+		receivedChan := make(chan received)
+		go func() {
+			for {
+				event, err := stream.Recv()
+				select {
+				case receivedChan <- received{event: event, err: err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		heartbeat := time.NewTicker(sseHeartbeatInterval)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+					return nil
+				}
+				response.Flush()
+			case received := <-receivedChan:
+				if received.err != nil {
+					// The client reconnects after the stream is closed, e.g. when the server shuts down.
+					slog.Debug("memo events stream closed", slog.Any("err", received.err))
+					return nil
+				}
+				data, err := protojson.Marshal(received.event)
+				if err != nil {
+					return nil
+				}
+				if _, err := fmt.Fprintf(response, "event: memo\ndata: %s\n\n", data); err != nil {
+					return nil
+				}
+				response.Flush()
+			}
+		}
+	})
+}
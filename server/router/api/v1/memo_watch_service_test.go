@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoWatcher(t *testing.T) {
+	w := newMemoWatcher()
+	require.False(t, w.hasSubscribers())
+	fastID, fast, err := w.subscribe()
+	require.NoError(t, err)
+	_, slow, err := w.subscribe()
+	require.NoError(t, err)
+	require.True(t, w.hasSubscribers())
+
+	// The events are sent to all the subscribers.
+	event := newMemoEvent(&store.Memo{ID: 1}, &v1pb.Memo{Name: "memos/1"}, v1pb.MemoEvent_CREATED)
+	w.publish(event)
+	require.Equal(t, event, <-fast)
+
+	// The subscriber falling behind the buffer is closed, the others keep receiving.
+	for i := 0; i < memoEventBufferSize; i++ {
+		w.publish(event)
+		require.Equal(t, event, <-fast)
+	}
+	for i := 0; i < memoEventBufferSize; i++ {
+		require.Equal(t, event, <-slow)
+	}
+	_, ok := <-slow
+	require.False(t, ok)
+	w.publish(event)
+	require.Equal(t, event, <-fast)
+
+	// The unsubscribed channels are closed, and nothing is sent after the watcher is closed.
+	w.unsubscribe(fastID)
+	_, ok = <-fast
+	require.False(t, ok)
+	require.False(t, w.hasSubscribers())
+	_, events, err := w.subscribe()
+	require.NoError(t, err)
+	w.close()
+	_, ok = <-events
+	require.False(t, ok)
+	_, _, err = w.subscribe()
+	require.Error(t, err)
+}
+
+func TestMatchMemoEvent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	reader := createTestingUser(ctx, t, s, "reader", store.RoleUser)
+	grantee := createTestingUser(ctx, t, s, "grantee", store.RoleUser)
+	publicMemo := createTestingWatchedMemo(ctx, t, s, owner, "public", "work public", store.Public)
+	protectedMemo := createTestingWatchedMemo(ctx, t, s, owner, "protected", "work protected", store.Protected)
+	privateMemo := createTestingWatchedMemo(ctx, t, s, owner, "private", "work private", store.Private)
+	_, err := s.Store.UpsertMemoACL(ctx, &store.MemoACL{MemoID: privateMemo.ID, UserID: grantee.ID, Permission: store.MemoPermissionRead})
+	require.NoError(t, err)
+
+	// The events are matched by the visibility of the memos to the users.
+	for _, tc := range []struct {
+		user    *store.User
+		visible []*store.Memo
+	}{
+		{user: nil, visible: []*store.Memo{publicMemo}},
+		{user: reader, visible: []*store.Memo{publicMemo, protectedMemo}},
+		{user: grantee, visible: []*store.Memo{publicMemo, protectedMemo, privateMemo}},
+		{user: owner, visible: []*store.Memo{publicMemo, protectedMemo, privateMemo}},
+	} {
+		visible := []*store.Memo{}
+		for _, memo := range []*store.Memo{publicMemo, protectedMemo, privateMemo} {
+			matched, err := s.matchMemoEvent(ctx, newMemoEvent(memo, &v1pb.Memo{}, v1pb.MemoEvent_UPDATED), tc.user, "")
+			require.NoError(t, err)
+			if matched {
+				visible = append(visible, memo)
+			}
+		}
+		require.Equal(t, tc.visible, visible)
+	}
+
+	// The filters are matched once for all the watchers of the event.
+	event := newMemoEvent(publicMemo, &v1pb.Memo{}, v1pb.MemoEvent_UPDATED)
+	matched, err := s.matchMemoEvent(ctx, event, reader, `content.contains("public")`)
+	require.NoError(t, err)
+	require.True(t, matched)
+	matched, err = s.matchMemoEvent(ctx, event, reader, `content.contains("private")`)
+	require.NoError(t, err)
+	require.False(t, matched)
+	require.Equal(t, map[string]bool{`content.contains("public")`: true, `content.contains("private")`: false}, event.filterMatches)
+	content := "changed"
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: publicMemo.ID, Content: &content}))
+	matched, err = s.matchMemoEvent(ctx, event, owner, `content.contains("public")`)
+	require.NoError(t, err)
+	require.True(t, matched)
+}
+
+func TestDeleteMemoEvents(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	memo := createTestingWatchedMemo(ctx, t, s, user, "memo", "memo", store.Public)
+	_, events, err := s.memoWatcher.subscribe()
+	require.NoError(t, err)
+
+	// The memo failed to be deleted is not published.
+	_, err = s.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: "memos/memo", Etag: `"stale"`})
+	require.Error(t, err)
+	require.Empty(t, events)
+
+	// The deleted memo is published with the last state before, and the undeleted memo as a new one.
+	_, err = s.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: "memos/memo"})
+	require.NoError(t, err)
+	event := <-events
+	require.Equal(t, v1pb.MemoEvent_DELETED, event.event.Type)
+	require.Equal(t, v1pb.State_NORMAL, event.event.Memo.State)
+	require.Equal(t, memo.ID, event.memo.ID)
+	_, err = s.UndeleteMemo(userCtx, &v1pb.UndeleteMemoRequest{Name: "memos/memo"})
+	require.NoError(t, err)
+	event = <-events
+	require.Equal(t, v1pb.MemoEvent_CREATED, event.event.Type)
+	require.Equal(t, "memos/memo", event.event.Memo.Name)
+
+	// The memos changed by the runners are published.
+	require.NoError(t, s.PublishStoreMemoEvents(ctx, []int32{memo.ID}, v1pb.MemoEvent_UPDATED))
+	event = <-events
+	require.Equal(t, v1pb.MemoEvent_UPDATED, event.event.Type)
+	require.Equal(t, "memos/memo", event.event.Memo.Name)
+}
+
+func TestMemoEventsSSE(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	reader := createTestingUser(ctx, t, s, "reader", store.RoleUser)
+	accessToken, err := GenerateAccessToken(reader.Username, reader.ID, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, reader, accessToken, "test"))
+
+	// The SSE endpoint proxies the watch of the gRPC server with the credentials of the request.
+	authInterceptor := NewGRPCAuthInterceptor(s.Store, s.Secret)
+	grpcServer := grpc.NewServer(grpc.ChainStreamInterceptor(authInterceptor.StreamAuthenticationInterceptor))
+	v1pb.RegisterMemoServiceServer(grpcServer, s)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	echoServer := echo.New()
+	s.registerMemoEventsSSE(echoServer, conn)
+	httpServer := httptest.NewServer(echoServer)
+	defer httpServer.Close()
+
+	// The invalid filters are rejected before the stream starts.
+	response, err := getMemoEventsSSE(httpServer.URL, accessToken, "invalid ==")
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	response, err = getMemoEventsSSE(httpServer.URL, accessToken, `content.contains("work")`)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "text/event-stream", response.Header.Get(echo.HeaderContentType))
+
+	// Only the events of the memos readable by the user and matching the filter are streamed.
+	for _, memo := range []*store.Memo{
+		createTestingWatchedMemo(ctx, t, s, owner, "private", "work private", store.Private),
+		createTestingWatchedMemo(ctx, t, s, owner, "unmatched", "home protected", store.Protected),
+		createTestingWatchedMemo(ctx, t, s, owner, "protected", "work protected", store.Protected),
+	} {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		require.NoError(t, err)
+		s.publishMemoEvent(ctx, memoMessage, v1pb.MemoEvent_CREATED)
+	}
+	body := bufio.NewReader(response.Body)
+	require.Equal(t, "event: memo\n", readSSELine(t, body))
+	data := strings.TrimPrefix(readSSELine(t, body), "data: ")
+	event := &v1pb.MemoEvent{}
+	require.NoError(t, protojson.Unmarshal([]byte(data), event))
+	require.Equal(t, v1pb.MemoEvent_CREATED, event.Type)
+	require.Equal(t, "memos/protected", event.Memo.Name)
+	require.Equal(t, "\n", readSSELine(t, body))
+
+	// The stream ends when the server shuts down, so the clients reconnect.
+	s.CloseMemoWatches()
+	_, err = body.ReadString('\n')
+	require.Error(t, err)
+}
+
+func createTestingWatchedMemo(ctx context.Context, t *testing.T, s *APIV1Service, user *store.User, uid, content string, visibility store.Visibility) *store.Memo {
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: visibility,
+	})
+	require.NoError(t, err)
+	return memo
+}
+
+func getMemoEventsSSE(serverURL, accessToken, filter string) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodGet, serverURL+"/api/v1/sse/memos?filter="+url.QueryEscape(filter), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	return http.DefaultClient.Do(request)
+}
+
+func readSSELine(t *testing.T, reader *bufio.Reader) string {
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	return line
+}
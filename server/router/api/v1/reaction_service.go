@@ -3,12 +3,17 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -38,6 +43,16 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
+	existingReactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		CreatorID: &user.ID,
+		ContentID: &request.Reaction.ContentId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	isNewReaction := !slices.ContainsFunc(existingReactions, func(reaction *store.Reaction) bool {
+		return reaction.ReactionType == request.Reaction.ReactionType
+	})
 	reaction, err := s.Store.UpsertReaction(ctx, &store.Reaction{
 		CreatorID:    user.ID,
 		ContentID:    request.Reaction.ContentId,
@@ -46,6 +61,11 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
+	if isNewReaction {
+		if err := s.notifyMemoReaction(ctx, reaction); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to notify memo reaction: %v", err)
+		}
+	}
 
 	reactionMessage, err := s.convertReactionFromStore(ctx, reaction)
 	if err != nil {
@@ -64,6 +84,35 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 	return &emptypb.Empty{}, nil
 }
 
+// notifyMemoReaction sends the reaction to the inbox of the memo creator, if the reaction is on a memo of someone else.
+func (s *APIV1Service) notifyMemoReaction(ctx context.Context, reaction *store.Reaction) error {
+	if !strings.HasPrefix(reaction.ContentID, MemoNamePrefix) {
+		return nil
+	}
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentID)
+	if err != nil {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || memo.CreatorID == reaction.CreatorID {
+		return nil
+	}
+	return s.sendActivityToInbox(ctx, &store.Activity{
+		CreatorID: reaction.CreatorID,
+		Type:      store.ActivityTypeMemoReaction,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReaction: &storepb.ActivityMemoReactionPayload{
+				MemoId:       memo.ID,
+				ReactionType: reaction.ReactionType,
+			},
+		},
+	}, memo.CreatorID, storepb.InboxMessage_MEMO_REACTION)
+}
+
 func (s *APIV1Service) convertReactionFromStore(ctx context.Context, reaction *store.Reaction) (*v1pb.Reaction, error) {
 	creator, err := s.Store.GetUser(ctx, &store.FindUser{
 		ID: &reaction.CreatorID,
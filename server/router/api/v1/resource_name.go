@@ -13,6 +13,10 @@ const (
 	WorkspaceSettingNamePrefix = "settings/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
+	MemoRevisionNamePrefix     = "revisions/"
+	MemoShareNamePrefix        = "shares/"
+	MemoGrantNamePrefix        = "grants/"
+	TaskNamePrefix             = "tasks/"
 	ResourceNamePrefix         = "resources/"
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identityProviders/"
@@ -71,6 +75,57 @@ func ExtractMemoUIDFromName(name string) (string, error) {
 	return id, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo UID and the revision ID from a resource name.
+// e.g., "memos/uuid/revisions/1" -> "uuid", 1.
+func ExtractMemoRevisionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo revision ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractMemoShareUIDFromName returns the memo UID and the share UID from a memo share name.
+func ExtractMemoShareUIDFromName(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoShareNamePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
+// ExtractMemoGrantIDFromName returns the memo UID and the grant ID from a memo grant name.
+// e.g., "memos/uuid/grants/1" -> "uuid", 1.
+func ExtractMemoGrantIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoGrantNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo grant ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractTaskIndexFromName returns the memo UID and the task index from a task name.
+// e.g., "memos/uuid/tasks/0" -> "uuid", 0.
+func ExtractTaskIndexFromName(name string) (string, int, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, TaskNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	index, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil || index < 0 {
+		return "", 0, errors.Errorf("invalid task index %q", tokens[1])
+	}
+	return tokens[0], int(index), nil
+}
+
 // ExtractResourceUIDFromName returns the resource UID from a resource name.
 func ExtractResourceUIDFromName(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, ResourceNamePrefix)
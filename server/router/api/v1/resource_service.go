@@ -152,7 +152,8 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", resource.MemoID)
 		}
-		if memo != nil && memo.Visibility != store.Public {
+		// A valid share token of the memo grants access to its resources.
+		if memo != nil && memo.Visibility != store.Public && !s.isMemoSharedWithToken(ctx, memo, request.ShareToken) {
 			user, err := s.GetCurrentUser(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -161,7 +162,13 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
 			}
 			if memo.Visibility == store.Private && user.ID != resource.CreatorID {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				canRead, err := s.canReadMemo(ctx, memo, user)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to check memo permission: %v", err)
+				}
+				if !canRead {
+					return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				}
 			}
 		}
 	}
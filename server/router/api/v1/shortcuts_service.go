@@ -240,18 +240,37 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 }
 
 func (s *APIV1Service) validateFilter(_ context.Context, filterStr string) error {
+	_, err := s.convertFilter(filterStr)
+	return err
+}
+
+// validateSelectiveFilter validates the filter of the changes to the memos, e.g. the batch updates.
+// The filters converting to no condition, e.g. a bare `true`, are rejected as they select all the memos.
+func (s *APIV1Service) validateSelectiveFilter(_ context.Context, filterStr string) error {
+	condition, err := s.convertFilter(filterStr)
+	if err != nil {
+		return err
+	}
+	if condition == "" {
+		return errors.New("filter matches all memos")
+	}
+	return nil
+}
+
+// convertFilter returns the SQL condition of the filter.
+func (s *APIV1Service) convertFilter(filterStr string) (string, error) {
 	if filterStr == "" {
-		return errors.New("filter cannot be empty")
+		return "", errors.New("filter cannot be empty")
 	}
 	// Validate the filter.
 	parsedExpr, err := filter.Parse(filterStr, filter.MemoFilterCELAttributes...)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse filter")
+		return "", errors.Wrap(err, "failed to parse filter")
 	}
 	convertCtx := filter.NewConvertContext()
 	err = s.Store.GetDriver().ConvertExprToSQL(convertCtx, parsedExpr.GetExpr())
 	if err != nil {
-		return errors.Wrap(err, "failed to convert filter to SQL")
+		return "", errors.Wrap(err, "failed to convert filter to SQL")
 	}
-	return nil
+	return convertCtx.Buffer.String(), nil
 }
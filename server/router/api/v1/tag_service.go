@@ -0,0 +1,296 @@
+package v1
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTags(ctx context.Context, request *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	user, err := s.getTagOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.listUserTags(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	return &v1pb.ListTagsResponse{
+		Tags: buildTagTree(tags),
+	}, nil
+}
+
+func (s *APIV1Service) UpdateTag(ctx context.Context, request *v1pb.UpdateTagRequest) (*v1pb.Tag, error) {
+	user, err := s.getTagOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	name, err := normalizeBatchTag(request.Tag.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.listUserTags(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	tag, ok := tags[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tag not found")
+	}
+	update := &store.Tag{
+		CreatorID:   user.ID,
+		Name:        name,
+		Color:       tag.Color,
+		Description: tag.Description,
+		Icon:        tag.Icon,
+		Pinned:      tag.Pinned,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "color":
+			update.Color = request.Tag.Color
+		case "description":
+			update.Description = request.Tag.Description
+		case "icon":
+			update.Icon = request.Tag.Icon
+		case "pinned":
+			update.Pinned = request.Tag.Pinned
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path: %s", path)
+		}
+	}
+	if _, err := s.Store.UpsertTag(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tag: %v", err)
+	}
+
+	tag.Color = update.Color
+	tag.Description = update.Description
+	tag.Icon = update.Icon
+	tag.Pinned = update.Pinned
+	buildTagTree(tags)
+	return tag, nil
+}
+
+func (s *APIV1Service) MergeTags(ctx context.Context, request *v1pb.MergeTagsRequest) (*v1pb.Tag, error) {
+	user, err := s.getTagOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.SourceTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags are required")
+	}
+	target, err := normalizeBatchTag(request.TargetTag)
+	if err != nil {
+		return nil, err
+	}
+	sources := []string{}
+	for _, sourceTag := range request.SourceTags {
+		source, err := normalizeBatchTag(sourceTag)
+		if err != nil {
+			return nil, err
+		}
+		if source == target {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot merge tag %q into itself", source)
+		}
+		if strings.HasPrefix(target, source+"/") {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot merge tag %q into its nested tag", source)
+		}
+		sources = append(sources, source)
+	}
+	// rename moves the source tags and their nested tags under the target tag.
+	rename := func(tag string) (string, bool) {
+		for _, source := range sources {
+			if tag == source {
+				return target, true
+			}
+			if strings.HasPrefix(tag, source+"/") {
+				return target + strings.TrimPrefix(tag, source), true
+			}
+		}
+		return "", false
+	}
+
+	// Tag searching is not used as it doesn't match the nested tags in all drivers.
+	if err := s.renameMemoTags(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		ExcludeComments: true,
+	}, rename); err != nil {
+		return nil, err
+	}
+
+	if err := s.moveTagAttributes(ctx, user.ID, rename); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.listUserTags(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	buildTagTree(tags)
+	if tag, ok := tags[target]; ok {
+		return tag, nil
+	}
+	return &v1pb.Tag{Name: target, Children: []*v1pb.Tag{}}, nil
+}
+
+// moveTagAttributes moves the attributes of the renamed tags to their new names.
+// The existing attributes of the new names are kept.
+func (s *APIV1Service) moveTagAttributes(ctx context.Context, userID int32, rename func(tag string) (string, bool)) error {
+	tagRows, err := s.Store.ListTags(ctx, &store.FindTag{CreatorID: &userID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	tagRowNames := map[string]bool{}
+	for _, tagRow := range tagRows {
+		tagRowNames[tagRow.Name] = true
+	}
+	for _, tagRow := range tagRows {
+		newName, ok := rename(tagRow.Name)
+		if !ok || newName == tagRow.Name {
+			continue
+		}
+		if !tagRowNames[newName] {
+			if _, err := s.Store.UpsertTag(ctx, &store.Tag{
+				CreatorID:   userID,
+				Name:        newName,
+				Color:       tagRow.Color,
+				Description: tagRow.Description,
+				Icon:        tagRow.Icon,
+				Pinned:      tagRow.Pinned,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to update tag: %v", err)
+			}
+			tagRowNames[newName] = true
+		}
+		if err := s.Store.DeleteTag(ctx, &store.DeleteTag{ID: &tagRow.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+		}
+	}
+	return nil
+}
+
+// getTagOwner returns the user of the parent if it is the current user.
+func (s *APIV1Service) getTagOwner(ctx context.Context, parent string) (*store.User, error) {
+	userID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// listUserTags returns the tags of the user by name, including the tags that only have attributes.
+// The parent tags of the nested tags are always included.
+func (s *APIV1Service) listUserTags(ctx context.Context, userID int32) (map[string]*v1pb.Tag, error) {
+	state := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &state,
+		ExcludeContent:  true,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tagRows, err := s.Store.ListTags(ctx, &store.FindTag{CreatorID: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]*v1pb.Tag{}
+	getOrCreateTag := func(name string) *v1pb.Tag {
+		tag, ok := tags[name]
+		if !ok {
+			tag = &v1pb.Tag{Name: name}
+			tags[name] = tag
+		}
+		return tag
+	}
+	for _, memo := range memos {
+		// A memo is counted once for each tag, even if several of its nested tags are used.
+		counted := map[string]bool{}
+		for _, memoTag := range memo.Payload.GetTags() {
+			for _, name := range getTagPaths(memoTag) {
+				if !counted[name] {
+					counted[name] = true
+					getOrCreateTag(name).MemoCount++
+				}
+			}
+		}
+	}
+	for _, tagRow := range tagRows {
+		for _, name := range getTagPaths(tagRow.Name) {
+			getOrCreateTag(name)
+		}
+		tag := tags[tagRow.Name]
+		tag.Color = tagRow.Color
+		tag.Description = tagRow.Description
+		tag.Icon = tagRow.Icon
+		tag.Pinned = tagRow.Pinned
+	}
+	return tags, nil
+}
+
+// getTagPaths returns the tag and its parent tags, e.g. `a`, `a/b` and `a/b/c` for `a/b/c`.
+func getTagPaths(tag string) []string {
+	paths := []string{}
+	for i := 1; i < len(tag); i++ {
+		if tag[i] == '/' {
+			paths = append(paths, tag[:i])
+		}
+	}
+	return append(paths, tag)
+}
+
+// buildTagTree links the nested tags to their parent tags and returns the top-level tags.
+// The pinned tags come first, then the tags are ordered by name.
+func buildTagTree(tags map[string]*v1pb.Tag) []*v1pb.Tag {
+	names := make([]string, 0, len(tags))
+	for name, tag := range tags {
+		names = append(names, name)
+		tag.Children = []*v1pb.Tag{}
+	}
+	sort.Strings(names)
+	roots := []*v1pb.Tag{}
+	for _, name := range names {
+		tag := tags[name]
+		if index := strings.LastIndex(name, "/"); index > 0 {
+			if parent, ok := tags[name[:index]]; ok {
+				parent.Children = append(parent.Children, tag)
+				continue
+			}
+		}
+		roots = append(roots, tag)
+	}
+	sortPinnedTags(roots)
+	return roots
+}
+
+func sortPinnedTags(tags []*v1pb.Tag) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Pinned && !tags[j].Pinned
+	})
+	for _, tag := range tags {
+		sortPinnedTags(tag.Children)
+	}
+}
@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func TestBuildTagTree(t *testing.T) {
+	tags := map[string]*v1pb.Tag{}
+	for _, name := range []string{"work", "work/b", "work/a", "work/a/x", "reading", "zoo/orphan", "pinned"} {
+		tags[name] = &v1pb.Tag{Name: name}
+	}
+	tags["pinned"].Pinned = true
+	tags["work/b"].Pinned = true
+
+	roots := buildTagTree(tags)
+	// The pinned tags come first, then the tags are ordered by name.
+	// The nested tags without a parent tag are kept at the top level.
+	require.Equal(t, []string{"pinned", "reading", "work", "zoo/orphan"}, getTagNames(roots))
+	require.Equal(t, []string{"work/b", "work/a"}, getTagNames(tags["work"].Children))
+	require.Equal(t, []string{"work/a/x"}, getTagNames(tags["work/a"].Children))
+	require.Empty(t, tags["reading"].Children)
+
+	// Building again doesn't duplicate the children.
+	buildTagTree(tags)
+	require.Len(t, tags["work"].Children, 2)
+}
+
+func TestMergeTags(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "merger", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	parent := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	memo := createTestingTagMemo(ctx, t, s, user, "tagged", "#old and #old/nested and #other")
+	_, err := s.Store.UpsertTag(ctx, &store.Tag{CreatorID: user.ID, Name: "old", Color: "#ff0000"})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertTag(ctx, &store.Tag{CreatorID: user.ID, Name: "old/nested", Color: "#00ff00"})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertTag(ctx, &store.Tag{CreatorID: user.ID, Name: "new", Color: "#0000ff"})
+	require.NoError(t, err)
+
+	// The tags can't be merged into themselves or their nested tags.
+	_, err = s.MergeTags(userCtx, &v1pb.MergeTagsRequest{Parent: parent, SourceTags: []string{"old"}, TargetTag: "old"})
+	require.Error(t, err)
+	_, err = s.MergeTags(userCtx, &v1pb.MergeTagsRequest{Parent: parent, SourceTags: []string{"old"}, TargetTag: "old/nested"})
+	require.Error(t, err)
+	// Only the owner can merge the tags.
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	_, err = s.MergeTags(withUser(ctx, other), &v1pb.MergeTagsRequest{Parent: parent, SourceTags: []string{"old"}, TargetTag: "new"})
+	require.Error(t, err)
+
+	tag, err := s.MergeTags(userCtx, &v1pb.MergeTagsRequest{Parent: parent, SourceTags: []string{"#old"}, TargetTag: "new"})
+	require.NoError(t, err)
+	require.Equal(t, "new", tag.Name)
+	require.Equal(t, int32(1), tag.MemoCount)
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "#new and #new/nested and #other", memo.Content)
+	require.Equal(t, []string{"new", "new/nested", "other"}, memo.Payload.Tags)
+
+	// The attributes of the target tag are kept, the attributes of the nested tags are moved.
+	tagRows, err := s.Store.ListTags(ctx, &store.FindTag{CreatorID: &user.ID})
+	require.NoError(t, err)
+	colors := map[string]string{}
+	for _, tagRow := range tagRows {
+		colors[tagRow.Name] = tagRow.Color
+	}
+	require.Equal(t, map[string]string{"new": "#0000ff", "new/nested": "#00ff00"}, colors)
+}
+
+func TestRenameMemoTag(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "renamer", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	first := createTestingTagMemo(ctx, t, s, user, "first", "#old in the first memo, not #old/nested or #older")
+	second := createTestingTagMemo(ctx, t, s, user, "second", "#old in the second memo")
+	_, err := s.Store.UpsertTag(ctx, &store.Tag{CreatorID: user.ID, Name: "old", Color: "#ff0000", Pinned: true})
+	require.NoError(t, err)
+
+	// Renaming in a single memo keeps the attributes, the tag is still used by the other memos.
+	_, err = s.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: MemoNamePrefix + second.UID, OldTag: "old", NewTag: "renamed"})
+	require.NoError(t, err)
+	second, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &second.ID})
+	require.NoError(t, err)
+	require.Equal(t, "#renamed in the second memo", second.Content)
+	first, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, "#old in the first memo, not #old/nested or #older", first.Content)
+	oldName := "old"
+	tagRow, err := s.Store.GetTag(ctx, &store.FindTag{CreatorID: &user.ID, Name: &oldName})
+	require.NoError(t, err)
+	require.NotNil(t, tagRow)
+
+	// Renaming in all memos only renames the exact tag, and moves its attributes.
+	_, err = s.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: "memos/-", OldTag: "old", NewTag: "renamed"})
+	require.NoError(t, err)
+	first, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, "#renamed in the first memo, not #old/nested or #older", first.Content)
+	require.Equal(t, []string{"renamed", "old/nested", "older"}, first.Payload.Tags)
+	tagRow, err = s.Store.GetTag(ctx, &store.FindTag{CreatorID: &user.ID, Name: &oldName})
+	require.NoError(t, err)
+	require.Nil(t, tagRow)
+	newName := "renamed"
+	tagRow, err = s.Store.GetTag(ctx, &store.FindTag{CreatorID: &user.ID, Name: &newName})
+	require.NoError(t, err)
+	require.NotNil(t, tagRow)
+	require.Equal(t, "#ff0000", tagRow.Color)
+	require.True(t, tagRow.Pinned)
+}
+
+func createTestingTagMemo(ctx context.Context, t *testing.T, s *APIV1Service, user *store.User, uid, content string) *store.Memo {
+	memo := &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: store.Private,
+	}
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+	memo, err := s.Store.CreateMemo(ctx, memo)
+	require.NoError(t, err)
+	return memo
+}
+
+func getTagNames(tags []*v1pb.Tag) []string {
+	names := []string{}
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
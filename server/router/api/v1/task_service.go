@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTasks(ctx context.Context, request *v1pb.ListTasksRequest) (*v1pb.ListTasksResponse, error) {
+	for _, date := range []string{request.DueAfter, request.DueBefore} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(memopayload.TaskDueDateLayout, date); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid due date %q, expect YYYY-MM-DD", date)
+		}
+	}
+
+	state := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:       &state,
+		ExcludeComments: true,
+	}
+	if request.Tag != "" {
+		memoFind.PayloadFind = &store.FindMemoPayload{
+			TagSearch: []string{request.Tag},
+		}
+	}
+	if request.Parent != "" && request.Parent != "users/-" {
+		userID, err := ExtractUserIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		}
+		memoFind.CreatorID = &userID
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	filter := getVisibleMemosFilter(currentUser)
+	memoFind.Filter = &filter
+	taskFind := &store.FindMemoTask{
+		MemoFind: memoFind,
+		Complete: request.Complete,
+	}
+	if request.DueAfter != "" {
+		taskFind.DueAfter = &request.DueAfter
+	}
+	if request.DueBefore != "" {
+		taskFind.DueBefore = &request.DueBefore
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	taskFind.Limit = &limitPlusOne
+	taskFind.Offset = &offset
+
+	memoTasks, err := s.Store.ListMemoTasks(ctx, taskFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+	response := &v1pb.ListTasksResponse{
+		Tasks: []*v1pb.Task{},
+	}
+	if len(memoTasks) == limitPlusOne {
+		memoTasks = memoTasks[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	for _, memoTask := range memoTasks {
+		response.Tasks = append(response.Tasks, convertTaskFromStore(memoTask.MemoUID, memoTask.Index, memoTask.Task))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpdateTask(ctx context.Context, request *v1pb.UpdateTaskRequest) (*v1pb.Task, error) {
+	if request.Task == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "task is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	memoUID, index, err := ExtractTaskIndexFromName(request.Task.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if _, err := checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
+	}
+
+	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse memo content: %v", err)
+	}
+	taskNode := findTaskListItem(nodes, index)
+	if taskNode == nil {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+	for _, path := range request.UpdateMask.Paths {
+		if path == "complete" {
+			taskNode.Complete = request.Task.Complete
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path: %s", path)
+		}
+	}
+
+	// The memo is updated through UpdateMemo so that permissions, revisions and webhooks are handled.
+	// The update expects the memo the task is toggled in, so a concurrent edit of the content isn't overwritten.
+	if _, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content: restore.Restore(nodes),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		Etag:       getMemoEtag(memo),
+	}); err != nil {
+		return nil, err
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	tasks := memo.Payload.GetTasks()
+	if index >= len(tasks) {
+		return nil, status.Errorf(codes.Internal, "task not found after update")
+	}
+	return convertTaskFromStore(memo.UID, index, tasks[index]), nil
+}
+
+func convertTaskFromStore(memoUID string, index int, task *storepb.MemoPayload_Task) *v1pb.Task {
+	tags := task.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &v1pb.Task{
+		Name:     fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memoUID, TaskNamePrefix, index),
+		Memo:     fmt.Sprintf("%s%s", MemoNamePrefix, memoUID),
+		Content:  task.Content,
+		Complete: task.Complete,
+		DueDate:  task.DueDate,
+		Tags:     tags,
+	}
+}
+
+// findTaskListItem returns the task list item at the index in document order.
+func findTaskListItem(nodes []ast.Node, index int) *ast.TaskListItem {
+	var result *ast.TaskListItem
+	count := 0
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		if n, ok := node.(*ast.TaskListItem); ok {
+			if count == index {
+				result = n
+			}
+			count++
+		}
+	})
+	return result
+}
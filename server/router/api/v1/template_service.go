@@ -0,0 +1,265 @@
+package v1
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// templateVariableRegexp matches the template variables such as `{{date}}` and `{{ user.nickname }}`.
+var templateVariableRegexp = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_.]+)\s*\}\}`)
+
+func (s *APIV1Service) ListTemplates(ctx context.Context, request *v1pb.ListTemplatesRequest) (*v1pb.ListTemplatesResponse, error) {
+	userID, err := s.getTemplateOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	templatesUserSetting, err := s.getTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get templates user setting: %v", err)
+	}
+	templates := []*v1pb.Template{}
+	for _, template := range templatesUserSetting.GetTemplates() {
+		templates = append(templates, convertTemplateFromStore(template))
+	}
+	return &v1pb.ListTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+func (s *APIV1Service) CreateTemplate(ctx context.Context, request *v1pb.CreateTemplateRequest) (*v1pb.Template, error) {
+	userID, err := s.getTemplateOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	newTemplate := &storepb.TemplatesUserSetting_Template{
+		Id:         util.GenUUID(),
+		Title:      request.Template.GetTitle(),
+		Content:    request.Template.GetContent(),
+		Visibility: convertVisibilityToStore(request.Template.GetVisibility()).String(),
+	}
+	if newTemplate.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title is required")
+	}
+
+	templatesUserSetting, err := s.getTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get templates user setting: %v", err)
+	}
+	templatesUserSetting.Templates = append(templatesUserSetting.Templates, newTemplate)
+	if err := s.upsertTemplatesUserSetting(ctx, userID, templatesUserSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert templates user setting: %v", err)
+	}
+	return convertTemplateFromStore(newTemplate), nil
+}
+
+func (s *APIV1Service) UpdateTemplate(ctx context.Context, request *v1pb.UpdateTemplateRequest) (*v1pb.Template, error) {
+	userID, err := s.getTemplateOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	templatesUserSetting, err := s.getTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get templates user setting: %v", err)
+	}
+	var updatedTemplate *storepb.TemplatesUserSetting_Template
+	for _, template := range templatesUserSetting.GetTemplates() {
+		if template.GetId() != request.Template.GetId() {
+			continue
+		}
+		for _, field := range request.UpdateMask.Paths {
+			switch field {
+			case "title":
+				if request.Template.GetTitle() == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "title is required")
+				}
+				template.Title = request.Template.GetTitle()
+			case "content":
+				template.Content = request.Template.GetContent()
+			case "visibility":
+				template.Visibility = convertVisibilityToStore(request.Template.GetVisibility()).String()
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
+			}
+		}
+		updatedTemplate = template
+	}
+	if updatedTemplate == nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+
+	if err := s.upsertTemplatesUserSetting(ctx, userID, templatesUserSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert templates user setting: %v", err)
+	}
+	return convertTemplateFromStore(updatedTemplate), nil
+}
+
+func (s *APIV1Service) DeleteTemplate(ctx context.Context, request *v1pb.DeleteTemplateRequest) (*emptypb.Empty, error) {
+	userID, err := s.getTemplateOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	templatesUserSetting, err := s.getTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get templates user setting: %v", err)
+	}
+	templates := templatesUserSetting.GetTemplates()
+	newTemplates := make([]*storepb.TemplatesUserSetting_Template, 0, len(templates))
+	for _, template := range templates {
+		if template.GetId() != request.Id {
+			newTemplates = append(newTemplates, template)
+		}
+	}
+	templatesUserSetting.Templates = newTemplates
+	if err := s.upsertTemplatesUserSetting(ctx, userID, templatesUserSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert templates user setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) CreateMemoFromTemplate(ctx context.Context, request *v1pb.CreateMemoFromTemplateRequest) (*v1pb.Memo, error) {
+	userID, err := s.getTemplateOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	templatesUserSetting, err := s.getTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get templates user setting: %v", err)
+	}
+	var template *storepb.TemplatesUserSetting_Template
+	for _, t := range templatesUserSetting.GetTemplates() {
+		if t.GetId() == request.Id {
+			template = t
+			break
+		}
+	}
+	if template == nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+
+	location := time.Local
+	if request.Timezone != "" {
+		location, err = time.LoadLocation(request.Timezone)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timezone: %v", err)
+		}
+	}
+	variables := getTemplateVariables(user, time.Now().In(location))
+	// The caller-provided variables take precedence over the built-in ones.
+	for key, value := range request.Variables {
+		variables[key] = value
+	}
+
+	visibility := store.Private
+	if template.Visibility != "" {
+		visibility = store.Visibility(template.Visibility)
+	}
+	return s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    expandTemplateContent(template.Content, variables),
+			Visibility: convertVisibilityFromStore(visibility),
+		},
+	})
+}
+
+// getTemplateOwnerID returns the user id of the parent if it is the current user.
+func (s *APIV1Service) getTemplateOwnerID(ctx context.Context, parent string) (int32, error) {
+	userID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func (s *APIV1Service) getTemplatesUserSetting(ctx context.Context, userID int32) (*storepb.TemplatesUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_TEMPLATES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetTemplates() == nil {
+		return &storepb.TemplatesUserSetting{
+			Templates: []*storepb.TemplatesUserSetting_Template{},
+		}, nil
+	}
+	return userSetting.GetTemplates(), nil
+}
+
+func (s *APIV1Service) upsertTemplatesUserSetting(ctx context.Context, userID int32, templatesUserSetting *storepb.TemplatesUserSetting) error {
+	_, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_TEMPLATES,
+		Value: &storepb.UserSetting_Templates{
+			Templates: templatesUserSetting,
+		},
+	})
+	return err
+}
+
+// getTemplateVariables returns the built-in template variables of the user at the time.
+func getTemplateVariables(user *store.User, now time.Time) map[string]string {
+	return map[string]string{
+		"date":          now.Format(time.DateOnly),
+		"time":          now.Format("15:04"),
+		"user.nickname": user.Nickname,
+		"user.username": user.Username,
+	}
+}
+
+// expandTemplateContent replaces the template variables with their values.
+// Unknown variables are kept as they are.
+func expandTemplateContent(content string, variables map[string]string) string {
+	return templateVariableRegexp.ReplaceAllStringFunc(content, func(match string) string {
+		name := templateVariableRegexp.FindStringSubmatch(match)[1]
+		if value, ok := variables[name]; ok {
+			return value
+		}
+		return match
+	})
+}
+
+func convertTemplateFromStore(template *storepb.TemplatesUserSetting_Template) *v1pb.Template {
+	visibility := store.Private
+	if template.Visibility != "" {
+		visibility = store.Visibility(template.Visibility)
+	}
+	return &v1pb.Template{
+		Id:         template.Id,
+		Title:      template.Title,
+		Content:    template.Content,
+		Visibility: convertVisibilityFromStore(visibility),
+	}
+}
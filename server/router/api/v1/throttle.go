@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// requestThrottle limits the number of the requests of each key in a period, e.g. the password resets of an email.
+type requestThrottle struct {
+	mu      sync.Mutex
+	limit   int
+	period  time.Duration
+	windows map[string]*throttleWindow
+}
+
+type throttleWindow struct {
+	start time.Time
+	count int
+}
+
+func newRequestThrottle(limit int, period time.Duration) *requestThrottle {
+	return &requestThrottle{
+		limit:   limit,
+		period:  period,
+		windows: map[string]*throttleWindow{},
+	}
+}
+
+// allow records the request of the key, it returns false if the key has reached the limit in the current period.
+func (t *requestThrottle) allow(key string, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// The ended periods are dropped, so the keys don't pile up.
+	for k, window := range t.windows {
+		if now.Sub(window.start) >= t.period {
+			delete(t.windows, k)
+		}
+	}
+	window, ok := t.windows[key]
+	if !ok {
+		window = &throttleWindow{start: now}
+		t.windows[key] = window
+	}
+	if window.count >= t.limit {
+		return false
+	}
+	window.count++
+	return true
+}
+
+// getClientIP returns the IP of the client. The requests through the gateway come from the loopback,
+// the gateway appends the address of its client to X-Forwarded-For, so the last one is taken.
+func getClientIP(ctx context.Context) string {
+	var peerIP net.IP
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		peerIP = net.ParseIP(host)
+		if peerIP != nil && !peerIP.IsLoopback() {
+			return peerIP.String()
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-forwarded-for"); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			return strings.TrimSpace(forwarded[len(forwarded)-1])
+		}
+	}
+	if peerIP != nil {
+		return peerIP.String()
+	}
+	return ""
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"regexp"
 	"slices"
 	"strings"
@@ -21,6 +23,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/internal/util"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -214,6 +217,9 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			passwordHashStr := string(passwordHash)
 			update.PasswordHash = &passwordHashStr
 		} else if field == "state" {
+			if request.User.State == v1pb.State_DELETED {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid user state: %s", request.User.State)
+			}
 			rowStatus := convertStateToStore(request.User.State)
 			update.RowStatus = &rowStatus
 		} else {
@@ -287,6 +293,10 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, _ *v1pb.GetUserSettin
 			userSettingMessage.Appearance = setting.GetAppearance()
 		} else if setting.Key == storepb.UserSettingKey_MEMO_VISIBILITY {
 			userSettingMessage.MemoVisibility = setting.GetMemoVisibility()
+		} else if setting.Key == storepb.UserSettingKey_LIFECYCLE_RULES {
+			userSettingMessage.LifecycleRules = convertLifecycleRulesFromStore(setting.GetLifecycleRules().GetRules())
+		} else if setting.Key == storepb.UserSettingKey_NOTIFICATIONS {
+			userSettingMessage.NotificationPreferences = convertNotificationPreferencesFromStore(setting.GetNotifications())
 		}
 	}
 	return userSettingMessage, nil
@@ -333,6 +343,36 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 			}
+		} else if field == "lifecycle_rules" {
+			rules := convertLifecycleRulesToStore(request.Setting.LifecycleRules)
+			if err := s.normalizeLifecycleRules(ctx, rules); err != nil {
+				return nil, err
+			}
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_LIFECYCLE_RULES,
+				Value: &storepb.UserSetting_LifecycleRules{
+					LifecycleRules: &storepb.LifecycleRulesUserSetting{
+						Rules: rules,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
+		} else if field == "notification_preferences" {
+			notificationsUserSetting := convertNotificationPreferencesToStore(request.Setting.NotificationPreferences)
+			if err := s.validateAlertShortcutIDs(ctx, user.ID, notificationsUserSetting.AlertShortcutIds); err != nil {
+				return nil, err
+			}
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_NOTIFICATIONS,
+				Value: &storepb.UserSetting_Notifications{
+					Notifications: notificationsUserSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
@@ -341,6 +381,54 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{})
 }
 
+// validateAlertShortcutIDs checks that the shortcuts to alert about are shortcuts of the user.
+func (s *APIV1Service) validateAlertShortcutIDs(ctx context.Context, userID int32, shortcutIDs []string) error {
+	if len(shortcutIDs) == 0 {
+		return nil
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_SHORTCUTS,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	for _, shortcutID := range shortcutIDs {
+		if !slices.ContainsFunc(userSetting.GetShortcuts().GetShortcuts(), func(shortcut *storepb.ShortcutsUserSetting_Shortcut) bool {
+			return shortcut.GetId() == shortcutID
+		}) {
+			return status.Errorf(codes.InvalidArgument, "shortcut not found: %s", shortcutID)
+		}
+	}
+	return nil
+}
+
+func convertNotificationPreferencesFromStore(notificationsUserSetting *storepb.NotificationsUserSetting) *v1pb.NotificationPreferences {
+	mutedTypes := []v1pb.Inbox_Type{}
+	for _, mutedType := range notificationsUserSetting.GetMutedTypes() {
+		mutedTypes = append(mutedTypes, v1pb.Inbox_Type(mutedType))
+	}
+	return &v1pb.NotificationPreferences{
+		MutedTypes:       mutedTypes,
+		AlertShortcutIds: notificationsUserSetting.GetAlertShortcutIds(),
+		DailyDigest:      notificationsUserSetting.GetDailyDigest(),
+		Email:            notificationsUserSetting.GetEmail(),
+	}
+}
+
+func convertNotificationPreferencesToStore(notificationPreferences *v1pb.NotificationPreferences) *storepb.NotificationsUserSetting {
+	mutedTypes := []storepb.InboxMessage_Type{}
+	for _, mutedType := range notificationPreferences.GetMutedTypes() {
+		mutedTypes = append(mutedTypes, storepb.InboxMessage_Type(mutedType))
+	}
+	return &storepb.NotificationsUserSetting{
+		MutedTypes:       mutedTypes,
+		AlertShortcutIds: notificationPreferences.GetAlertShortcutIds(),
+		DailyDigest:      notificationPreferences.GetDailyDigest(),
+		Email:            notificationPreferences.GetEmail(),
+	}
+}
+
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	userID, err := ExtractUserIDFromName(request.Name)
 	if err != nil {
@@ -527,6 +615,127 @@ func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store
 	return nil
 }
 
+func (s *APIV1Service) RegisterPushSubscription(ctx context.Context, request *v1pb.RegisterPushSubscriptionRequest) (*v1pb.PushSubscription, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	pushSubscription := request.PushSubscription
+	if pushSubscription == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "push subscription is required")
+	}
+	if err := validatePushSubscription(pushSubscription); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid push subscription: %v", err)
+	}
+
+	userPushSubscriptions, err := s.Store.GetUserPushSubscriptions(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list push subscriptions: %v", err)
+	}
+	// The browser registers the same endpoint again when its keys are rotated, so the subscription is updated in place.
+	var userPushSubscription *storepb.PushSubscriptionsUserSetting_PushSubscription
+	for _, subscription := range userPushSubscriptions {
+		if subscription.Endpoint == pushSubscription.Endpoint {
+			userPushSubscription = subscription
+			break
+		}
+	}
+	if userPushSubscription == nil {
+		userPushSubscription = &storepb.PushSubscriptionsUserSetting_PushSubscription{
+			Id:        util.GenUUID(),
+			Endpoint:  pushSubscription.Endpoint,
+			CreatedTs: time.Now().Unix(),
+		}
+		userPushSubscriptions = append(userPushSubscriptions, userPushSubscription)
+	}
+	userPushSubscription.P256Dh = pushSubscription.P256Dh
+	userPushSubscription.Auth = pushSubscription.Auth
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: currentUser.ID,
+		Key:    storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_PushSubscriptions{
+			PushSubscriptions: &storepb.PushSubscriptionsUserSetting{
+				Subscriptions: userPushSubscriptions,
+			},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return convertPushSubscriptionFromStore(userPushSubscription), nil
+}
+
+func (s *APIV1Service) DeletePushSubscription(ctx context.Context, request *v1pb.DeletePushSubscriptionRequest) (*emptypb.Empty, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	userPushSubscriptions, err := s.Store.GetUserPushSubscriptions(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list push subscriptions: %v", err)
+	}
+	if !slices.ContainsFunc(userPushSubscriptions, func(subscription *storepb.PushSubscriptionsUserSetting_PushSubscription) bool {
+		return subscription.Id == request.Id
+	}) {
+		return nil, status.Errorf(codes.NotFound, "push subscription not found")
+	}
+	if err := s.Store.RemoveUserPushSubscription(ctx, currentUser.ID, request.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete push subscription: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func validatePushSubscription(pushSubscription *v1pb.PushSubscription) error {
+	endpoint, err := url.Parse(pushSubscription.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return errors.Errorf("invalid endpoint %q", pushSubscription.Endpoint)
+	}
+	// Browsers only hand out https endpoints of the public push services, the others could make the server
+	// send requests to the internal network.
+	if endpoint.Scheme != "https" {
+		return errors.Errorf("invalid endpoint scheme %q", endpoint.Scheme)
+	}
+	if hostname := endpoint.Hostname(); hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") {
+		return errors.Errorf("invalid endpoint host %q", hostname)
+	} else if ip := net.ParseIP(hostname); ip != nil && (ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified()) {
+		return errors.Errorf("invalid endpoint host %q", hostname)
+	}
+	if pushSubscription.P256Dh == "" || pushSubscription.Auth == "" {
+		return errors.New("p256dh and auth keys are required")
+	}
+	return nil
+}
+
+func convertPushSubscriptionFromStore(pushSubscription *storepb.PushSubscriptionsUserSetting_PushSubscription) *v1pb.PushSubscription {
+	return &v1pb.PushSubscription{
+		Id:         pushSubscription.Id,
+		Endpoint:   pushSubscription.Endpoint,
+		P256Dh:     pushSubscription.P256Dh,
+		Auth:       pushSubscription.Auth,
+		CreateTime: timestamppb.New(time.Unix(pushSubscription.CreatedTs, 0)),
+	}
+}
+
 func convertUserFromStore(user *store.User) *v1pb.User {
 	userpb := &v1pb.User{
 		Name:        fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
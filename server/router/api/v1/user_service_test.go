@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestRegisterPushSubscription(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	name := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+
+	// The endpoints other than the https ones of the public hosts are rejected.
+	for _, endpoint := range []string{
+		"",
+		"push.example.com/send",
+		"http://push.example.com/send",
+		"ftp://push.example.com/send",
+		"https://localhost/send",
+		"https://127.0.0.1/send",
+		"https://10.0.0.1/send",
+		"https://192.168.1.1:8443/send",
+		"https://169.254.169.254/latest",
+		"https://[::1]/send",
+	} {
+		_, err := s.RegisterPushSubscription(userCtx, &v1pb.RegisterPushSubscriptionRequest{
+			Name:             name,
+			PushSubscription: &v1pb.PushSubscription{Endpoint: endpoint, P256Dh: "p256dh", Auth: "auth"},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), endpoint)
+	}
+	_, err := s.RegisterPushSubscription(userCtx, &v1pb.RegisterPushSubscriptionRequest{
+		Name:             name,
+		PushSubscription: &v1pb.PushSubscription{Endpoint: "https://push.example.com/send"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The subscriptions of other users can't be registered.
+	_, err = s.RegisterPushSubscription(withUser(ctx, other), &v1pb.RegisterPushSubscriptionRequest{
+		Name:             name,
+		PushSubscription: &v1pb.PushSubscription{Endpoint: "https://push.example.com/send", P256Dh: "p256dh", Auth: "auth"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	subscription, err := s.RegisterPushSubscription(userCtx, &v1pb.RegisterPushSubscriptionRequest{
+		Name:             name,
+		PushSubscription: &v1pb.PushSubscription{Endpoint: "https://push.example.com/send", P256Dh: "p256dh", Auth: "auth"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, subscription.Id)
+
+	// The same endpoint is registered again with the rotated keys, it's updated in place.
+	updatedSubscription, err := s.RegisterPushSubscription(userCtx, &v1pb.RegisterPushSubscriptionRequest{
+		Name:             name,
+		PushSubscription: &v1pb.PushSubscription{Endpoint: "https://push.example.com/send", P256Dh: "rotated-p256dh", Auth: "rotated-auth"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, subscription.Id, updatedSubscription.Id)
+	subscriptions, err := s.Store.GetUserPushSubscriptions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, "rotated-p256dh", subscriptions[0].P256Dh)
+	require.Equal(t, "rotated-auth", subscriptions[0].Auth)
+}
+
+func TestDeletePushSubscription(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	name := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	subscription, err := s.RegisterPushSubscription(userCtx, &v1pb.RegisterPushSubscriptionRequest{
+		Name:             name,
+		PushSubscription: &v1pb.PushSubscription{Endpoint: "https://push.example.com/send", P256Dh: "p256dh", Auth: "auth"},
+	})
+	require.NoError(t, err)
+
+	_, err = s.DeletePushSubscription(withUser(ctx, other), &v1pb.DeletePushSubscriptionRequest{Name: name, Id: subscription.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DeletePushSubscription(userCtx, &v1pb.DeletePushSubscriptionRequest{Name: name, Id: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeletePushSubscription(userCtx, &v1pb.DeletePushSubscriptionRequest{Name: name, Id: subscription.Id})
+	require.NoError(t, err)
+	subscriptions, err := s.Store.GetUserPushSubscriptions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, subscriptions)
+	_, err = s.DeletePushSubscription(userCtx, &v1pb.DeletePushSubscriptionRequest{Name: name, Id: subscription.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/textproto"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -29,6 +30,9 @@ type APIV1Service struct {
 	v1pb.UnimplementedMemoServiceServer
 	v1pb.UnimplementedResourceServiceServer
 	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedTagServiceServer
+	v1pb.UnimplementedTemplateServiceServer
+	v1pb.UnimplementedTaskServiceServer
 	v1pb.UnimplementedInboxServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedWebhookServiceServer
@@ -40,16 +44,24 @@ type APIV1Service struct {
 	Profile *profile.Profile
 	Store   *store.Store
 
-	grpcServer *grpc.Server
+	grpcServer  *grpc.Server
+	memoWatcher *memoWatcher
+	// passwordResetEmailThrottle and passwordResetIPThrottle limit the password reset requests
+	// of each email and each client IP.
+	passwordResetEmailThrottle *requestThrottle
+	passwordResetIPThrottle    *requestThrottle
 }
 
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
 	grpc.EnableTracing = true
 	apiv1Service := &APIV1Service{
-		Secret:     secret,
-		Profile:    profile,
-		Store:      store,
-		grpcServer: grpcServer,
+		Secret:                     secret,
+		Profile:                    profile,
+		Store:                      store,
+		grpcServer:                 grpcServer,
+		memoWatcher:                newMemoWatcher(),
+		passwordResetEmailThrottle: newRequestThrottle(passwordResetEmailLimit, passwordResetThrottlePeriod),
+		passwordResetIPThrottle:    newRequestThrottle(passwordResetIPLimit, passwordResetThrottlePeriod),
 	}
 	grpc_health_v1.RegisterHealthServer(grpcServer, apiv1Service)
 	v1pb.RegisterWorkspaceServiceServer(grpcServer, apiv1Service)
@@ -59,6 +71,9 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterMemoServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterResourceServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTagServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTemplateServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTaskServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterInboxServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterWebhookServiceServer(grpcServer, apiv1Service)
@@ -86,7 +101,7 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	if err := v1pb.RegisterWorkspaceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -108,6 +123,15 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterShortcutServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterTagServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterTemplateServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterTaskServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterInboxServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -132,6 +156,7 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 
 	gwGroup.Any("/api/v1/*", handler)
 	gwGroup.Any("/file/*", handler)
+	s.registerMemoEventsSSE(echoServer, conn)
 
 	// GRPC web proxy.
 	options := []grpcweb.Option{
@@ -145,3 +170,15 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 
 	return nil
 }
+
+// ifMatchMetadataKey is the metadata key of the etag precondition.
+const ifMatchMetadataKey = "if-match"
+
+// incomingHeaderMatcher forwards the `If-Match` header as the `if-match` metadata,
+// so that it can be used as the etag precondition of the requests.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "If-Match" {
+		return ifMatchMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
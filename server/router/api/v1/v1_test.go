@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+// newTestingService returns the service backed by a testing store, without the gRPC server.
+func newTestingService(ctx context.Context, t *testing.T) *APIV1Service {
+	ts := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() {
+		ts.Close()
+	})
+	return &APIV1Service{
+		Secret:                     "test-secret",
+		Profile:                    &profile.Profile{Mode: "dev"},
+		Store:                      ts,
+		memoWatcher:                newMemoWatcher(),
+		passwordResetEmailThrottle: newRequestThrottle(passwordResetEmailLimit, passwordResetThrottlePeriod),
+		passwordResetIPThrottle:    newRequestThrottle(passwordResetIPLimit, passwordResetThrottlePeriod),
+	}
+}
+
+func createTestingUser(ctx context.Context, t *testing.T, s *APIV1Service, username string, role store.Role) *store.User {
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     role,
+		Email:    username + "@test.com",
+		Nickname: username,
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// withUser returns the context authenticated as the user.
+func withUser(ctx context.Context, user *store.User) context.Context {
+	return context.WithValue(ctx, usernameContextKey, user.Username)
+}
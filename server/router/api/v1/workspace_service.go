@@ -24,6 +24,11 @@ func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorks
 	if owner != nil {
 		workspaceProfile.Owner = owner.Name
 	}
+	workspaceBasicSetting, err := s.Store.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace basic setting: %v", err)
+	}
+	workspaceProfile.VapidPublicKey = workspaceBasicSetting.VapidPublicKey
 	return workspaceProfile, nil
 }
 
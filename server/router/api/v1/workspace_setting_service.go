@@ -3,10 +3,12 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/mail"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/internal/util"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -31,6 +33,10 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		_, err = s.Store.GetWorkspaceStorageSetting(ctx)
 	case storepb.WorkspaceSettingKey_AI_MODEL:
 		// Do nothing.
+	case storepb.WorkspaceSettingKey_LIFECYCLE:
+		_, err = s.Store.GetWorkspaceLifecycleSetting(ctx)
+	case storepb.WorkspaceSettingKey_NOTIFICATION:
+		_, err = s.Store.GetWorkspaceNotificationSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported workspace setting key: %v", workspaceSettingKey)
 	}
@@ -48,8 +54,8 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		return nil, status.Errorf(codes.NotFound, "workspace setting not found")
 	}
 
-	// For storage setting and AI model setting, only host can get it.
-	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE || workspaceSetting.Key == storepb.WorkspaceSettingKey_AI_MODEL {
+	// For storage setting, AI model setting and notification setting, only host can get it.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE || workspaceSetting.Key == storepb.WorkspaceSettingKey_AI_MODEL || workspaceSetting.Key == storepb.WorkspaceSettingKey_NOTIFICATION {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -72,6 +78,16 @@ func (s *APIV1Service) SetWorkspaceSetting(ctx context.Context, request *v1pb.Se
 	}
 
 	updateSetting := convertWorkspaceSettingToStore(request.Setting)
+	if lifecycleSetting := updateSetting.GetLifecycleSetting(); lifecycleSetting != nil {
+		if err := s.normalizeLifecycleRules(ctx, lifecycleSetting.Rules); err != nil {
+			return nil, err
+		}
+	}
+	if notificationSetting := updateSetting.GetNotificationSetting(); notificationSetting != nil {
+		if err := validateEmailSetting(notificationSetting.GetEmail()); err != nil {
+			return nil, err
+		}
+	}
 	workspaceSetting, err := s.Store.UpsertWorkspaceSetting(ctx, updateSetting)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert workspace setting: %v", err)
@@ -101,6 +117,16 @@ func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *v1pb.W
 		workspaceSetting.Value = &v1pb.WorkspaceSetting_AiModelSetting{
 			AiModelSetting: convertWorkspaceAIModelSettingFromStore(setting.GetAiModelSetting()),
 		}
+	case *storepb.WorkspaceSetting_LifecycleSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_LifecycleSetting{
+			LifecycleSetting: &v1pb.WorkspaceLifecycleSetting{
+				Rules: convertLifecycleRulesFromStore(setting.GetLifecycleSetting().GetRules()),
+			},
+		}
+	case *storepb.WorkspaceSetting_NotificationSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_NotificationSetting{
+			NotificationSetting: convertWorkspaceNotificationSettingFromStore(setting.GetNotificationSetting()),
+		}
 	}
 	return workspaceSetting
 }
@@ -130,6 +156,16 @@ func convertWorkspaceSettingToStore(setting *v1pb.WorkspaceSetting) *storepb.Wor
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiModelSetting{
 			AiModelSetting: convertWorkspaceAIModelSettingToStore(setting.GetAiModelSetting()),
 		}
+	case storepb.WorkspaceSettingKey_LIFECYCLE:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_LifecycleSetting{
+			LifecycleSetting: &storepb.WorkspaceLifecycleSetting{
+				Rules: convertLifecycleRulesToStore(setting.GetLifecycleSetting().GetRules()),
+			},
+		}
+	case storepb.WorkspaceSettingKey_NOTIFICATION:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_NotificationSetting{
+			NotificationSetting: convertWorkspaceNotificationSettingToStore(setting.GetNotificationSetting()),
+		}
 	}
 	return workspaceSetting
 }
@@ -243,6 +279,7 @@ func convertWorkspaceMemoRelatedSettingFromStore(setting *storepb.WorkspaceMemoR
 		DisableMarkdownShortcuts: setting.DisableMarkdownShortcuts,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		TrashRetentionDays:       setting.TrashRetentionDays,
 	}
 }
 
@@ -261,6 +298,7 @@ func convertWorkspaceMemoRelatedSettingToStore(setting *v1pb.WorkspaceMemoRelate
 		DisableMarkdownShortcuts: setting.DisableMarkdownShortcuts,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		TrashRetentionDays:       setting.TrashRetentionDays,
 	}
 }
 
@@ -285,3 +323,132 @@ func convertWorkspaceAIModelSettingToStore(setting *v1pb.WorkspaceAIModelSetting
 		BaseUrl: setting.BaseUrl,
 	}
 }
+
+// validateEmailSetting checks that the SMTP server and the sender are set once the email notifications are enabled.
+func validateEmailSetting(setting *storepb.WorkspaceNotificationSetting_EmailSetting) error {
+	if !setting.GetEnabled() {
+		return nil
+	}
+	if setting.SmtpHost == "" {
+		return status.Errorf(codes.InvalidArgument, "SMTP host is required")
+	}
+	if setting.SmtpPort <= 0 || setting.SmtpPort > 65535 {
+		return status.Errorf(codes.InvalidArgument, "invalid SMTP port: %d", setting.SmtpPort)
+	}
+	if _, err := mail.ParseAddress(setting.FromEmail); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid from email: %v", err)
+	}
+	return nil
+}
+
+func convertWorkspaceNotificationSettingFromStore(setting *storepb.WorkspaceNotificationSetting) *v1pb.WorkspaceNotificationSetting {
+	if setting == nil {
+		return nil
+	}
+	notificationSetting := &v1pb.WorkspaceNotificationSetting{}
+	if setting.Email != nil {
+		notificationSetting.Email = &v1pb.WorkspaceNotificationSetting_EmailSetting{
+			Enabled:      setting.Email.Enabled,
+			SmtpHost:     setting.Email.SmtpHost,
+			SmtpPort:     setting.Email.SmtpPort,
+			Security:     v1pb.WorkspaceNotificationSetting_EmailSetting_Security(setting.Email.Security),
+			SmtpUsername: setting.Email.SmtpUsername,
+			SmtpPassword: setting.Email.SmtpPassword,
+			FromEmail:    setting.Email.FromEmail,
+			FromName:     setting.Email.FromName,
+		}
+	}
+	return notificationSetting
+}
+
+func convertWorkspaceNotificationSettingToStore(setting *v1pb.WorkspaceNotificationSetting) *storepb.WorkspaceNotificationSetting {
+	if setting == nil {
+		return nil
+	}
+	notificationSetting := &storepb.WorkspaceNotificationSetting{}
+	if setting.Email != nil {
+		notificationSetting.Email = &storepb.WorkspaceNotificationSetting_EmailSetting{
+			Enabled:      setting.Email.Enabled,
+			SmtpHost:     setting.Email.SmtpHost,
+			SmtpPort:     setting.Email.SmtpPort,
+			Security:     storepb.WorkspaceNotificationSetting_EmailSetting_Security(setting.Email.Security),
+			SmtpUsername: setting.Email.SmtpUsername,
+			SmtpPassword: setting.Email.SmtpPassword,
+			FromEmail:    setting.Email.FromEmail,
+			FromName:     setting.Email.FromName,
+		}
+	}
+	return notificationSetting
+}
+
+func convertLifecycleRulesFromStore(rules []*storepb.LifecycleRule) []*v1pb.LifecycleRule {
+	result := []*v1pb.LifecycleRule{}
+	for _, rule := range rules {
+		lifecycleRule := &v1pb.LifecycleRule{
+			Id:      rule.Id,
+			Title:   rule.Title,
+			Filter:  rule.Filter,
+			AgeDays: rule.AgeDays,
+			Action:  v1pb.LifecycleRule_Action(rule.Action),
+			Tag:     rule.Tag,
+		}
+		if rule.Visibility != "" {
+			lifecycleRule.Visibility = convertVisibilityFromStore(store.Visibility(rule.Visibility))
+		}
+		result = append(result, lifecycleRule)
+	}
+	return result
+}
+
+func convertLifecycleRulesToStore(rules []*v1pb.LifecycleRule) []*storepb.LifecycleRule {
+	result := []*storepb.LifecycleRule{}
+	for _, rule := range rules {
+		lifecycleRule := &storepb.LifecycleRule{
+			Id:      rule.Id,
+			Title:   rule.Title,
+			Filter:  rule.Filter,
+			AgeDays: rule.AgeDays,
+			Action:  storepb.LifecycleRule_Action(rule.Action),
+			Tag:     rule.Tag,
+		}
+		if rule.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			lifecycleRule.Visibility = convertVisibilityToStore(rule.Visibility).String()
+		}
+		result = append(result, lifecycleRule)
+	}
+	return result
+}
+
+// normalizeLifecycleRules validates the lifecycle rules and fills in the generated ids.
+func (s *APIV1Service) normalizeLifecycleRules(ctx context.Context, rules []*storepb.LifecycleRule) error {
+	for _, rule := range rules {
+		if rule.AgeDays <= 0 {
+			return status.Errorf(codes.InvalidArgument, "age days must be positive")
+		}
+		// The rules without a filter apply to all the stale memos, a filter must select some of them.
+		if rule.Filter != "" {
+			if err := s.validateSelectiveFilter(ctx, rule.Filter); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+			}
+		}
+		switch rule.Action {
+		case storepb.LifecycleRule_ARCHIVE, storepb.LifecycleRule_DELETE:
+		case storepb.LifecycleRule_CHANGE_VISIBILITY:
+			if rule.Visibility == "" {
+				return status.Errorf(codes.InvalidArgument, "visibility is required")
+			}
+		case storepb.LifecycleRule_ADD_TAG:
+			tag, err := normalizeBatchTag(rule.Tag)
+			if err != nil {
+				return err
+			}
+			rule.Tag = tag
+		default:
+			return status.Errorf(codes.InvalidArgument, "unsupported action: %v", rule.Action)
+		}
+		if rule.Id == "" {
+			rule.Id = util.GenUUID()
+		}
+	}
+	return nil
+}
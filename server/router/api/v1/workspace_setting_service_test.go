@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestNormalizeLifecycleRules(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+
+	// The rules get the generated ids and the normalized tags.
+	rules := []*storepb.LifecycleRule{
+		{AgeDays: 30, Action: storepb.LifecycleRule_ARCHIVE},
+		{AgeDays: 30, Filter: `tag in ["stale"]`, Action: storepb.LifecycleRule_ADD_TAG, Tag: "#later"},
+	}
+	require.NoError(t, s.normalizeLifecycleRules(ctx, rules))
+	require.NotEmpty(t, rules[0].Id)
+	require.Equal(t, "later", rules[1].Tag)
+
+	// The filters selecting all the memos, or failing to convert, are rejected.
+	for _, filter := range []string{`true`, `content.endsWith("x")`, `tag in`} {
+		err := s.normalizeLifecycleRules(ctx, []*storepb.LifecycleRule{{AgeDays: 30, Filter: filter, Action: storepb.LifecycleRule_DELETE}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+	}
+	err := s.normalizeLifecycleRules(ctx, []*storepb.LifecycleRule{{Action: storepb.LifecycleRule_DELETE}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
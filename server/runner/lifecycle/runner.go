@@ -0,0 +1,286 @@
+package lifecycle
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/plugin/filter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+
+	// OnApplied is called after a lifecycle rule has changed the memos.
+	OnApplied func(ctx context.Context, rule *storepb.LifecycleRule, memoIDs []int32)
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Evaluate the lifecycle rules every hour.
+const runnerSpec = "@every 1h"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSpec, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule lifecycle runner", "error", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.ApplyWorkspaceRules(ctx)
+	r.ApplyUserRules(ctx)
+}
+
+// ApplyWorkspaceRules applies the workspace lifecycle rules to the memos of all users.
+func (r *Runner) ApplyWorkspaceRules(ctx context.Context) {
+	workspaceLifecycleSetting, err := r.Store.GetWorkspaceLifecycleSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace lifecycle setting", "error", err)
+		return
+	}
+	if len(workspaceLifecycleSetting.Rules) == 0 {
+		return
+	}
+
+	// The notifications of the workspace rules are sent by the host.
+	hostRole := store.RoleHost
+	host, err := r.Store.GetUser(ctx, &store.FindUser{Role: &hostRole})
+	if err != nil {
+		slog.Error("Failed to get host user", "error", err)
+		return
+	}
+	var senderID *int32
+	if host != nil {
+		senderID = &host.ID
+	}
+	for _, rule := range workspaceLifecycleSetting.Rules {
+		if err := r.ApplyRule(ctx, rule, nil, senderID); err != nil {
+			slog.Error("Failed to apply workspace lifecycle rule", "error", err, "ruleID", rule.Id)
+		}
+	}
+}
+
+// ApplyUserRules applies the lifecycle rules of each user to the memos of the user.
+func (r *Runner) ApplyUserRules(ctx context.Context) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_LIFECYCLE_RULES,
+	})
+	if err != nil {
+		slog.Error("Failed to list user lifecycle rules", "error", err)
+		return
+	}
+
+	for _, userSetting := range userSettings {
+		userID := userSetting.UserId
+		for _, rule := range userSetting.GetLifecycleRules().GetRules() {
+			if err := r.ApplyRule(ctx, rule, &userID, &userID); err != nil {
+				slog.Error("Failed to apply user lifecycle rule", "error", err, "ruleID", rule.Id, "userID", userID)
+			}
+		}
+	}
+}
+
+// ApplyRule applies the rule to the memos of the creator, or of all users if the creator is nil.
+// Each affected user gets an activity and an inbox message sent by the sender, or by themself if the sender is nil.
+func (r *Runner) ApplyRule(ctx context.Context, rule *storepb.LifecycleRule, creatorID *int32, senderID *int32) error {
+	if err := r.validateRule(ctx, rule); err != nil {
+		return err
+	}
+	now := time.Now()
+	updatedBefore := now.Add(-time.Duration(rule.AgeDays) * 24 * time.Hour).Unix()
+	normalStatus := store.Normal
+	find := &store.FindMemo{
+		CreatorID:       creatorID,
+		RowStatus:       &normalStatus,
+		UpdatedTsBefore: &updatedBefore,
+		ExcludeComments: true,
+	}
+	if rule.Filter != "" {
+		find.Filter = &rule.Filter
+	}
+	memos, err := r.Store.ListMemos(ctx, find)
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+
+	updatesByCreator := map[int32][]*store.UpdateMemo{}
+	creatorIDs := []int32{}
+	for _, memo := range memos {
+		update, err := r.buildMemoUpdate(rule, memo, now)
+		if err != nil {
+			// The memo failing to be changed doesn't hold back the others.
+			slog.Warn("Failed to apply lifecycle rule to memo", "error", err, "ruleID", rule.Id, "memoID", memo.ID)
+			continue
+		}
+		if update == nil {
+			continue
+		}
+		if _, ok := updatesByCreator[memo.CreatorID]; !ok {
+			creatorIDs = append(creatorIDs, memo.CreatorID)
+		}
+		updatesByCreator[memo.CreatorID] = append(updatesByCreator[memo.CreatorID], update)
+	}
+
+	for _, receiverID := range creatorIDs {
+		updates := updatesByCreator[receiverID]
+		if err := r.Store.UpdateMemos(ctx, updates); err != nil {
+			return errors.Wrap(err, "failed to update memos")
+		}
+		memoIDs := []int32{}
+		for _, update := range updates {
+			memoIDs = append(memoIDs, update.ID)
+		}
+		if r.OnApplied != nil {
+			r.OnApplied(ctx, rule, memoIDs)
+		}
+		activityCreatorID := receiverID
+		if senderID != nil {
+			activityCreatorID = *senderID
+		}
+		if err := r.notify(ctx, rule, memoIDs, activityCreatorID, receiverID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRule makes sure the rule can be applied, before any memo is changed.
+func (r *Runner) validateRule(ctx context.Context, rule *storepb.LifecycleRule) error {
+	if rule.AgeDays <= 0 {
+		return errors.Errorf("invalid age days: %d", rule.AgeDays)
+	}
+	if rule.Filter != "" {
+		if err := r.validateRuleFilter(rule.Filter); err != nil {
+			return err
+		}
+	}
+	switch rule.Action {
+	case storepb.LifecycleRule_ARCHIVE, storepb.LifecycleRule_DELETE:
+	case storepb.LifecycleRule_CHANGE_VISIBILITY:
+		if store.Visibility(rule.Visibility) == store.Public {
+			workspaceMemoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to get workspace memo related setting")
+			}
+			if workspaceMemoRelatedSetting.DisallowPublicVisibility {
+				return errors.New("public visibility is disallowed")
+			}
+		}
+	case storepb.LifecycleRule_ADD_TAG:
+		if rule.Tag == "" {
+			return errors.New("tag is empty")
+		}
+	default:
+		return errors.Errorf("unsupported action: %v", rule.Action)
+	}
+	return nil
+}
+
+// validateRuleFilter makes sure the filter selects the memos. A filter converting to no condition,
+// e.g. a bare `true`, would apply the rule to all the stale memos.
+func (r *Runner) validateRuleFilter(filterStr string) error {
+	parsedExpr, err := filter.Parse(filterStr, filter.MemoFilterCELAttributes...)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse filter")
+	}
+	convertCtx := filter.NewConvertContext()
+	if err := r.Store.GetDriver().ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+		return errors.Wrap(err, "failed to convert filter to SQL")
+	}
+	if convertCtx.Buffer.Len() == 0 {
+		return errors.New("filter matches all memos")
+	}
+	return nil
+}
+
+// buildMemoUpdate returns the update of the rule action on the memo, or nil if the memo is unchanged.
+func (r *Runner) buildMemoUpdate(rule *storepb.LifecycleRule, memo *store.Memo, now time.Time) (*store.UpdateMemo, error) {
+	update := &store.UpdateMemo{
+		ID: memo.ID,
+	}
+	switch rule.Action {
+	case storepb.LifecycleRule_ARCHIVE:
+		archived := store.Archived
+		update.RowStatus = &archived
+	case storepb.LifecycleRule_CHANGE_VISIBILITY:
+		visibility := store.Visibility(rule.Visibility)
+		if memo.Visibility == visibility {
+			return nil, nil
+		}
+		update.Visibility = &visibility
+	case storepb.LifecycleRule_ADD_TAG:
+		if slices.Contains(memo.Payload.GetTags(), rule.Tag) {
+			return nil, nil
+		}
+		content := strings.TrimRight(memo.Content, "\n")
+		if content != "" {
+			content += "\n\n"
+		}
+		memo.Content = content + "#" + rule.Tag
+		if err := memopayload.RebuildMemoPayload(memo); err != nil {
+			return nil, errors.Wrap(err, "failed to rebuild memo payload")
+		}
+		update.Content = &memo.Content
+		update.Payload = memo.Payload
+	case storepb.LifecycleRule_DELETE:
+		// The updated_ts of a memo in the trash is the time it was moved to the trash.
+		deleted := store.Deleted
+		deletedTs := now.Unix()
+		update.RowStatus = &deleted
+		update.UpdatedTs = &deletedTs
+	default:
+		return nil, errors.Errorf("unsupported action: %v", rule.Action)
+	}
+	return update, nil
+}
+
+// notify records the applied rule as an activity and sends it to the inbox of the receiver.
+func (r *Runner) notify(ctx context.Context, rule *storepb.LifecycleRule, memoIDs []int32, senderID, receiverID int32) error {
+	activity, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: senderID,
+		Type:      store.ActivityTypeMemoLifecycle,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoLifecycle: &storepb.ActivityMemoLifecyclePayload{
+				RuleId:    rule.Id,
+				RuleTitle: rule.Title,
+				Action:    rule.Action,
+				MemoIds:   memoIDs,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := r.Store.SendInboxMessage(ctx, &store.Inbox{
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_LIFECYCLE,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestApplyRule(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	appliedMemoIDs := []int32{}
+	r.OnApplied = func(_ context.Context, _ *storepb.LifecycleRule, memoIDs []int32) {
+		appliedMemoIDs = append(appliedMemoIDs, memoIDs...)
+	}
+	user := createTestingUser(ctx, t, ts, "owner")
+	other := createTestingUser(ctx, t, ts, "other")
+
+	oldTs := time.Now().AddDate(0, 0, -40).Unix()
+	staleMemo := createTestingMemo(ctx, t, ts, user, "stale", "#stale note", store.Public, oldTs)
+	untaggedMemo := createTestingMemo(ctx, t, ts, user, "untagged", "old note", store.Public, oldTs)
+	recentMemo := createTestingMemo(ctx, t, ts, user, "recent", "#stale but recent", store.Public, time.Now().Unix())
+	otherMemo := createTestingMemo(ctx, t, ts, other, "other", "#stale of another user", store.Public, oldTs)
+
+	// The rule of a user changes the visibility of the old memos of the user matching the filter.
+	rule := &storepb.LifecycleRule{
+		Id:         "private",
+		Filter:     `tag in ["stale"]`,
+		AgeDays:    30,
+		Action:     storepb.LifecycleRule_CHANGE_VISIBILITY,
+		Visibility: "PRIVATE",
+	}
+	require.NoError(t, r.ApplyRule(ctx, rule, &user.ID, &user.ID))
+	require.Equal(t, store.Private, getMemo(ctx, t, ts, staleMemo.ID).Visibility)
+	require.Equal(t, store.Public, getMemo(ctx, t, ts, untaggedMemo.ID).Visibility)
+	require.Equal(t, store.Public, getMemo(ctx, t, ts, recentMemo.ID).Visibility)
+	require.Equal(t, store.Public, getMemo(ctx, t, ts, otherMemo.ID).Visibility)
+	require.Equal(t, []int32{staleMemo.ID}, appliedMemoIDs)
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{ReceiverID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+	require.Equal(t, storepb.InboxMessage_MEMO_LIFECYCLE, inboxes[0].Message.Type)
+
+	// The memos already changed are skipped.
+	require.NoError(t, r.ApplyRule(ctx, rule, &user.ID, &user.ID))
+	require.Equal(t, []int32{staleMemo.ID}, appliedMemoIDs)
+
+	// The workspace rules archive the matching memos of all users.
+	rule = &storepb.LifecycleRule{
+		Id:      "archive",
+		Filter:  `tag in ["stale"]`,
+		AgeDays: 30,
+		Action:  storepb.LifecycleRule_ARCHIVE,
+	}
+	require.NoError(t, r.ApplyRule(ctx, rule, nil, nil))
+	require.Equal(t, store.Archived, getMemo(ctx, t, ts, staleMemo.ID).RowStatus)
+	require.Equal(t, store.Archived, getMemo(ctx, t, ts, otherMemo.ID).RowStatus)
+	require.Equal(t, store.Normal, getMemo(ctx, t, ts, untaggedMemo.ID).RowStatus)
+	require.Equal(t, store.Normal, getMemo(ctx, t, ts, recentMemo.ID).RowStatus)
+	require.ElementsMatch(t, []int32{staleMemo.ID, staleMemo.ID, otherMemo.ID}, appliedMemoIDs)
+	inboxes, err = ts.ListInboxes(ctx, &store.FindInbox{ReceiverID: &other.ID})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+
+	require.Error(t, r.ApplyRule(ctx, &storepb.LifecycleRule{Action: storepb.LifecycleRule_ARCHIVE}, nil, nil))
+
+	// The filters selecting all the memos, or failing to convert, change nothing.
+	for _, filter := range []string{`true`, `content.endsWith("note")`} {
+		rule := &storepb.LifecycleRule{Id: "delete", Filter: filter, AgeDays: 30, Action: storepb.LifecycleRule_DELETE}
+		require.Error(t, r.ApplyRule(ctx, rule, nil, nil), filter)
+	}
+	require.Equal(t, store.Normal, getMemo(ctx, t, ts, untaggedMemo.ID).RowStatus)
+}
+
+func TestBuildMemoUpdate(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	now := time.Now()
+	memo := &store.Memo{ID: 1, Content: "note\n", Visibility: store.Private}
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+
+	update, err := r.buildMemoUpdate(&storepb.LifecycleRule{Action: storepb.LifecycleRule_ARCHIVE}, memo, now)
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, *update.RowStatus)
+	require.Nil(t, update.Visibility)
+
+	// The memos with the visibility already are unchanged.
+	update, err = r.buildMemoUpdate(&storepb.LifecycleRule{Action: storepb.LifecycleRule_CHANGE_VISIBILITY, Visibility: "PRIVATE"}, memo, now)
+	require.NoError(t, err)
+	require.Nil(t, update)
+	update, err = r.buildMemoUpdate(&storepb.LifecycleRule{Action: storepb.LifecycleRule_CHANGE_VISIBILITY, Visibility: "PROTECTED"}, memo, now)
+	require.NoError(t, err)
+	require.Equal(t, store.Protected, *update.Visibility)
+	require.Nil(t, update.RowStatus)
+
+	update, err = r.buildMemoUpdate(&storepb.LifecycleRule{Action: storepb.LifecycleRule_ADD_TAG, Tag: "later"}, memo, now)
+	require.NoError(t, err)
+	require.Equal(t, "note\n\n#later", *update.Content)
+	require.Equal(t, []string{"later"}, update.Payload.Tags)
+	update, err = r.buildMemoUpdate(&storepb.LifecycleRule{Action: storepb.LifecycleRule_ADD_TAG, Tag: "later"}, memo, now)
+	require.NoError(t, err)
+	require.Nil(t, update)
+
+	update, err = r.buildMemoUpdate(&storepb.LifecycleRule{Action: storepb.LifecycleRule_DELETE}, memo, now)
+	require.NoError(t, err)
+	require.Equal(t, store.Deleted, *update.RowStatus)
+	require.Equal(t, now.Unix(), *update.UpdatedTs)
+}
+
+func createTestingUser(ctx context.Context, t *testing.T, ts *store.Store, username string) *store.User {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     store.RoleUser,
+		Email:    username + "@test.com",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// createTestingMemo creates the memo last updated at the time.
+func createTestingMemo(ctx context.Context, t *testing.T, ts *store.Store, user *store.User, uid, content string, visibility store.Visibility, updatedTs int64) *store.Memo {
+	memo := &store.Memo{
+		UID:        fmt.Sprintf("%s-%d", uid, user.ID),
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: visibility,
+	}
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+	memo, err := ts.CreateMemo(ctx, memo)
+	require.NoError(t, err)
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, UpdatedTs: &updatedTs}))
+	return memo
+}
+
+func getMemo(ctx context.Context, t *testing.T, ts *store.Store, id int32) *store.Memo {
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &id})
+	require.NoError(t, err)
+	return memo
+}
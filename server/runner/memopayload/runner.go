@@ -3,17 +3,31 @@ package memopayload
 import (
 	"context"
 	"log/slog"
+	"regexp"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/usememos/gomark/ast"
 	"github.com/usememos/gomark/parser"
 	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
 
+	"github.com/usememos/memos/internal/base"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
+// TaskDueDateLayout is the layout of the task due dates.
+const TaskDueDateLayout = "2006-01-02"
+
+// taskDueDateRegexp matches the inline due date of a task, e.g. `@due(2026-11-01)`.
+var taskDueDateRegexp = regexp.MustCompile(`@due\((\d{4}-\d{2}-\d{2})\)`)
+
+// mentionRegexp matches the `@username` mentions, but not the email addresses.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^a-zA-Z0-9_@.])@([a-zA-Z0-9-]+)`)
+
 type Runner struct {
 	Store *store.Store
 }
@@ -82,6 +96,7 @@ func RebuildMemoPayload(memo *store.Memo) error {
 		memo.Payload = &storepb.MemoPayload{}
 	}
 	tags := []string{}
+	tasks := []*storepb.MemoPayload_Task{}
 	property := &storepb.MemoPayload_Property{}
 	TraverseASTNodes(nodes, func(node ast.Node) {
 		switch n := node.(type) {
@@ -97,18 +112,77 @@ func RebuildMemoPayload(memo *store.Memo) error {
 			if !n.Complete {
 				property.HasIncompleteTasks = true
 			}
+			tasks = append(tasks, buildMemoTask(n))
 		case *ast.Code, *ast.CodeBlock:
 			property.HasCode = true
 		case *ast.EmbeddedContent:
-			// TODO: validate references.
-			property.References = append(property.References, n.ResourceName)
+			// References are validated when they are stored as memo relations.
+			if !slices.Contains(property.References, n.ResourceName) {
+				property.References = append(property.References, n.ResourceName)
+			}
+		case *ast.ReferencedContent:
+			if !slices.Contains(property.References, n.ResourceName) {
+				property.References = append(property.References, n.ResourceName)
+			}
+		case *ast.Text:
+			for _, mention := range GetMentions(n.Content) {
+				if !slices.Contains(property.Mentions, mention) {
+					property.Mentions = append(property.Mentions, mention)
+				}
+			}
 		}
 	})
 	memo.Payload.Tags = tags
+	memo.Payload.Tasks = tasks
 	memo.Payload.Property = property
 	return nil
 }
 
+// buildMemoTask returns the task of the task list item, with its due date and tags.
+func buildMemoTask(node *ast.TaskListItem) *storepb.MemoPayload_Task {
+	content := strings.TrimSpace(restore.Restore(node.Children))
+	task := &storepb.MemoPayload_Task{
+		Content:  content,
+		Complete: node.Complete,
+		DueDate:  GetTaskDueDate(content),
+	}
+	TraverseASTNodes(node.Children, func(node ast.Node) {
+		if tag, ok := node.(*ast.Tag); ok && !slices.Contains(task.Tags, tag.Content) {
+			task.Tags = append(task.Tags, tag.Content)
+		}
+	})
+	return task
+}
+
+// GetTaskDueDate returns the due date of the task content, or an empty string if it has no valid due date.
+func GetTaskDueDate(content string) string {
+	matches := taskDueDateRegexp.FindStringSubmatch(content)
+	if len(matches) != 2 {
+		return ""
+	}
+	if _, err := time.Parse(TaskDueDateLayout, matches[1]); err != nil {
+		return ""
+	}
+	return matches[1]
+}
+
+// GetMentions returns the usernames mentioned in the text with `@username`.
+func GetMentions(text string) []string {
+	mentions := []string{}
+	for _, match := range mentionRegexp.FindAllStringSubmatchIndex(text, -1) {
+		// The username is cut short by the characters invalid in it, e.g. "@bob@example.com" or "@bob_smith".
+		// The inline functions are not mentions either, e.g. the due date of a task "@due(2026-11-01)".
+		if end := match[1]; end < len(text) && (text[end] == '@' || text[end] == '_' || text[end] == '(') {
+			continue
+		}
+		mention := strings.TrimRight(text[match[2]:match[3]], "-")
+		if base.UIDMatcher.MatchString(mention) {
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions
+}
+
 func TraverseASTNodes(nodes []ast.Node, fn func(ast.Node)) {
 	for _, node := range nodes {
 		fn(node)
@@ -0,0 +1,95 @@
+package memopayload
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestRebuildMemoPayloadTasks(t *testing.T) {
+	memo := &store.Memo{
+		Content: "# Plan\n" +
+			"- [ ] Write the draft @due(2026-11-01) #work\n" +
+			"- [x] Book the flight #travel #work/trip\n" +
+			"- Not a task\n" +
+			"- [ ] Call back @due(2026-13-01)",
+	}
+	require.NoError(t, RebuildMemoPayload(memo))
+
+	tasks := memo.Payload.Tasks
+	require.Len(t, tasks, 3)
+	require.Equal(t, "Write the draft @due(2026-11-01) #work", tasks[0].Content)
+	require.False(t, tasks[0].Complete)
+	require.Equal(t, "2026-11-01", tasks[0].DueDate)
+	require.Equal(t, []string{"work"}, tasks[0].Tags)
+	require.True(t, tasks[1].Complete)
+	require.Empty(t, tasks[1].DueDate)
+	require.Equal(t, []string{"travel", "work/trip"}, tasks[1].Tags)
+	// The invalid dates are ignored.
+	require.Empty(t, tasks[2].DueDate)
+	require.True(t, memo.Payload.Property.HasTaskList)
+	require.True(t, memo.Payload.Property.HasIncompleteTasks)
+	// The due dates are not mentions of a user named "due".
+	require.Empty(t, memo.Payload.Property.Mentions)
+
+	// The tasks are rebuilt with the content.
+	memo.Content = "No tasks anymore"
+	require.NoError(t, RebuildMemoPayload(memo))
+	require.Empty(t, memo.Payload.Tasks)
+}
+
+func TestGetTaskDueDate(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{content: "Pay the rent @due(2026-02-01)", want: "2026-02-01"},
+		{content: "@due(2026-02-01) comes first", want: "2026-02-01"},
+		{content: "Two dates @due(2026-02-01) @due(2026-03-01)", want: "2026-02-01"},
+		{content: "Not a date @due(2026-02-30)", want: ""},
+		{content: "Wrong format @due(2026/02/01)", want: ""},
+		{content: "Missing parenthesis @due 2026-02-01", want: ""},
+		{content: "No due date", want: ""},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, GetTaskDueDate(tt.content), tt.content)
+	}
+}
+
+func TestGetMentions(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{text: "@alice", want: []string{"alice"}},
+		{text: "cc @alice and @bob-smith, thanks @carol.", want: []string{"alice", "bob-smith", "carol"}},
+		{text: "(@alice) @bob!", want: []string{"alice", "bob"}},
+		// The trailing hyphens are not part of the username.
+		{text: "@alice-- done", want: []string{"alice"}},
+		// The email addresses are not mentions.
+		{text: "mail alice@example.com or @bob@example.com", want: []string{}},
+		{text: "@@alice a.@bob", want: []string{}},
+		// The due dates of the tasks are not mentions.
+		{text: "- [ ] draft @due(2026-11-01) for @alice", want: []string{"alice"}},
+		// The usernames must be valid.
+		{text: "@under_score @" + strings.Repeat("a", 33), want: []string{}},
+		{text: "no mentions here", want: []string{}},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, GetMentions(test.text), test.text)
+	}
+}
+
+func TestRebuildMemoPayloadMentions(t *testing.T) {
+	memo := &store.Memo{
+		Content: "Hi @alice, see `@bob` and alice@example.com\n" +
+			"```\n@carol\n```\n" +
+			"@alice again and @dave",
+	}
+	require.NoError(t, RebuildMemoPayload(memo))
+	// The mentions in the code are ignored, and each user is mentioned once.
+	require.Equal(t, []string{"alice", "dave"}, memo.Payload.Property.Mentions)
+}
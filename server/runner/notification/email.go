@@ -0,0 +1,279 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// emailRetryPeriod is how long the inbox messages failed to be sent by email are retried.
+	emailRetryPeriod = time.Hour
+	// emailConcurrency is the number of the emails sent at the same time.
+	emailConcurrency = 4
+)
+
+// inboxEmail is the email of an inbox message.
+type inboxEmail struct {
+	inbox   *store.Inbox
+	message *email.Message
+}
+
+// SendInboxEmails sends the unread inbox messages not sent yet to the email of the receivers who opted in.
+// The receivers with the daily digest only get the digests by email. Each message is marked once it's sent,
+// the failed ones are retried by the next checks until they are older than the retry period.
+func (r *Runner) SendInboxEmails(ctx context.Context) {
+	config, err := r.Store.GetEmailConfig(ctx)
+	if err != nil {
+		slog.Error("Failed to get email config", "error", err)
+		return
+	}
+	if config == nil {
+		return
+	}
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_NOTIFICATIONS,
+	})
+	if err != nil {
+		slog.Error("Failed to list user notifications settings", "error", err)
+		return
+	}
+	createdTsAfter := time.Now().Add(-emailRetryPeriod).Unix()
+	inboxEmails := []*inboxEmail{}
+	for _, userSetting := range userSettings {
+		notificationsSetting := userSetting.GetNotifications()
+		if !notificationsSetting.GetEmail() {
+			continue
+		}
+		userInboxEmails, err := r.listUserInboxEmails(ctx, userSetting.UserId, notificationsSetting.GetDailyDigest(), createdTsAfter)
+		if err != nil {
+			slog.Error("Failed to list inbox emails", "error", err, "userID", userSetting.UserId)
+			continue
+		}
+		inboxEmails = append(inboxEmails, userInboxEmails...)
+	}
+	r.sendInboxEmails(ctx, config, inboxEmails)
+}
+
+// listUserInboxEmails returns the emails of the unread inbox messages of the user created since the time and not sent yet.
+func (r *Runner) listUserInboxEmails(ctx context.Context, userID int32, digestOnly bool, createdTsAfter int64) ([]*inboxEmail, error) {
+	user, err := r.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.Email == "" || user.RowStatus == store.Archived {
+		return nil, nil
+	}
+	unreadStatus := store.UNREAD
+	find := &store.FindInbox{
+		ReceiverID:     &userID,
+		Status:         &unreadStatus,
+		CreatedTsAfter: &createdTsAfter,
+	}
+	if digestOnly {
+		find.MessageTypes = []storepb.InboxMessage_Type{storepb.InboxMessage_DIGEST}
+	}
+	inboxes, err := r.Store.ListInboxes(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list inboxes")
+	}
+	inboxEmails := []*inboxEmail{}
+	for _, inbox := range inboxes {
+		if inbox.Message.EmailedTs != 0 || inbox.Message.ActivityId == nil {
+			continue
+		}
+		// A message failed to be rendered doesn't stop the others of the user.
+		data, err := r.getNotificationData(ctx, inbox.Message.GetActivityId())
+		if err != nil {
+			slog.Warn("Failed to get notification data", "error", err, "inboxID", inbox.ID)
+			continue
+		}
+		if data == nil {
+			continue
+		}
+		message, err := email.NotificationTemplate.Render([]string{user.Email}, data)
+		if err != nil {
+			slog.Warn("Failed to render email", "error", err, "inboxID", inbox.ID)
+			continue
+		}
+		inboxEmails = append(inboxEmails, &inboxEmail{inbox: inbox, message: message})
+	}
+	return inboxEmails, nil
+}
+
+// sendInboxEmails sends the emails concurrently, so a slow SMTP session doesn't hold up the others.
+func (r *Runner) sendInboxEmails(ctx context.Context, config *email.Config, inboxEmails []*inboxEmail) {
+	semaphore := make(chan struct{}, emailConcurrency)
+	var wg sync.WaitGroup
+	for _, inboxEmail := range inboxEmails {
+		if ctx.Err() != nil {
+			break
+		}
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := r.sendInboxEmail(ctx, config, inboxEmail); err != nil {
+				slog.Warn("Failed to send inbox email", "error", err, "inboxID", inboxEmail.inbox.ID)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// sendInboxEmail sends the email and marks its inbox message as sent.
+func (r *Runner) sendInboxEmail(ctx context.Context, config *email.Config, inboxEmail *inboxEmail) error {
+	if err := email.Send(config, inboxEmail.message); err != nil {
+		return errors.Wrap(err, "failed to send email")
+	}
+	return r.updateInboxMessage(ctx, inboxEmail.inbox.ID, func(message *storepb.InboxMessage) {
+		message.EmailedTs = time.Now().Unix()
+	})
+}
+
+// getNotificationData returns the email content of the activity, or nil if there is nothing to notify about anymore.
+func (r *Runner) getNotificationData(ctx context.Context, activityID int32) (*email.NotificationData, error) {
+	activity, err := r.Store.GetActivity(ctx, &store.FindActivity{ID: &activityID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil {
+		return nil, nil
+	}
+
+	if digest := activity.Payload.GetDigest(); digest != nil {
+		data := &email.NotificationData{
+			Title: "Your daily digest",
+			URL:   r.getURL("/inbox"),
+		}
+		for _, activityID := range digest.ActivityIds {
+			activity, err := r.Store.GetActivity(ctx, &store.FindActivity{ID: &activityID})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get activity")
+			}
+			if activity == nil {
+				continue
+			}
+			title, _, err := r.describeActivity(ctx, activity)
+			if err != nil {
+				return nil, err
+			}
+			if title != "" {
+				data.Lines = append(data.Lines, title)
+			}
+		}
+		if len(data.Lines) == 0 {
+			return nil, nil
+		}
+		return data, nil
+	}
+
+	title, memo, err := r.describeActivity(ctx, activity)
+	if err != nil {
+		return nil, err
+	}
+	if title == "" {
+		return nil, nil
+	}
+	data := &email.NotificationData{
+		Title: title,
+		URL:   r.getURL("/inbox"),
+	}
+	if memo != nil {
+		snippet, err := getMemoContentSnippet(memo.Content)
+		if err != nil {
+			return nil, err
+		}
+		if snippet != "" {
+			data.Lines = append(data.Lines, snippet)
+		}
+		data.URL = r.getURL("/memos/" + memo.UID)
+	}
+	return data, nil
+}
+
+// describeActivity returns the title of the activity and the memo it's about, if any.
+// The title is empty if the activity is unknown or its memo is gone.
+func (r *Runner) describeActivity(ctx context.Context, activity *store.Activity) (string, *store.Memo, error) {
+	sender, err := r.Store.GetUser(ctx, &store.FindUser{ID: &activity.CreatorID})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get user")
+	}
+	senderName := "Someone"
+	if sender != nil {
+		senderName = sender.Nickname
+		if senderName == "" {
+			senderName = sender.Username
+		}
+	}
+
+	var title string
+	var memoID int32
+	payload := activity.Payload
+	switch {
+	case payload.GetMemoComment() != nil:
+		title, memoID = fmt.Sprintf("%s commented on your memo", senderName), payload.MemoComment.MemoId
+	case payload.GetMemoMention() != nil:
+		title, memoID = fmt.Sprintf("%s mentioned you in a memo", senderName), payload.MemoMention.MemoId
+	case payload.GetMemoReaction() != nil:
+		title, memoID = fmt.Sprintf("%s reacted %s to your memo", senderName, payload.MemoReaction.ReactionType), payload.MemoReaction.MemoId
+	case payload.GetMemoReference() != nil:
+		title, memoID = fmt.Sprintf("%s referenced your memo", senderName), payload.MemoReference.MemoId
+	case payload.GetShortcutMatch() != nil:
+		title, memoID = fmt.Sprintf("A new memo of %s matches your shortcut %s", senderName, payload.ShortcutMatch.ShortcutTitle), payload.ShortcutMatch.MemoId
+	case payload.GetMemoReminder() != nil:
+		title, memoID = "Memo reminder", payload.MemoReminder.MemoId
+	case payload.GetMemoLifecycle() != nil:
+		ruleTitle := payload.MemoLifecycle.RuleTitle
+		if ruleTitle == "" {
+			ruleTitle = payload.MemoLifecycle.RuleId
+		}
+		return fmt.Sprintf("Lifecycle rule %s applied to %d memos", ruleTitle, len(payload.MemoLifecycle.MemoIds)), nil, nil
+	default:
+		return "", nil, nil
+	}
+
+	memo, err := r.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return "", nil, nil
+	}
+	return title, memo, nil
+}
+
+// getURL returns the URL of the path in the instance, or an empty string if the instance URL is unknown.
+func (r *Runner) getURL(path string) string {
+	if r.InstanceURL == "" {
+		return ""
+	}
+	return strings.TrimRight(r.InstanceURL, "/") + path
+}
+
+func getMemoContentSnippet(content string) (string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse content")
+	}
+	plainText := []rune(strings.Join(strings.Fields(renderer.NewStringRenderer().Render(nodes)), " "))
+	if len(plainText) > 140 {
+		return string(plainText[:140]) + "...", nil
+	}
+	return string(plainText), nil
+}
@@ -0,0 +1,168 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+// smtpSink is a local SMTP server counting the messages delivered to the recipients.
+// The recipients starting with "rejected" are refused.
+type smtpSink struct {
+	mu        sync.Mutex
+	delivered map[string]int
+}
+
+func startSMTPSink(t *testing.T) (*smtpSink, int) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	sink := &smtpSink{delivered: map[string]int{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go sink.serve(conn)
+		}
+	}()
+	return sink, listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := textproto.NewReader(bufio.NewReader(conn))
+	reply := func(lines ...string) {
+		io.WriteString(conn, strings.Join(lines, "\r\n")+"\r\n")
+	}
+
+	recipients := []string{}
+	reply("220 localhost ESMTP sink")
+	for {
+		line, err := reader.ReadLine()
+		if err != nil {
+			return
+		}
+		switch strings.ToUpper(strings.SplitN(line, " ", 2)[0]) {
+		case "EHLO":
+			reply("250 localhost")
+		case "MAIL":
+			reply("250 OK")
+		case "RCPT":
+			recipient := strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">")
+			if strings.HasPrefix(recipient, "rejected") {
+				reply("550 No such user")
+				continue
+			}
+			recipients = append(recipients, recipient)
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			if _, err := reader.ReadDotBytes(); err != nil {
+				return
+			}
+			s.mu.Lock()
+			for _, recipient := range recipients {
+				s.delivered[recipient]++
+			}
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func (s *smtpSink) getDelivered() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delivered := map[string]int{}
+	for recipient, count := range s.delivered {
+		delivered[recipient] = count
+	}
+	return delivered
+}
+
+func TestSendInboxEmails(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	sink, port := startSMTPSink(t)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_NOTIFICATION,
+		Value: &storepb.WorkspaceSetting_NotificationSetting{
+			NotificationSetting: &storepb.WorkspaceNotificationSetting{
+				Email: &storepb.WorkspaceNotificationSetting_EmailSetting{
+					Enabled:   true,
+					SmtpHost:  "127.0.0.1",
+					SmtpPort:  int32(port),
+					Security:  storepb.WorkspaceNotificationSetting_EmailSetting_NONE,
+					FromEmail: "memos@test.com",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	r := NewRunner(ts)
+	user := createTestingUser(ctx, t, ts, "user")
+	rejected := createTestingUser(ctx, t, ts, "rejected")
+	other := createTestingUser(ctx, t, ts, "other")
+	for _, u := range []*store.User{user, rejected} {
+		_, err := ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: u.ID,
+			Key:    storepb.UserSettingKey_NOTIFICATIONS,
+			Value: &storepb.UserSetting_Notifications{
+				Notifications: &storepb.NotificationsUserSetting{
+					Email: true,
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+	memo := createTestingMemo(ctx, t, ts, other, "memo", "memo", store.Public, time.Now().Unix())
+	rejectedInbox, _ := createTestingCommentInbox(ctx, t, ts, memo, other, rejected)
+	userInbox, _ := createTestingCommentInbox(ctx, t, ts, memo, other, user)
+	createTestingCommentInbox(ctx, t, ts, memo, user, other)
+
+	// The failed message doesn't stop the others, and only the sent ones are marked.
+	r.SendInboxEmails(ctx)
+	require.Equal(t, map[string]int{"user@test.com": 1}, sink.getDelivered())
+	require.NotZero(t, getInbox(ctx, t, ts, userInbox.ID).Message.EmailedTs)
+	require.Zero(t, getInbox(ctx, t, ts, rejectedInbox.ID).Message.EmailedTs)
+
+	// The sent messages are not sent again, the failed ones are retried.
+	retriedEmail := "retried@test.com"
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:    rejected.ID,
+		Email: &retriedEmail,
+	})
+	require.NoError(t, err)
+	r.SendInboxEmails(ctx)
+	require.Equal(t, map[string]int{"user@test.com": 1, "retried@test.com": 1}, sink.getDelivered())
+	require.NotZero(t, getInbox(ctx, t, ts, rejectedInbox.ID).Message.EmailedTs)
+	require.Equal(t, store.UNREAD, getInbox(ctx, t, ts, userInbox.ID).Status)
+}
+
+func getInbox(ctx context.Context, t *testing.T, ts *store.Store, id int32) *store.Inbox {
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{ID: &id})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+	return inboxes[0]
+}
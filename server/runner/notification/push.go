@@ -0,0 +1,203 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webpush"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// pushTTL is how long the push services keep the messages for the offline browsers.
+	pushTTL = 24 * time.Hour
+	// pushRetryPeriod is how long the inbox messages failed to be pushed are retried.
+	pushRetryPeriod = 10 * time.Minute
+	// defaultPushSubscriber is the VAPID contact if neither the instance URL nor the sender email is configured.
+	defaultPushSubscriber = "https://www.usememos.com"
+)
+
+// pushMessageTypes are the types of the inbox messages pushed to the browsers.
+var pushMessageTypes = []storepb.InboxMessage_Type{
+	storepb.InboxMessage_MEMO_COMMENT,
+	storepb.InboxMessage_MEMO_MENTION,
+	storepb.InboxMessage_REMINDER,
+}
+
+// pushPayload is the JSON payload of the push messages, handled by the service worker of the frontend.
+type pushPayload struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// URL is the link to open, it's relative to the instance if the instance URL is unknown.
+	URL string `json:"url"`
+	// Inbox is the name of the inbox message, e.g. "inboxes/1".
+	Inbox string `json:"inbox"`
+}
+
+// SendInboxPushes pushes the unread inbox messages of comments, mentions and reminders not pushed yet
+// to the browsers subscribed by the receivers. Each message is marked once it's pushed, the failed ones are
+// retried by the next checks until they are older than the retry period. The subscriptions gone from their
+// push services are removed.
+func (r *Runner) SendInboxPushes(ctx context.Context) {
+	createdTsAfter := time.Now().Add(-pushRetryPeriod).Unix()
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+	})
+	if err != nil {
+		slog.Error("Failed to list user push subscriptions", "error", err)
+		return
+	}
+	var options *webpush.Options
+	for _, userSetting := range userSettings {
+		subscriptions := userSetting.GetPushSubscriptions().GetSubscriptions()
+		if len(subscriptions) == 0 {
+			continue
+		}
+		if options == nil {
+			if options, err = r.getPushOptions(ctx); err != nil {
+				slog.Error("Failed to get push options", "error", err)
+				return
+			}
+		}
+		if err := r.sendUserInboxPushes(ctx, options, userSetting.UserId, subscriptions, createdTsAfter); err != nil {
+			slog.Error("Failed to send inbox pushes", "error", err, "userID", userSetting.UserId)
+		}
+	}
+}
+
+func (r *Runner) sendUserInboxPushes(ctx context.Context, options *webpush.Options, userID int32, subscriptions []*storepb.PushSubscriptionsUserSetting_PushSubscription, createdTsAfter int64) error {
+	unreadStatus := store.UNREAD
+	inboxes, err := r.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:     &userID,
+		Status:         &unreadStatus,
+		CreatedTsAfter: &createdTsAfter,
+		MessageTypes:   pushMessageTypes,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+	for _, inbox := range inboxes {
+		if inbox.Message.PushedTs != 0 || inbox.Message.ActivityId == nil {
+			continue
+		}
+		payload, err := r.getPushPayload(ctx, inbox)
+		if err != nil {
+			return err
+		}
+		if payload == nil {
+			continue
+		}
+		// A newer message of the same inbox replaces the pending one.
+		inboxOptions := *options
+		inboxOptions.Topic = fmt.Sprintf("inbox-%d", inbox.ID)
+		// The message is retried if it fails to reach any of the subscriptions still active.
+		delivered, failed := false, false
+		for _, subscription := range subscriptions {
+			err := webpush.Send(&webpush.Subscription{
+				Endpoint: subscription.Endpoint,
+				P256dh:   subscription.P256Dh,
+				Auth:     subscription.Auth,
+			}, payload, &inboxOptions)
+			if errors.Is(err, webpush.ErrSubscriptionGone) {
+				if err := r.Store.RemoveUserPushSubscription(ctx, userID, subscription.Id); err != nil {
+					return errors.Wrap(err, "failed to remove push subscription")
+				}
+				continue
+			}
+			if err != nil {
+				slog.Warn("Failed to send push message", "error", err, "userID", userID, "subscription", subscription.Id)
+				failed = true
+				continue
+			}
+			delivered = true
+		}
+		if failed && !delivered {
+			continue
+		}
+		if err := r.updateInboxMessage(ctx, inbox.ID, func(message *storepb.InboxMessage) {
+			message.PushedTs = time.Now().Unix()
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPushPayload returns the push payload of the inbox message, or nil if there is nothing to notify about anymore.
+func (r *Runner) getPushPayload(ctx context.Context, inbox *store.Inbox) ([]byte, error) {
+	activityID := inbox.Message.GetActivityId()
+	activity, err := r.Store.GetActivity(ctx, &store.FindActivity{ID: &activityID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil {
+		return nil, nil
+	}
+	title, memo, err := r.describeActivity(ctx, activity)
+	if err != nil {
+		return nil, err
+	}
+	if title == "" {
+		return nil, nil
+	}
+	payload := &pushPayload{
+		Title: title,
+		URL:   r.getURLOrPath("/inbox"),
+		Inbox: fmt.Sprintf("inboxes/%d", inbox.ID),
+	}
+	if memo != nil {
+		if payload.Body, err = getMemoContentSnippet(memo.Content); err != nil {
+			return nil, err
+		}
+		payload.URL = r.getURLOrPath("/memos/" + memo.UID)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal push payload")
+	}
+	return data, nil
+}
+
+// getPushOptions returns the VAPID keys of the workspace and the contact of the instance.
+func (r *Runner) getPushOptions(ctx context.Context) (*webpush.Options, error) {
+	workspaceBasicSetting, err := r.Store.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace basic setting")
+	}
+	if workspaceBasicSetting.VapidPublicKey == "" || workspaceBasicSetting.VapidPrivateKey == "" {
+		return nil, errors.New("VAPID keys are not generated")
+	}
+	subscriber := defaultPushSubscriber
+	if strings.HasPrefix(r.InstanceURL, "https://") {
+		subscriber = r.InstanceURL
+	} else {
+		config, err := r.Store.GetEmailConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if config != nil && config.FromEmail != "" {
+			subscriber = "mailto:" + config.FromEmail
+		}
+	}
+	return &webpush.Options{
+		VAPIDPublicKey:  workspaceBasicSetting.VapidPublicKey,
+		VAPIDPrivateKey: workspaceBasicSetting.VapidPrivateKey,
+		Subscriber:      subscriber,
+		TTL:             pushTTL,
+	}, nil
+}
+
+// getURLOrPath returns the URL of the path in the instance, or the path itself if the instance URL is unknown.
+func (r *Runner) getURLOrPath(path string) string {
+	if url := r.getURL(path); url != "" {
+		return url
+	}
+	return path
+}
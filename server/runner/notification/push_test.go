@@ -0,0 +1,143 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/webpush"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestSendInboxPushes(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	privateKey, publicKey, err := webpush.GenerateVAPIDKeys()
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_BASIC,
+		Value: &storepb.WorkspaceSetting_BasicSetting{
+			BasicSetting: &storepb.WorkspaceBasicSetting{
+				VapidPublicKey:  publicKey,
+				VapidPrivateKey: privateKey,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The push service records the topics of the messages, the gone and failing subscriptions are reported as such.
+	var mu sync.Mutex
+	topics := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		mu.Lock()
+		topics = append(topics, r.Header.Get("Topic"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	getTopics := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, topics...)
+	}
+	user := createTestingUser(ctx, t, ts, "user")
+	other := createTestingUser(ctx, t, ts, "other")
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_PushSubscriptions{
+			PushSubscriptions: &storepb.PushSubscriptionsUserSetting{
+				Subscriptions: []*storepb.PushSubscriptionsUserSetting_PushSubscription{
+					newTestingPushSubscription(t, "active", server.URL+"/active"),
+					newTestingPushSubscription(t, "gone", server.URL+"/gone"),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: other.ID,
+		Key:    storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_PushSubscriptions{
+			PushSubscriptions: &storepb.PushSubscriptionsUserSetting{
+				Subscriptions: []*storepb.PushSubscriptionsUserSetting_PushSubscription{
+					newTestingPushSubscription(t, "error", server.URL+"/error"),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	memo := createTestingMemo(ctx, t, ts, user, "memo", "memo", store.Public, time.Now().Unix())
+	r := NewRunner(ts)
+	commentInbox, _ := createTestingCommentInbox(ctx, t, ts, memo, other, user)
+	failedInbox, _ := createTestingCommentInbox(ctx, t, ts, memo, user, other)
+	reactionActivity, err := ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: other.ID,
+		Type:      store.ActivityTypeMemoReaction,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReaction: &storepb.ActivityMemoReactionPayload{MemoId: memo.ID, ReactionType: "👍"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateInbox(ctx, &store.Inbox{
+		SenderID:   other.ID,
+		ReceiverID: user.ID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_REACTION,
+			ActivityId: &reactionActivity.ID,
+		},
+	})
+	require.NoError(t, err)
+
+	// Only the messages of the pushed types are pushed and marked, and the gone subscription is removed.
+	r.SendInboxPushes(ctx)
+	require.Equal(t, []string{fmt.Sprintf("inbox-%d", commentInbox.ID)}, getTopics())
+	require.NotZero(t, getInbox(ctx, t, ts, commentInbox.ID).Message.PushedTs)
+	subscriptions, err := ts.GetUserPushSubscriptions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, "active", subscriptions[0].Id)
+
+	// The messages failed to be pushed are left to be retried.
+	require.Zero(t, getInbox(ctx, t, ts, failedInbox.ID).Message.PushedTs)
+
+	// The messages are pushed once, even by another runner, e.g. after a restart.
+	NewRunner(ts).SendInboxPushes(ctx)
+	require.Len(t, getTopics(), 1)
+}
+
+// newTestingPushSubscription returns the subscription of a browser with newly generated keys.
+func newTestingPushSubscription(t *testing.T, id, endpoint string) *storepb.PushSubscriptionsUserSetting_PushSubscription {
+	userAgentKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return &storepb.PushSubscriptionsUserSetting_PushSubscription{
+		Id:       id,
+		Endpoint: endpoint,
+		P256Dh:   base64.RawURLEncoding.EncodeToString(userAgentKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(bytes.Repeat([]byte{1}, 16)),
+	}
+}
@@ -0,0 +1,378 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// InstanceURL is used for the links in the emails, they are left out if it's empty.
+	InstanceURL string
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+const (
+	// Check the updated memos against the alerting shortcuts every 5 minutes.
+	alertRunnerSpec = "@every 5m"
+	// Roll the unread inbox messages into the digest every day.
+	digestRunnerSpec = "@daily"
+	// Send the inbox messages not sent yet by email every minute.
+	emailRunnerSpec = "@every 1m"
+	// Push the new inbox messages to the subscribed browsers every 10 seconds.
+	pushRunnerSpec = "@every 10s"
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	// A run taking longer than its interval, e.g. on a slow SMTP server, is not overlapped by the next one,
+	// which would send the same messages again.
+	c := cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger)))
+	if _, err := c.AddFunc(alertRunnerSpec, func() {
+		r.SendShortcutAlerts(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule shortcut alerts", "error", err)
+		return
+	}
+	if _, err := c.AddFunc(digestRunnerSpec, func() {
+		r.SendDailyDigests(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule daily digests", "error", err)
+		return
+	}
+	if _, err := c.AddFunc(emailRunnerSpec, func() {
+		r.SendInboxEmails(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule inbox emails", "error", err)
+		return
+	}
+	if _, err := c.AddFunc(pushRunnerSpec, func() {
+		r.SendInboxPushes(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule inbox pushes", "error", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.SendShortcutAlerts(ctx)
+	r.SendDailyDigests(ctx)
+	r.SendInboxEmails(ctx)
+	r.SendInboxPushes(ctx)
+}
+
+// SendShortcutAlerts sends the memos of others updated since the last check to the users
+// who are alerted about the shortcuts they match. The memos are matched once the users can read them,
+// e.g. after they are published on schedule, made visible or granted, and each memo is alerted once.
+func (r *Runner) SendShortcutAlerts(ctx context.Context) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_NOTIFICATIONS,
+	})
+	if err != nil {
+		slog.Error("Failed to list user notifications settings", "error", err)
+		return
+	}
+	for _, userSetting := range userSettings {
+		notificationsSetting := userSetting.GetNotifications()
+		if len(notificationsSetting.GetAlertShortcutIds()) == 0 || slices.Contains(notificationsSetting.GetMutedTypes(), storepb.InboxMessage_SHORTCUT_MATCH) {
+			continue
+		}
+		if err := r.sendUserShortcutAlerts(ctx, userSetting.UserId, notificationsSetting.GetAlertShortcutIds()); err != nil {
+			slog.Error("Failed to send shortcut alerts", "error", err, "userID", userSetting.UserId)
+		}
+	}
+}
+
+// sendUserShortcutAlerts alerts the user about the memos updated or granted since the last check of the user,
+// the check is saved only if all the alerts are sent, so the failed ones are retried.
+func (r *Runner) sendUserShortcutAlerts(ctx context.Context, userID int32, alertShortcutIDs []string) error {
+	notificationState, err := r.Store.GetUserNotificationState(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user notification state")
+	}
+	// The memos updated in the current second are left to the next check, so none is missed.
+	updatedTsAfter, updatedTsBefore := notificationState.AlertUpdatedTs, time.Now().Unix()
+	// The memos updated before the alerts are turned on are not alerted.
+	if updatedTsAfter != 0 {
+		if err := r.sendUserShortcutAlertsBetween(ctx, userID, alertShortcutIDs, updatedTsAfter, updatedTsBefore); err != nil {
+			return err
+		}
+	}
+	notificationState.AlertUpdatedTs = updatedTsBefore - 1
+	if _, err := r.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_NOTIFICATION_STATE,
+		Value: &storepb.UserSetting_NotificationState{
+			NotificationState: notificationState,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to upsert user notification state")
+	}
+	return nil
+}
+
+func (r *Runner) sendUserShortcutAlertsBetween(ctx context.Context, userID int32, alertShortcutIDs []string, updatedTsAfter, updatedTsBefore int64) error {
+	shortcutsSetting, err := r.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_SHORTCUTS,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user shortcuts")
+	}
+	// The memos granted to the user since the last check are matched even if they are not updated.
+	memoACLs, err := r.Store.ListMemoACLs(ctx, &store.FindMemoACL{
+		UserID: &userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo grants")
+	}
+	grantedMemoIDs := []int32{}
+	for _, memoACL := range memoACLs {
+		if memoACL.CreatedTs > updatedTsAfter && memoACL.CreatedTs < updatedTsBefore {
+			grantedMemoIDs = append(grantedMemoIDs, memoACL.MemoID)
+		}
+	}
+
+	type shortcutMatch struct {
+		memo     *store.Memo
+		shortcut *storepb.ShortcutsUserSetting_Shortcut
+	}
+	// A memo matching several shortcuts is alerted once.
+	matches := []*shortcutMatch{}
+	matchedMemoIDs := map[int32]bool{}
+	for _, shortcut := range shortcutsSetting.GetShortcuts().GetShortcuts() {
+		if !slices.Contains(alertShortcutIDs, shortcut.Id) || shortcut.Filter == "" {
+			continue
+		}
+		// The memos readable by the user, the same as canReadMemo of the API, as the own memos are skipped.
+		filter := fmt.Sprintf(`(%s) && (visibility in ["PUBLIC", "PROTECTED"] || granted_to(%d))`, shortcut.Filter, userID)
+		normalStatus := store.Normal
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			RowStatus:       &normalStatus,
+			UpdatedTsAfter:  &updatedTsAfter,
+			UpdatedTsBefore: &updatedTsBefore,
+			ExcludeContent:  true,
+			ExcludeComments: true,
+			Filter:          &filter,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list memos of shortcut %s", shortcut.Id)
+		}
+		if len(grantedMemoIDs) > 0 {
+			grantedMemos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+				IDList:          grantedMemoIDs,
+				RowStatus:       &normalStatus,
+				ExcludeContent:  true,
+				ExcludeComments: true,
+				Filter:          &filter,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to list granted memos of shortcut %s", shortcut.Id)
+			}
+			memos = append(memos, grantedMemos...)
+		}
+		for _, memo := range memos {
+			if memo.CreatorID == userID || matchedMemoIDs[memo.ID] {
+				continue
+			}
+			matchedMemoIDs[memo.ID] = true
+			matches = append(matches, &shortcutMatch{memo: memo, shortcut: shortcut})
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	// The memos are alerted after they are created, so the earlier alerts are not looked up.
+	createdTsAfter := matches[0].memo.CreatedTs
+	for _, match := range matches {
+		createdTsAfter = min(createdTsAfter, match.memo.CreatedTs)
+	}
+	alertedMemoIDs, err := r.getAlertedMemoIDs(ctx, userID, createdTsAfter-1)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		memo, shortcut := match.memo, match.shortcut
+		if alertedMemoIDs[memo.ID] {
+			continue
+		}
+		activity, err := r.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: memo.CreatorID,
+			Type:      store.ActivityTypeShortcutMatch,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				ShortcutMatch: &storepb.ActivityShortcutMatchPayload{
+					MemoId:        memo.ID,
+					ShortcutId:    shortcut.Id,
+					ShortcutTitle: shortcut.Title,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		if _, err := r.Store.SendInboxMessage(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: userID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_SHORTCUT_MATCH,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+	}
+	return nil
+}
+
+// getAlertedMemoIDs returns the ids of the memos the user has been alerted about since the time.
+func (r *Runner) getAlertedMemoIDs(ctx context.Context, userID int32, createdTsAfter int64) (map[int32]bool, error) {
+	inboxes, err := r.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:     &userID,
+		CreatedTsAfter: &createdTsAfter,
+		MessageTypes:   []storepb.InboxMessage_Type{storepb.InboxMessage_SHORTCUT_MATCH},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list inboxes")
+	}
+	alertedMemoIDs := map[int32]bool{}
+	activityIDs := []int32{}
+	for _, inbox := range inboxes {
+		if inbox.Message.ActivityId != nil {
+			activityIDs = append(activityIDs, inbox.Message.GetActivityId())
+		}
+	}
+	if len(activityIDs) == 0 {
+		return alertedMemoIDs, nil
+	}
+	activities, err := r.Store.ListActivities(ctx, &store.FindActivity{
+		IDList: activityIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list activities")
+	}
+	for _, activity := range activities {
+		if shortcutMatch := activity.Payload.GetShortcutMatch(); shortcutMatch != nil {
+			alertedMemoIDs[shortcutMatch.MemoId] = true
+		}
+	}
+	return alertedMemoIDs, nil
+}
+
+// SendDailyDigests rolls the unread inbox messages of the users who opted in into a single digest message.
+func (r *Runner) SendDailyDigests(ctx context.Context) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_NOTIFICATIONS,
+	})
+	if err != nil {
+		slog.Error("Failed to list user notifications settings", "error", err)
+		return
+	}
+	for _, userSetting := range userSettings {
+		if !userSetting.GetNotifications().GetDailyDigest() {
+			continue
+		}
+		if err := r.SendDigest(ctx, userSetting.UserId); err != nil {
+			slog.Error("Failed to send daily digest", "error", err, "userID", userSetting.UserId)
+		}
+	}
+}
+
+// SendDigest archives the unread inbox messages of the user and sends a digest message referring to their activities.
+// The previous digests are not rolled, and no digest is sent if there is nothing unread.
+func (r *Runner) SendDigest(ctx context.Context, userID int32) error {
+	unreadStatus := store.UNREAD
+	inboxes, err := r.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &userID,
+		Status:     &unreadStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+
+	rolledInboxes := []*store.Inbox{}
+	activityIDs := []int32{}
+	for _, inbox := range inboxes {
+		if inbox.Message.GetType() == storepb.InboxMessage_DIGEST || inbox.Message.ActivityId == nil {
+			continue
+		}
+		rolledInboxes = append(rolledInboxes, inbox)
+		activityIDs = append(activityIDs, inbox.Message.GetActivityId())
+	}
+	if len(rolledInboxes) == 0 {
+		return nil
+	}
+
+	activity, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: userID,
+		Type:      store.ActivityTypeDigest,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			Digest: &storepb.ActivityDigestPayload{
+				ActivityIds: activityIDs,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	// The digest is sent even if the digest type is muted, as the user opted in to it.
+	if _, err := r.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   userID,
+		ReceiverID: userID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_DIGEST,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	for _, inbox := range rolledInboxes {
+		if _, err := r.Store.UpdateInbox(ctx, &store.UpdateInbox{
+			ID:     inbox.ID,
+			Status: store.ARCHIVED,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update inbox")
+		}
+	}
+	return nil
+}
+
+// updateInboxMessage changes the message of the inbox as last stored, so the marks set by the other checks are kept.
+func (r *Runner) updateInboxMessage(ctx context.Context, inboxID int32, update func(message *storepb.InboxMessage)) error {
+	inboxes, err := r.Store.ListInboxes(ctx, &store.FindInbox{ID: &inboxID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+	if len(inboxes) == 0 {
+		return nil
+	}
+	message := inboxes[0].Message
+	update(message)
+	if _, err := r.Store.UpdateInbox(ctx, &store.UpdateInbox{
+		ID:      inboxID,
+		Message: message,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update inbox")
+	}
+	return nil
+}
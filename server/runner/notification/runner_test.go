@@ -0,0 +1,232 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestSendShortcutAlerts(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	user := createTestingUser(ctx, t, ts, "user")
+	other := createTestingUser(ctx, t, ts, "other")
+	_, err := ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_SHORTCUTS,
+		Value: &storepb.UserSetting_Shortcuts{
+			Shortcuts: &storepb.ShortcutsUserSetting{
+				Shortcuts: []*storepb.ShortcutsUserSetting_Shortcut{
+					{Id: "work", Title: "Work", Filter: `tag in ["work"]`},
+					{Id: "todo", Title: "Todo", Filter: `tag in ["todo"]`},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_NOTIFICATIONS,
+		Value: &storepb.UserSetting_Notifications{
+			Notifications: &storepb.NotificationsUserSetting{
+				AlertShortcutIds: []string{"work"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The memos updated before the alerts are turned on are not alerted.
+	now := time.Now().Unix()
+	createTestingMemo(ctx, t, ts, other, "early", "#work early", store.Public, now-150)
+	r.SendShortcutAlerts(ctx)
+	require.Empty(t, listAlertedMemoIDs(ctx, t, ts, user.ID))
+	notificationState, err := ts.GetUserNotificationState(ctx, user.ID)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, notificationState.AlertUpdatedTs, now-1)
+
+	// The memos updated since the last check are alerted if the user can read them.
+	setAlertUpdatedTs(ctx, t, ts, user.ID, now-100)
+	publicMemo := createTestingMemo(ctx, t, ts, other, "public", "#work public", store.Public, now-50)
+	protectedMemo := createTestingMemo(ctx, t, ts, other, "protected", "#work protected", store.Protected, now-50)
+	createTestingMemo(ctx, t, ts, other, "private", "#work private", store.Private, now-50)
+	grantedMemo := createTestingMemo(ctx, t, ts, other, "granted", "#work granted", store.Private, now-50)
+	_, err = ts.UpsertMemoACL(ctx, &store.MemoACL{MemoID: grantedMemo.ID, UserID: user.ID, Permission: store.MemoPermissionRead})
+	require.NoError(t, err)
+	createTestingMemo(ctx, t, ts, other, "todo", "#todo not alerting", store.Public, now-50)
+	createTestingMemo(ctx, t, ts, user, "own", "#work own", store.Public, now-50)
+	scheduledMemo := createTestingMemo(ctx, t, ts, other, "scheduled", "#work scheduled", store.Private, now-150)
+	lateGrantedMemo := createTestingMemo(ctx, t, ts, other, "late-granted", "#work granted later", store.Private, now-150)
+	r.SendShortcutAlerts(ctx)
+	require.ElementsMatch(t, []int32{publicMemo.ID, protectedMemo.ID, grantedMemo.ID}, listAlertedMemoIDs(ctx, t, ts, user.ID))
+
+	// The memos published or granted after they are created are alerted then, the updated memos are not alerted again.
+	setAlertUpdatedTs(ctx, t, ts, user.ID, now-100)
+	publicVisibility, updatedTs := store.Public, now-10
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: scheduledMemo.ID, Visibility: &publicVisibility, UpdatedTs: &updatedTs}))
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: publicMemo.ID, UpdatedTs: &updatedTs}))
+	_, err = ts.UpsertMemoACL(ctx, &store.MemoACL{MemoID: lateGrantedMemo.ID, UserID: user.ID, Permission: store.MemoPermissionRead})
+	require.NoError(t, err)
+	// The grants of the current second are left to the next check.
+	time.Sleep(time.Second)
+	r.SendShortcutAlerts(ctx)
+	require.ElementsMatch(t, []int32{publicMemo.ID, protectedMemo.ID, grantedMemo.ID, scheduledMemo.ID, lateGrantedMemo.ID}, listAlertedMemoIDs(ctx, t, ts, user.ID))
+
+	// The muted alerts are not checked.
+	setAlertUpdatedTs(ctx, t, ts, user.ID, now-100)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_NOTIFICATIONS,
+		Value: &storepb.UserSetting_Notifications{
+			Notifications: &storepb.NotificationsUserSetting{
+				MutedTypes:       []storepb.InboxMessage_Type{storepb.InboxMessage_SHORTCUT_MATCH},
+				AlertShortcutIds: []string{"work"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	r.SendShortcutAlerts(ctx)
+	notificationState, err = ts.GetUserNotificationState(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, now-100, notificationState.AlertUpdatedTs)
+}
+
+func TestSendDailyDigests(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	user := createTestingUser(ctx, t, ts, "user")
+	other := createTestingUser(ctx, t, ts, "other")
+	_, err := ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_NOTIFICATIONS,
+		Value: &storepb.UserSetting_Notifications{
+			Notifications: &storepb.NotificationsUserSetting{
+				DailyDigest: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	memo := createTestingMemo(ctx, t, ts, user, "memo", "memo", store.Public, time.Now().Unix())
+	commentInbox, commentActivityID := createTestingCommentInbox(ctx, t, ts, memo, other, user)
+	otherInbox, _ := createTestingCommentInbox(ctx, t, ts, memo, user, other)
+
+	// The unread messages are rolled into the digest of the users who opted in.
+	r.SendDailyDigests(ctx)
+	inboxes := listUnreadInboxes(ctx, t, ts, user.ID)
+	require.Len(t, inboxes, 1)
+	digestInbox := inboxes[0]
+	require.Equal(t, storepb.InboxMessage_DIGEST, digestInbox.Message.Type)
+	digestActivity, err := ts.GetActivity(ctx, &store.FindActivity{ID: digestInbox.Message.ActivityId})
+	require.NoError(t, err)
+	require.Equal(t, []int32{commentActivityID}, digestActivity.Payload.GetDigest().GetActivityIds())
+	archivedInbox, err := ts.ListInboxes(ctx, &store.FindInbox{ID: &commentInbox.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.ARCHIVED, archivedInbox[0].Status)
+	require.Equal(t, []*store.Inbox{otherInbox}, listUnreadInboxes(ctx, t, ts, other.ID))
+
+	// The digests are not rolled, so nothing is sent without new messages.
+	r.SendDailyDigests(ctx)
+	require.Equal(t, []*store.Inbox{digestInbox}, listUnreadInboxes(ctx, t, ts, user.ID))
+}
+
+func createTestingUser(ctx context.Context, t *testing.T, ts *store.Store, username string) *store.User {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     store.RoleUser,
+		Email:    username + "@test.com",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// createTestingMemo creates the memo last updated at the time.
+func createTestingMemo(ctx context.Context, t *testing.T, ts *store.Store, user *store.User, uid, content string, visibility store.Visibility, updatedTs int64) *store.Memo {
+	memo := &store.Memo{
+		UID:        fmt.Sprintf("%s-%d", uid, user.ID),
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: visibility,
+	}
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+	memo, err := ts.CreateMemo(ctx, memo)
+	require.NoError(t, err)
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, UpdatedTs: &updatedTs}))
+	return memo
+}
+
+// createTestingCommentInbox sends the message of a comment on the memo to the receiver.
+func createTestingCommentInbox(ctx context.Context, t *testing.T, ts *store.Store, memo *store.Memo, sender, receiver *store.User) (*store.Inbox, int32) {
+	activity, err := ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: sender.ID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoComment: &storepb.ActivityMemoCommentPayload{
+				MemoId:        memo.ID,
+				RelatedMemoId: memo.ID,
+			},
+		},
+	})
+	require.NoError(t, err)
+	inbox, err := ts.CreateInbox(ctx, &store.Inbox{
+		SenderID:   sender.ID,
+		ReceiverID: receiver.ID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_COMMENT,
+			ActivityId: &activity.ID,
+		},
+	})
+	require.NoError(t, err)
+	return inbox, activity.ID
+}
+
+func setAlertUpdatedTs(ctx context.Context, t *testing.T, ts *store.Store, userID int32, alertUpdatedTs int64) {
+	_, err := ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_NOTIFICATION_STATE,
+		Value: &storepb.UserSetting_NotificationState{
+			NotificationState: &storepb.NotificationStateUserSetting{
+				AlertUpdatedTs: alertUpdatedTs,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+// listAlertedMemoIDs returns the ids of the memos in the shortcut alerts of the user.
+func listAlertedMemoIDs(ctx context.Context, t *testing.T, ts *store.Store, userID int32) []int32 {
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:   &userID,
+		MessageTypes: []storepb.InboxMessage_Type{storepb.InboxMessage_SHORTCUT_MATCH},
+	})
+	require.NoError(t, err)
+	memoIDs := []int32{}
+	for _, inbox := range inboxes {
+		activity, err := ts.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, activity.Payload.GetShortcutMatch().GetMemoId())
+	}
+	return memoIDs
+}
+
+func listUnreadInboxes(ctx context.Context, t *testing.T, ts *store.Store, userID int32) []*store.Inbox {
+	unreadStatus := store.UNREAD
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &userID,
+		Status:     &unreadStatus,
+	})
+	require.NoError(t, err)
+	return inboxes
+}
@@ -0,0 +1,155 @@
+package reminder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/cron"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+
+	// OnReminded is called after a reminder of the memo has been delivered.
+	OnReminded func(ctx context.Context, memo *store.Memo)
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Check the reminders every minute.
+const runnerSpec = "@every 1m"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSpec, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule reminder runner", "error", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.DeliverDueReminders(ctx)
+}
+
+// DeliverDueReminders sends the due reminders of the memos to the inbox of their creators.
+func (r *Runner) DeliverDueReminders(ctx context.Context) {
+	normalStatus := store.Normal
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &normalStatus,
+		PayloadFind: &store.FindMemoPayload{
+			HasReminders: true,
+		},
+	})
+	if err != nil {
+		slog.Error("Failed to list memos with reminders", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, memo := range memos {
+		due := false
+		for _, reminder := range memo.Payload.GetReminders() {
+			nextRemindTime, err := GetNextRemindTime(reminder, memo.CreatedTs)
+			if err != nil {
+				slog.Error("Failed to get next remind time", "error", err, "memoID", memo.ID)
+				continue
+			}
+			if nextRemindTime == nil || nextRemindTime.After(now) {
+				continue
+			}
+			// The missed occurrences of a recurring reminder are delivered once.
+			reminder.LastRemindTime = timestamppb.New(now)
+			due = true
+		}
+		if !due {
+			continue
+		}
+		if err := r.deliverReminder(ctx, memo); err != nil {
+			slog.Error("Failed to deliver memo reminder", "error", err, "memoID", memo.ID)
+			continue
+		}
+		if r.OnReminded != nil {
+			r.OnReminded(ctx, memo)
+		}
+	}
+}
+
+func (r *Runner) deliverReminder(ctx context.Context, memo *store.Memo) error {
+	if err := r.Store.UpdateMemoReminders(ctx, &store.UpdateMemoReminders{
+		ID:        memo.ID,
+		Reminders: memo.Payload.GetReminders(),
+	}); err != nil {
+		return errors.Wrap(err, "failed to update memo reminders")
+	}
+	activity, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoReminder,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReminder: &storepb.ActivityMemoReminderPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := r.Store.SendInboxMessage(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_REMINDER,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
+// GetNextRemindTime returns the time the reminder comes due next, or nil if it won't come due anymore.
+// A recurring reminder comes due at the first occurrence after its last delivery, its start or the creation of the memo.
+// Its cron expression is in the local time of the server, unless it's prefixed with a timezone, e.g. "CRON_TZ=Asia/Tokyo 0 9 * * MON".
+func GetNextRemindTime(reminder *storepb.MemoPayload_Reminder, createdTs int64) (*time.Time, error) {
+	if reminder.Cron == "" {
+		if reminder.RemindTime == nil || reminder.LastRemindTime != nil {
+			return nil, nil
+		}
+		remindTime := reminder.RemindTime.AsTime()
+		return &remindTime, nil
+	}
+
+	schedule, err := cron.ParseStandard(reminder.Cron)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron expression %q", reminder.Cron)
+	}
+	since := time.Unix(createdTs, 0)
+	if reminder.RemindTime != nil && reminder.RemindTime.AsTime().After(since) {
+		since = reminder.RemindTime.AsTime()
+	}
+	if reminder.LastRemindTime != nil && reminder.LastRemindTime.AsTime().After(since) {
+		since = reminder.LastRemindTime.AsTime()
+	}
+	// The schedule is evaluated in the timezone of the time, the timestamps are in UTC.
+	nextRemindTime := schedule.Next(since.Local())
+	if nextRemindTime.IsZero() {
+		return nil, nil
+	}
+	return &nextRemindTime, nil
+}
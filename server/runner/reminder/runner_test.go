@@ -0,0 +1,149 @@
+package reminder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestGetNextRemindTime(t *testing.T) {
+	// The cron expressions without a timezone are in the local time of the server.
+	local := time.Local
+	time.Local = time.FixedZone("UTC+8", 8*60*60)
+	defer func() {
+		time.Local = local
+	}()
+	createdTs := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC).Unix()
+	remindTime := time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		reminder *storepb.MemoPayload_Reminder
+		want     *time.Time
+	}{
+		{
+			name:     "one-off",
+			reminder: &storepb.MemoPayload_Reminder{RemindTime: timestamppb.New(remindTime)},
+			want:     &remindTime,
+		},
+		{
+			name:     "one-off delivered",
+			reminder: &storepb.MemoPayload_Reminder{RemindTime: timestamppb.New(remindTime), LastRemindTime: timestamppb.New(remindTime)},
+		},
+		{
+			name:     "recurring since creation",
+			reminder: &storepb.MemoPayload_Reminder{Cron: "0 9 * * *"},
+			want:     getTime(time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)),
+		},
+		{
+			// The start in UTC doesn't change the timezone of the schedule.
+			name:     "recurring since start",
+			reminder: &storepb.MemoPayload_Reminder{Cron: "0 9 * * *", RemindTime: timestamppb.New(remindTime)},
+			want:     getTime(time.Date(2026, 3, 6, 1, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "recurring since last delivery",
+			reminder: &storepb.MemoPayload_Reminder{
+				Cron:           "0 9 * * *",
+				RemindTime:     timestamppb.New(remindTime),
+				LastRemindTime: timestamppb.New(time.Date(2026, 3, 10, 1, 0, 30, 0, time.UTC)),
+			},
+			want: getTime(time.Date(2026, 3, 11, 1, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "recurring with timezone",
+			reminder: &storepb.MemoPayload_Reminder{Cron: "CRON_TZ=America/New_York 0 9 * * *", RemindTime: timestamppb.New(remindTime)},
+			want:     getTime(time.Date(2026, 3, 5, 14, 0, 0, 0, time.UTC)),
+		},
+		{
+			// The daylight saving time starts on 2026-03-08 in New York.
+			name: "recurring across daylight saving time",
+			reminder: &storepb.MemoPayload_Reminder{
+				Cron:           "CRON_TZ=America/New_York 0 9 * * *",
+				LastRemindTime: timestamppb.New(time.Date(2026, 3, 7, 14, 0, 0, 0, time.UTC)),
+			},
+			want: getTime(time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GetNextRemindTime(test.reminder, createdTs)
+			require.NoError(t, err)
+			if test.want == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			require.True(t, test.want.Equal(*got), "want %v, got %v", test.want.UTC(), got.UTC())
+		})
+	}
+
+	_, err := GetNextRemindTime(&storepb.MemoPayload_Reminder{Cron: "every day"}, createdTs)
+	require.Error(t, err)
+}
+
+func TestDeliverDueReminders(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	reminded := []int32{}
+	r.OnReminded = func(_ context.Context, memo *store.Memo) {
+		reminded = append(reminded, memo.ID)
+	}
+	user, err := ts.CreateUser(ctx, &store.User{Username: "reminded", Role: store.RoleUser, Email: "reminded@test.com"})
+	require.NoError(t, err)
+	dueMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "due",
+		CreatorID:  user.ID,
+		Content:    "due",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Reminders: []*storepb.MemoPayload_Reminder{{RemindTime: timestamppb.New(time.Now().Add(-time.Minute))}},
+		},
+	})
+	require.NoError(t, err)
+	laterMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "later",
+		CreatorID:  user.ID,
+		Content:    "later",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Reminders: []*storepb.MemoPayload_Reminder{{RemindTime: timestamppb.New(time.Now().Add(time.Hour))}},
+		},
+	})
+	require.NoError(t, err)
+
+	r.DeliverDueReminders(ctx)
+	require.Equal(t, []int32{dueMemo.ID}, reminded)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &dueMemo.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo.Payload.Reminders[0].LastRemindTime)
+	require.Equal(t, dueMemo.UpdatedTs, memo.UpdatedTs)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &laterMemo.ID})
+	require.NoError(t, err)
+	require.Nil(t, memo.Payload.Reminders[0].LastRemindTime)
+	// Delivering a reminder doesn't keep a revision of the memo.
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &dueMemo.ID})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{ReceiverID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+	require.Equal(t, storepb.InboxMessage_REMINDER, inboxes[0].Message.Type)
+
+	// The delivered reminders are not delivered again.
+	r.DeliverDueReminders(ctx)
+	require.Len(t, reminded, 1)
+}
+
+func getTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,111 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+
+	// OnPublished is called after a scheduled memo has been published.
+	OnPublished func(ctx context.Context, memo *store.Memo)
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Check the scheduled memos every minute.
+const runnerSpec = "@every 1m"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSpec, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("Failed to schedule publishing runner", "error", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.PublishDueMemos(ctx)
+}
+
+// PublishDueMemos promotes the memos whose publish time has arrived to their target visibility.
+func (r *Runner) PublishDueMemos(ctx context.Context) {
+	normalStatus := store.Normal
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &normalStatus,
+		PayloadFind: &store.FindMemoPayload{
+			HasPublishTime: true,
+		},
+	})
+	if err != nil {
+		slog.Error("Failed to list scheduled memos", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, memo := range memos {
+		publishTime := memo.Payload.GetPublishTime()
+		if publishTime == nil || publishTime.AsTime().After(now) {
+			continue
+		}
+		if err := r.publishMemo(ctx, memo, now); err != nil {
+			// The memo edited since it was listed is published on the next run with the changes.
+			if errors.Is(err, store.ErrMemoConflict) {
+				continue
+			}
+			slog.Error("Failed to publish scheduled memo", "error", err, "memoID", memo.ID)
+			continue
+		}
+		memo, err := r.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		if err != nil || memo == nil {
+			slog.Error("Failed to get published memo", "error", err)
+			continue
+		}
+		if r.OnPublished != nil {
+			r.OnPublished(ctx, memo)
+		}
+	}
+}
+
+func (r *Runner) publishMemo(ctx context.Context, memo *store.Memo, now time.Time) error {
+	visibility := store.Public
+	if memo.Payload.TargetVisibility != "" {
+		visibility = store.Visibility(memo.Payload.TargetVisibility)
+	}
+	workspaceMemoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+		slog.Warn("Public memos are disallowed, publishing scheduled memo as protected", "memoID", memo.ID)
+		visibility = store.Protected
+	}
+
+	payload := memo.Payload
+	payload.PublishTime = nil
+	payload.TargetVisibility = ""
+	updatedTs := now.Unix()
+	return r.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                memo.ID,
+		Visibility:        &visibility,
+		Payload:           payload,
+		UpdatedTs:         &updatedTs,
+		ExpectedUpdatedTs: &memo.UpdatedTs,
+	})
+}
@@ -0,0 +1,97 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestPublishDueMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	publishedMemoIDs := []int32{}
+	r.OnPublished = func(_ context.Context, memo *store.Memo) {
+		publishedMemoIDs = append(publishedMemoIDs, memo.ID)
+	}
+	user, err := ts.CreateUser(ctx, &store.User{Username: "owner", Role: store.RoleUser, Email: "owner@test.com"})
+	require.NoError(t, err)
+
+	dueMemo := createTestingMemo(ctx, t, ts, user, "due", time.Now().Add(-time.Minute), store.Protected)
+	futureMemo := createTestingMemo(ctx, t, ts, user, "future", time.Now().Add(time.Hour), store.Protected)
+
+	// Only the memos whose publish time has arrived are published, with their target visibility.
+	r.PublishDueMemos(ctx)
+	memo := getMemo(ctx, t, ts, dueMemo.ID)
+	require.Equal(t, store.Protected, memo.Visibility)
+	require.Nil(t, memo.Payload.PublishTime)
+	require.Empty(t, memo.Payload.TargetVisibility)
+	memo = getMemo(ctx, t, ts, futureMemo.ID)
+	require.Equal(t, store.Private, memo.Visibility)
+	require.NotNil(t, memo.Payload.PublishTime)
+	require.Equal(t, []int32{dueMemo.ID}, publishedMemoIDs)
+
+	// The public memos are published as protected once public memos are disallowed.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_MEMO_RELATED,
+		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{
+			MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{DisallowPublicVisibility: true},
+		},
+	})
+	require.NoError(t, err)
+	publicMemo := createTestingMemo(ctx, t, ts, user, "public", time.Now().Add(-time.Minute), store.Public)
+	r.PublishDueMemos(ctx)
+	require.Equal(t, store.Protected, getMemo(ctx, t, ts, publicMemo.ID).Visibility)
+	require.Equal(t, []int32{dueMemo.ID, publicMemo.ID}, publishedMemoIDs)
+}
+
+func TestPublishMemoConflict(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	user, err := ts.CreateUser(ctx, &store.User{Username: "owner", Role: store.RoleUser, Email: "owner@test.com"})
+	require.NoError(t, err)
+	memo := createTestingMemo(ctx, t, ts, user, "due", time.Now().Add(-time.Minute), store.Protected)
+
+	// The memo edited since it was read isn't overwritten.
+	stale := getMemo(ctx, t, ts, memo.ID)
+	content := "edited"
+	updatedTs := stale.UpdatedTs + 1
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content, UpdatedTs: &updatedTs}))
+	require.ErrorIs(t, r.publishMemo(ctx, stale, time.Now()), store.ErrMemoConflict)
+	memo = getMemo(ctx, t, ts, memo.ID)
+	require.Equal(t, store.Private, memo.Visibility)
+	require.Equal(t, "edited", memo.Content)
+	require.NotNil(t, memo.Payload.PublishTime)
+}
+
+// createTestingMemo creates the private memo scheduled to be published with the visibility.
+func createTestingMemo(ctx context.Context, t *testing.T, ts *store.Store, user *store.User, uid string, publishTime time.Time, visibility store.Visibility) *store.Memo {
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    uid,
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			PublishTime:      timestamppb.New(publishTime),
+			TargetVisibility: string(visibility),
+		},
+	})
+	require.NoError(t, err)
+	return memo
+}
+
+func getMemo(ctx context.Context, t *testing.T, ts *store.Store, id int32) *store.Memo {
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &id})
+	require.NoError(t, err)
+	return memo
+}
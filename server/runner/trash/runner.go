@@ -0,0 +1,141 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.PurgeExpiredMemos(ctx)
+}
+
+// PurgeExpiredMemos permanently deletes the memos that stayed in the trash longer than the retention period.
+func (r *Runner) PurgeExpiredMemos(ctx context.Context) {
+	workspaceMemoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace memo related setting", "error", err)
+		return
+	}
+
+	// The updated_ts of a memo is set to the time it was moved to the trash.
+	retention := time.Duration(workspaceMemoRelatedSetting.TrashRetentionDays) * 24 * time.Hour
+	deletedBefore := time.Now().Add(-retention).Unix()
+	rowStatus := store.Deleted
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &rowStatus,
+		UpdatedTsBefore: &deletedBefore,
+		ExcludeContent:  true,
+	})
+	if err != nil {
+		slog.Error("Failed to list deleted memos", "error", err)
+		return
+	}
+
+	for _, memo := range memos {
+		if err := PurgeMemo(ctx, r.Store, memo); err != nil {
+			slog.Error("Failed to purge memo", "error", err, "memoID", memo.ID)
+		}
+	}
+}
+
+// PurgeMemo permanently deletes a memo together with its relations, resources, reactions,
+// revisions, shares and comments.
+// The memo itself is deleted last, so a memo failing to be purged stays in the trash and
+// the remaining rows are retried on the next run.
+func PurgeMemo(ctx context.Context, stores *store.Store, memo *store.Memo) error {
+	// Purge memo comments first, as they are only reachable through the memo.
+	commentType := store.MemoRelationComment
+	relations, err := stores.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo comments")
+	}
+	for _, relation := range relations {
+		comment, err := stores.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID, ExcludeContent: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo comment")
+		}
+		if comment == nil {
+			continue
+		}
+		if err := PurgeMemo(ctx, stores, comment); err != nil {
+			return errors.Wrap(err, "failed to purge memo comment")
+		}
+	}
+
+	resources, err := stores.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resources {
+		if err := stores.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete resource")
+		}
+	}
+
+	contentID := fmt.Sprintf("memos/%s", memo.UID)
+	reactions, err := stores.ListReactions(ctx, &store.FindReaction{ContentID: &contentID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		if err := stores.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete reaction")
+		}
+	}
+
+	if err := stores.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo revisions")
+	}
+	if err := stores.DeleteMemoShare(ctx, &store.DeleteMemoShare{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo shares")
+	}
+	if err := stores.DeleteMemoACL(ctx, &store.DeleteMemoACL{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo grants")
+	}
+
+	// Delete memo relations in both directions.
+	if err := stores.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo relations")
+	}
+	if err := stores.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete related memo relations")
+	}
+
+	if err := stores.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestPurgeMemo(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	user := createTestingUser(ctx, t, ts, "owner")
+	other := createTestingUser(ctx, t, ts, "other")
+	memo := createTestingMemo(ctx, t, ts, user, "memo", store.Deleted, time.Now().Unix())
+	comment := createTestingMemo(ctx, t, ts, other, "comment", store.Normal, time.Now().Unix())
+	referrer := createTestingMemo(ctx, t, ts, other, "referrer", store.Normal, time.Now().Unix())
+
+	_, err := ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: comment.ID, RelatedMemoID: memo.ID, Type: store.MemoRelationComment})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: referrer.ID, RelatedMemoID: memo.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+	// The local file of the resource is already removed, as by an earlier failed purge.
+	_, err = ts.CreateResource(ctx, &store.Resource{
+		UID:         "resource",
+		CreatorID:   user.ID,
+		Filename:    "removed.txt",
+		Type:        "text/plain",
+		StorageType: storepb.ResourceStorageType_LOCAL,
+		Reference:   filepath.Join(t.TempDir(), "removed.txt"),
+		MemoID:      &memo.ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertReaction(ctx, &store.Reaction{CreatorID: other.ID, ContentID: "memos/memo", ReactionType: "👍"})
+	require.NoError(t, err)
+	_, err = ts.CreateMemoRevision(ctx, &store.MemoRevision{MemoID: memo.ID, Content: "memo", Visibility: store.Private})
+	require.NoError(t, err)
+	_, err = ts.CreateMemoShare(ctx, &store.MemoShare{UID: "share", MemoID: memo.ID, CreatorID: user.ID})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoACL(ctx, &store.MemoACL{MemoID: memo.ID, UserID: other.ID, Permission: store.MemoPermissionRead})
+	require.NoError(t, err)
+
+	// The memo is purged with its comments and all the rows depending on it.
+	require.NoError(t, PurgeMemo(ctx, ts, memo))
+	require.Nil(t, getMemo(ctx, t, ts, memo.ID))
+	require.Nil(t, getMemo(ctx, t, ts, comment.ID))
+	require.NotNil(t, getMemo(ctx, t, ts, referrer.ID))
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, relations)
+	resources, err := ts.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, resources)
+	contentID := "memos/memo"
+	reactions, err := ts.ListReactions(ctx, &store.FindReaction{ContentID: &contentID})
+	require.NoError(t, err)
+	require.Empty(t, reactions)
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+	shares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, shares)
+	acls, err := ts.ListMemoACLs(ctx, &store.FindMemoACL{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, acls)
+}
+
+func TestPurgeExpiredMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	r := NewRunner(ts)
+	user := createTestingUser(ctx, t, ts, "owner")
+
+	expiredTs := time.Now().AddDate(0, 0, -store.DefaultTrashRetentionDays-1).Unix()
+	recentTs := time.Now().AddDate(0, 0, -store.DefaultTrashRetentionDays+1).Unix()
+	expiredMemo := createTestingMemo(ctx, t, ts, user, "expired", store.Deleted, expiredTs)
+	recentMemo := createTestingMemo(ctx, t, ts, user, "recent", store.Deleted, recentTs)
+	normalMemo := createTestingMemo(ctx, t, ts, user, "normal", store.Normal, expiredTs)
+
+	// Only the memos in the trash longer than the retention period are purged.
+	r.PurgeExpiredMemos(ctx)
+	require.Nil(t, getMemo(ctx, t, ts, expiredMemo.ID))
+	require.NotNil(t, getMemo(ctx, t, ts, recentMemo.ID))
+	require.NotNil(t, getMemo(ctx, t, ts, normalMemo.ID))
+}
+
+func createTestingUser(ctx context.Context, t *testing.T, ts *store.Store, username string) *store.User {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     store.RoleUser,
+		Email:    username + "@test.com",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// createTestingMemo creates the memo with the row status, last updated at the time.
+func createTestingMemo(ctx context.Context, t *testing.T, ts *store.Store, user *store.User, uid string, rowStatus store.RowStatus, updatedTs int64) *store.Memo {
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    fmt.Sprintf("%s memo", uid),
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, RowStatus: &rowStatus, UpdatedTs: &updatedTs}))
+	return memo
+}
+
+func getMemo(ctx context.Context, t *testing.T, ts *store.Store, id int32) *store.Memo {
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &id})
+	require.NoError(t, err)
+	return memo
+}
@@ -19,13 +19,20 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/profiler"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/runner/lifecycle"
 	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/server/runner/notification"
+	"github.com/usememos/memos/server/runner/reminder"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/schedule"
+	"github.com/usememos/memos/server/runner/trash"
 	"github.com/usememos/memos/store"
 )
 
@@ -36,6 +43,7 @@ type Server struct {
 
 	echoServer        *echo.Echo
 	grpcServer        *grpc.Server
+	apiV1Service      *apiv1.APIV1Service
 	profiler          *profiler.Profiler
 	runnerCancelFuncs []context.CancelFunc
 }
@@ -98,9 +106,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	)
 	s.grpcServer = grpcServer
 
-	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	s.apiV1Service = apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
 	// Register gRPC gateway as api v1.
-	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
+	if err := s.apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
 	}
 
@@ -158,6 +166,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 		}
 	}
 
+	// End the memo watching streams, they would keep the servers from shutting down.
+	s.apiV1Service.CloseMemoWatches()
+
 	// Shutdown echo server.
 	if err := s.echoServer.Shutdown(ctx); err != nil {
 		slog.Error("failed to shutdown server", slog.String("error", err.Error()))
@@ -192,9 +203,14 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	// Create a separate context for each background runner
 	// This allows us to control cancellation for each runner independently
 	s3Context, s3Cancel := context.WithCancel(ctx)
+	trashContext, trashCancel := context.WithCancel(ctx)
+	scheduleContext, scheduleCancel := context.WithCancel(ctx)
+	lifecycleContext, lifecycleCancel := context.WithCancel(ctx)
+	reminderContext, reminderCancel := context.WithCancel(ctx)
+	notificationContext, notificationCancel := context.WithCancel(ctx)
 
 	// Store the cancel function so we can properly shut down runners
-	s.runnerCancelFuncs = append(s.runnerCancelFuncs, s3Cancel)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, s3Cancel, trashCancel, scheduleCancel, lifecycleCancel, reminderCancel, notificationCancel)
 
 	// Create and start S3 presign runner
 	s3presignRunner := s3presign.NewRunner(s.Store)
@@ -211,6 +227,63 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 		slog.Info("s3presign runner stopped")
 	}()
 
+	// Start continuous trash runner to purge expired memos
+	trashRunner := trash.NewRunner(s.Store)
+	go func() {
+		trashRunner.RunOnce(trashContext)
+		trashRunner.Run(trashContext)
+		slog.Info("trash runner stopped")
+	}()
+
+	// Start scheduled publishing runner
+	scheduleRunner := schedule.NewRunner(s.Store)
+	scheduleRunner.OnPublished = func(ctx context.Context, memo *store.Memo) {
+		// Try to dispatch webhook when memo is published.
+		if err := s.apiV1Service.DispatchStoreMemoUpdatedWebhook(ctx, memo); err != nil {
+			slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+		}
+	}
+	go func() {
+		scheduleRunner.Run(scheduleContext)
+		slog.Info("schedule runner stopped")
+	}()
+
+	// Start lifecycle runner to apply the lifecycle rules to stale memos
+	lifecycleRunner := lifecycle.NewRunner(s.Store)
+	lifecycleRunner.OnApplied = func(ctx context.Context, rule *storepb.LifecycleRule, memoIDs []int32) {
+		eventType := v1pb.MemoEvent_UPDATED
+		if rule.Action == storepb.LifecycleRule_DELETE {
+			eventType = v1pb.MemoEvent_DELETED
+		}
+		if err := s.apiV1Service.PublishStoreMemoEvents(ctx, memoIDs, eventType); err != nil {
+			slog.Warn("Failed to publish memo events", slog.Any("err", err))
+		}
+	}
+	go func() {
+		lifecycleRunner.Run(lifecycleContext)
+		slog.Info("lifecycle runner stopped")
+	}()
+
+	// Start reminder runner to deliver the due memo reminders
+	reminderRunner := reminder.NewRunner(s.Store)
+	reminderRunner.OnReminded = func(ctx context.Context, memo *store.Memo) {
+		if err := s.apiV1Service.DispatchStoreMemoRemindedWebhook(ctx, memo); err != nil {
+			slog.Warn("Failed to dispatch memo reminded webhook", slog.Any("err", err))
+		}
+	}
+	go func() {
+		reminderRunner.Run(reminderContext)
+		slog.Info("reminder runner stopped")
+	}()
+
+	// Start notification runner to send the shortcut alerts, the daily digests and the inbox emails
+	notificationRunner := notification.NewRunner(s.Store)
+	notificationRunner.InstanceURL = s.Profile.InstanceURL
+	go func() {
+		notificationRunner.Run(notificationContext)
+		slog.Info("notification runner stopped")
+	}()
+
 	// Log the number of goroutines running
 	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
 }
@@ -225,6 +298,14 @@ func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb
 		workspaceBasicSetting.SecretKey = uuid.NewString()
 		modified = true
 	}
+	if workspaceBasicSetting.VapidPublicKey == "" || workspaceBasicSetting.VapidPrivateKey == "" {
+		privateKey, publicKey, err := webpush.GenerateVAPIDKeys()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate VAPID keys")
+		}
+		workspaceBasicSetting.VapidPrivateKey, workspaceBasicSetting.VapidPublicKey = privateKey, publicKey
+		modified = true
+	}
 	if modified {
 		workspaceSetting, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
 			Key:   storepb.WorkspaceSettingKey_BASIC,
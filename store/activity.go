@@ -9,7 +9,14 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoComment   ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoLifecycle ActivityType = "MEMO_LIFECYCLE"
+	ActivityTypeMemoReminder  ActivityType = "MEMO_REMINDER"
+	ActivityTypeMemoMention   ActivityType = "MEMO_MENTION"
+	ActivityTypeMemoReaction  ActivityType = "MEMO_REACTION"
+	ActivityTypeMemoReference ActivityType = "MEMO_REFERENCE"
+	ActivityTypeShortcutMatch ActivityType = "SHORTCUT_MATCH"
+	ActivityTypeDigest        ActivityType = "DIGEST"
 )
 
 func (t ActivityType) String() string {
@@ -40,8 +47,9 @@ type Activity struct {
 }
 
 type FindActivity struct {
-	ID   *int32
-	Type *ActivityType
+	ID     *int32
+	IDList []int32
+	Type   *ActivityType
 }
 
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
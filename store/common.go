@@ -17,6 +17,8 @@ const (
 	Normal RowStatus = "NORMAL"
 	// Archived is the status for an archived row.
 	Archived RowStatus = "ARCHIVED"
+	// Deleted is the status for a row that has been moved to the trash.
+	Deleted RowStatus = "DELETED"
 )
 
 func (r RowStatus) String() string {
@@ -46,21 +46,7 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 }
 
 func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.Inbox, error) {
-	where, args := []string{"1 = 1"}, []any{}
-
-	if find.ID != nil {
-		where, args = append(where, "`id` = ?"), append(args, *find.ID)
-	}
-	if find.SenderID != nil {
-		where, args = append(where, "`sender_id` = ?"), append(args, *find.SenderID)
-	}
-	if find.ReceiverID != nil {
-		where, args = append(where, "`receiver_id` = ?"), append(args, *find.ReceiverID)
-	}
-	if find.Status != nil {
-		where, args = append(where, "`status` = ?"), append(args, *find.Status)
-	}
-
+	where, args := buildInboxFindWhere(find)
 	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -115,8 +101,60 @@ func (d *DB) GetInbox(ctx context.Context, find *store.FindInbox) (*store.Inbox,
 	return list[0], nil
 }
 
+func (d *DB) CountInboxes(ctx context.Context, find *store.FindInbox) (int, error) {
+	where, args := buildInboxFindWhere(find)
+	query := "SELECT COUNT(*) FROM `inbox` WHERE " + strings.Join(where, " AND ")
+	var count int
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// buildInboxFindWhere returns the conditions and the arguments of the inboxes matching the find.
+func buildInboxFindWhere(find *store.FindInbox) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.SenderID != nil {
+		where, args = append(where, "`sender_id` = ?"), append(args, *find.SenderID)
+	}
+	if find.ReceiverID != nil {
+		where, args = append(where, "`receiver_id` = ?"), append(args, *find.ReceiverID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, *find.Status)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "`created_ts` > FROM_UNIXTIME(?)"), append(args, *find.CreatedTsAfter)
+	}
+	if len(find.MessageTypes) != 0 {
+		placeholder := []string{}
+		for _, messageType := range find.MessageTypes {
+			placeholder, args = append(placeholder, "?"), append(args, messageType.String())
+		}
+		where = append(where, fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(`message`, '$.type')) IN (%s)", strings.Join(placeholder, ",")))
+	}
+	return where, args
+}
+
 func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store.Inbox, error) {
-	set, args := []string{"`status` = ?"}, []any{update.Status.String()}
+	set, args := []string{}, []any{}
+	if update.Status != "" {
+		set, args = append(set, "`status` = ?"), append(args, update.Status.String())
+	}
+	if update.Message != nil {
+		bytes, err := protojson.Marshal(update.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal inbox message")
+		}
+		set, args = append(set, "`message` = ?"), append(args, string(bytes))
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
 	args = append(args, update.ID)
 	query := "UPDATE `inbox` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
 	if _, err := d.db.ExecContext(ctx, query, args...); err != nil {
@@ -18,12 +18,12 @@ func TestConvertExprToSQL(t *testing.T) {
 		{
 			filter: `tag in ["tag1", "tag2"]`,
 			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?))",
-			args:   []any{"tag1", "tag2"},
+			args:   []any{`"tag1"`, `"tag2"`},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
 			want:   "NOT ((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)))",
-			args:   []any{"tag1", "tag2"},
+			args:   []any{`"tag1"`, `"tag2"`},
 		},
 		{
 			filter: `content.contains("memos")`,
@@ -40,10 +40,15 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "`memo`.`visibility` IN (?,?)",
 			args:   []any{"PUBLIC", "PRIVATE"},
 		},
+		{
+			filter: `id in [1, 2]`,
+			want:   "`memo`.`id` IN (?,?)",
+			args:   []any{int64(1), int64(2)},
+		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
 			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR `memo`.`content` LIKE ?)",
-			args:   []any{"tag1", "%hello%"},
+			args:   []any{`"tag1"`, "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -57,37 +62,37 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_task_list`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)",
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list == true`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)",
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list != false`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') != CAST('false' AS JSON)",
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list == false`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('false' AS JSON)",
+			want:   "NOT ((JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE)",
 			args:   []any{},
 		},
 		{
 			filter: `!has_task_list`,
-			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON))",
+			want:   "NOT ((JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE)",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list && pinned`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON) AND `memo`.`pinned` IS TRUE)",
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE AND `memo`.`pinned` IS TRUE)",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list && content.contains("todo")`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON) AND `memo`.`content` LIKE ?)",
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)) IS TRUE AND `memo`.`content` LIKE ?)",
 			args:   []any{"%todo%"},
 		},
 		{
@@ -95,6 +100,41 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "UNIX_TIMESTAMP(`memo`.`created_ts`) > ?",
 			args:   []any{time.Now().Unix() - 60*60*24},
 		},
+		{
+			filter: `"work" in tags`,
+			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)",
+			args:   []any{`"work"`},
+		},
+		{
+			filter: `tag.startsWith("work/")`,
+			want:   "JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL",
+			args:   []any{"work/%"},
+		},
+		{
+			filter: `has_link && !has_code`,
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') = CAST('true' AS JSON)) IS TRUE AND NOT ((JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') = CAST('true' AS JSON)) IS TRUE))",
+			args:   []any{},
+		},
+		{
+			filter: `row_status == "ARCHIVED"`,
+			want:   "`memo`.`row_status` = ?",
+			args:   []any{"ARCHIVED"},
+		},
+		{
+			filter: `display_time >= date("2025-01-02")`,
+			want:   "UNIX_TIMESTAMP(`memo`.`created_ts`) >= ?",
+			args:   []any{int64(1735776000)},
+		},
+		{
+			filter: `within_radius(40.7, -74.0, 10)`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND 2 * 6371 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(CAST(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) AS DOUBLE) - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(CAST(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) AS DOUBLE))) * POWER(SIN(RADIANS(CAST(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) AS DOUBLE) - ?) / 2), 2)))) <= ?)",
+			args:   []any{40.7, 40.7, -74.0, 10.0},
+		},
+		{
+			filter: `within_bbox(10, -75, 20.5, -70)`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND CAST(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) AS DOUBLE) BETWEEN ? AND ? AND CAST(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) AS DOUBLE) BETWEEN ? AND ?)",
+			args:   []any{10.0, 20.5, -75.0, -70.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -39,13 +39,27 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "`related_memo_id` = ?"), append(args, find.RelatedMemoID)
 	}
+	if len(find.MemoIDList) != 0 {
+		placeholder := []string{}
+		for _, id := range find.MemoIDList {
+			placeholder, args = append(placeholder, "?"), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo_id` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if len(find.RelatedMemoIDList) != 0 {
+		placeholder := []string{}
+		for _, id := range find.RelatedMemoIDList {
+			placeholder, args = append(placeholder, "?"), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`related_memo_id` IN (%s)", strings.Join(placeholder, ",")))
+	}
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type)
 	}
 	if find.MemoFilter != nil {
 		// Parse filter string and return the parsed expression.
 		// The filter string should be a CEL expression.
-		parsedExpr, err := filter.Parse(*find.MemoFilter, filter.MemoFilterCELAttributes...)
+		parsedExpr, err := filter.Parse(*find.MemoFilter, filter.MemoInternalFilterCELAttributes...)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,86 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SearchMemos(ctx context.Context, search *store.SearchMemo) ([]*store.MemoSearchResult, error) {
+	// Every term is a required phrase so that the memos must contain all of them.
+	phrases := []string{}
+	for _, term := range store.SplitMemoSearchQuery(search.Query) {
+		phrases = append(phrases, fmt.Sprintf(`+"%s"`, strings.ReplaceAll(term, `"`, "")))
+	}
+	match := "MATCH(`memo`.`content`) AGAINST(? IN BOOLEAN MODE)"
+	matchQuery := strings.Join(phrases, " ")
+	where, args := []string{match}, []any{matchQuery}
+
+	if v := search.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := search.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+	if v := search.VisibilityList; len(v) != 0 {
+		placeholder := []string{}
+		for _, visibility := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("`memo`.`visibility` in (%s)", strings.Join(placeholder, ",")))
+	}
+	if v := search.Filter; v != nil {
+		parsedExpr, err := filter.Parse(*v, filter.MemoInternalFilterCELAttributes...)
+		if err != nil {
+			return nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		convertCtx.DisplayWithUpdateTime = search.DisplayWithUpdateTime
+		if err := d.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, err
+		}
+		condition := convertCtx.Buffer.String()
+		if condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
+	if search.ExcludeComments {
+		where = append(where, "`memo_relation`.`related_memo_id` IS NULL")
+	}
+
+	// MySQL has no native snippets, they are built by the store.
+	query := "SELECT `memo`.`id`, " + match + ", '' FROM `memo` " +
+		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = 'COMMENT' " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"ORDER BY 2 DESC, `memo`.`created_ts` DESC"
+	if search.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *search.Limit)
+		if search.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *search.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, append([]any{matchQuery}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoSearchResult{}
+	for rows.Next() {
+		result := &store.MemoSearchResult{}
+		if err := rows.Scan(&result.MemoID, &result.Rank, &result.Snippet); err != nil {
+			return nil, err
+		}
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
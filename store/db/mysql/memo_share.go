@@ -0,0 +1,94 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShare(ctx context.Context, create *store.MemoShare) (*store.MemoShare, error) {
+	fields := []string{"`uid`", "`memo_id`", "`creator_id`", "`expires_ts`"}
+	placeholder := []string{"?", "?", "?", "FROM_UNIXTIME(?)"}
+	args := []any{create.UID, create.MemoID, create.CreatorID, create.ExpiresTs}
+
+	stmt := "INSERT INTO `memo_share` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListMemoShares(ctx, &store.FindMemoShare{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected memo share count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*store.MemoShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *find.UID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+
+	query := "SELECT `id`, `uid`, `memo_id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`expires_ts`) FROM `memo_share` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShare{}
+	for rows.Next() {
+		share := &store.MemoShare{}
+		if err := rows.Scan(
+			&share.ID,
+			&share.UID,
+			&share.MemoID,
+			&share.CreatorID,
+			&share.CreatedTs,
+			&share.ExpiresTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, share)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoShare(ctx context.Context, delete *store.DeleteMemoShare) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *delete.MemoID)
+	}
+	stmt := "DELETE FROM `memo_share` WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) ListMemoTasks(ctx context.Context, find *store.FindMemoTask) ([]*store.MemoTask, error) {
+	where, args, err := d.buildFindMemoWhere(find.MemoFind)
+	if err != nil {
+		return nil, err
+	}
+	if find.MemoFind.ExcludeComments {
+		where = append(where, "`memo_relation`.`related_memo_id` IS NULL")
+	}
+	if v := find.Complete; v != nil {
+		where, args = append(where, "(JSON_EXTRACT(`task`.`value`, '$.complete') IS TRUE) = ?"), append(args, *v)
+	}
+	// The dates in the format of "2006-01-02" are ordered as strings.
+	if v := find.DueAfter; v != nil {
+		where, args = append(where, "JSON_UNQUOTE(JSON_EXTRACT(`task`.`value`, '$.dueDate')) >= ?"), append(args, *v)
+	}
+	if v := find.DueBefore; v != nil {
+		where, args = append(where, "JSON_UNQUOTE(JSON_EXTRACT(`task`.`value`, '$.dueDate')) <= ?"), append(args, *v)
+	}
+
+	// The tasks are ordered as their memos, then in document order.
+	orders := store.GetMemoOrderBy(find.MemoFind)
+	idOrder := "DESC"
+	if !orders[len(orders)-1].Desc {
+		idOrder = "ASC"
+	}
+	orderBy := []string{}
+	for _, order := range orders {
+		direction := "ASC"
+		if order.Desc {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, fmt.Sprintf("%s %s", memoOrderFieldColumns[order.Field], direction))
+	}
+	orderBy = append(orderBy, "`memo`.`id` "+idOrder, "`task`.`ordinal` ASC")
+
+	query := "SELECT `memo`.`id`, `memo`.`uid`, `task`.`ordinal` - 1, `task`.`value` FROM `memo` " +
+		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = 'COMMENT' " +
+		"JOIN JSON_TABLE(`memo`.`payload`, '$.tasks[*]' COLUMNS (`ordinal` FOR ORDINALITY, `value` JSON PATH '$')) AS `task` " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"ORDER BY " + strings.Join(orderBy, ", ")
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTask{}
+	for rows.Next() {
+		memoTask := &store.MemoTask{}
+		var taskBytes []byte
+		if err := rows.Scan(&memoTask.MemoID, &memoTask.MemoUID, &memoTask.Index, &taskBytes); err != nil {
+			return nil, err
+		}
+		task := &storepb.MemoPayload_Task{}
+		if err := protojsonUnmarshaler.Unmarshal(taskBytes, task); err != nil {
+			return nil, err
+		}
+		memoTask.Task = task
+		list = append(list, memoTask)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
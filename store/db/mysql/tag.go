@@ -0,0 +1,91 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertTag(ctx context.Context, upsert *store.Tag) (*store.Tag, error) {
+	stmt := "INSERT INTO `tag` (`creator_id`, `name`, `color`, `description`, `icon`, `pinned`) VALUES (?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `color` = ?, `description` = ?, `icon` = ?, `pinned` = ?, `updated_ts` = CURRENT_TIMESTAMP"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.CreatorID, upsert.Name, upsert.Color, upsert.Description, upsert.Icon, upsert.Pinned, upsert.Color, upsert.Description, upsert.Icon, upsert.Pinned); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListTags(ctx, &store.FindTag{CreatorID: &upsert.CreatorID, Name: &upsert.Name})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected tag count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	query := "SELECT `id`, `creator_id`, `name`, `color`, `description`, `icon`, `pinned`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`) FROM `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY `name` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Tag{}
+	for rows.Next() {
+		tag := &store.Tag{}
+		if err := rows.Scan(
+			&tag.ID,
+			&tag.CreatorID,
+			&tag.Name,
+			&tag.Color,
+			&tag.Description,
+			&tag.Icon,
+			&tag.Pinned,
+			&tag.CreatedTs,
+			&tag.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *delete.CreatorID)
+	}
+	if delete.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *delete.Name)
+	}
+	stmt := "DELETE FROM `tag` WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
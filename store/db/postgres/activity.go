@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -39,6 +40,13 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.ID != nil {
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
 	}
+	if len(find.IDList) != 0 {
+		holders := []string{}
+		for _, id := range find.IDList {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.Type != nil {
 		where, args = append(where, "type = "+placeholder(len(args)+1)), append(args, find.Type.String())
 	}
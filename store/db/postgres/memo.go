@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -13,6 +14,16 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// memoOrderFieldColumns maps the order fields to the columns of the memo table.
+// The reactions refer to the memos by their resource name, i.e. `memos/{uid}`.
+var memoOrderFieldColumns = map[store.MemoOrderField]string{
+	store.MemoOrderFieldPinned:        "memo.pinned",
+	store.MemoOrderFieldCreatedTs:     "memo.created_ts",
+	store.MemoOrderFieldUpdatedTs:     "memo.updated_ts",
+	store.MemoOrderFieldReactionCount: "(SELECT COUNT(*) FROM reaction WHERE reaction.content_id = 'memos/' || memo.uid)",
+	store.MemoOrderFieldTitle:         "SPLIT_PART(memo.content, CHR(10), 1)",
+}
+
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
 	fields := []string{"uid", "creator_id", "content", "visibility", "payload"}
 	payload := "{}"
@@ -24,6 +35,9 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		payload = string(payloadBytes)
 	}
 	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	if create.CreatedTs != 0 {
+		fields, args = append(fields, "created_ts"), append(args, create.CreatedTs)
+	}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -39,6 +53,129 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 }
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	where, args, err := d.buildFindMemoWhere(find)
+	if err != nil {
+		return nil, err
+	}
+	if find.ExcludeComments {
+		where = append(where, "memo_relation.related_memo_id IS NULL")
+	}
+	orders := store.GetMemoOrderBy(find)
+	// The id breaks the ties of the order fields, so the order is stable for the pagination.
+	idOperator, idOrder := "<", "DESC"
+	if !orders[len(orders)-1].Desc {
+		idOperator, idOrder = ">", "ASC"
+	}
+	if v := find.Cursor; v != nil {
+		// List the memos after the cursor, i.e. compare the order fields one by one.
+		condition := ""
+		for _, order := range orders {
+			column, operator := memoOrderFieldColumns[order.Field], "<"
+			if !order.Desc {
+				operator = ">"
+			}
+			condition += fmt.Sprintf("(%s %s %s OR (%s = %s AND ", column, operator, placeholder(len(args)+1), column, placeholder(len(args)+1))
+			args = append(args, v.GetValue(order.Field))
+		}
+		condition += fmt.Sprintf("memo.id %s %s", idOperator, placeholder(len(args)+1)) + strings.Repeat("))", len(orders))
+		where, args = append(where, condition), append(args, v.ID)
+	}
+
+	orderBy := []string{}
+	for _, order := range orders {
+		direction := "ASC"
+		if order.Desc {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, fmt.Sprintf("%s %s", memoOrderFieldColumns[order.Field], direction))
+	}
+	orderBy = append(orderBy, "memo.id "+idOrder)
+	fields := []string{
+		`memo.id AS id`,
+		`memo.uid AS uid`,
+		`memo.creator_id AS creator_id`,
+		`memo.created_ts AS created_ts`,
+		`memo.updated_ts AS updated_ts`,
+		`memo.row_status AS row_status`,
+		`memo.visibility AS visibility`,
+		`memo.pinned AS pinned`,
+		`memo.payload AS payload`,
+		`memo_relation.related_memo_id AS parent_id`,
+	}
+	if !find.ExcludeContent {
+		fields = append(fields, `memo.content AS content`)
+	}
+
+	query := `SELECT ` + strings.Join(fields, ", ") + `
+		FROM memo
+		LEFT JOIN memo_relation ON memo.id = memo_relation.memo_id AND memo_relation.type = 'COMMENT'
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY ` + strings.Join(orderBy, ", ")
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.Memo, 0)
+	for rows.Next() {
+		var memo store.Memo
+		var payloadBytes []byte
+		dests := []any{
+			&memo.ID,
+			&memo.UID,
+			&memo.CreatorID,
+			&memo.CreatedTs,
+			&memo.UpdatedTs,
+			&memo.RowStatus,
+			&memo.Visibility,
+			&memo.Pinned,
+			&payloadBytes,
+			&memo.ParentID,
+		}
+		if !find.ExcludeContent {
+			dests = append(dests, &memo.Content)
+		}
+		if err := rows.Scan(dests...); err != nil {
+			return nil, err
+		}
+		payload := &storepb.MemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		memo.Payload = payload
+		list = append(list, &memo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, error) {
+	list, err := d.ListMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	memo := list[0]
+	return memo, nil
+}
+
+// buildFindMemoWhere returns the conditions of the memos to find, except the exclusion of the comments and the cursor.
+func (d *DB) buildFindMemoWhere(find *store.FindMemo) ([]string, []any, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
 	if v := find.ID; v != nil {
@@ -47,6 +184,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UID; v != nil {
 		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo.id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -102,19 +246,32 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		if v.HasIncompleteTasks {
 			where = append(where, "(memo.payload->'property'->>'hasIncompleteTasks')::BOOLEAN IS TRUE")
 		}
+		if v.HasPublishTime {
+			where = append(where, "memo.payload->>'publishTime' IS NOT NULL")
+		}
+		if v.HasLocation {
+			where = append(where, memoHasLocation)
+		}
+		if v.DailyDate != nil {
+			where, args = append(where, "memo.payload->>'dailyDate' = "+placeholder(len(args)+1)), append(args, *v.DailyDate)
+		}
+		if v.HasReminders {
+			where = append(where, "memo.payload->'reminders' IS NOT NULL")
+		}
 	}
 	if v := find.Filter; v != nil {
 		// Parse filter string and return the parsed expression.
 		// The filter string should be a CEL expression.
-		parsedExpr, err := filter.Parse(*v, filter.MemoFilterCELAttributes...)
+		parsedExpr, err := filter.Parse(*v, filter.MemoInternalFilterCELAttributes...)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		convertCtx := filter.NewConvertContext()
+		convertCtx.DisplayWithUpdateTime = find.DisplayWithUpdateTime
 		convertCtx.ArgsOffset = len(args)
 		// ConvertExprToSQL converts the parsed expression to a SQL condition string.
 		if err := d.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		condition := convertCtx.Buffer.String()
 		if condition != "" {
@@ -122,108 +279,90 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			args = append(args, convertCtx.Args...)
 		}
 	}
-	if find.ExcludeComments {
-		where = append(where, "memo_relation.related_memo_id IS NULL")
-	}
+	return where, args, nil
+}
 
-	order := "DESC"
-	if find.OrderByTimeAsc {
-		order = "ASC"
-	}
-	orderBy := []string{}
-	if find.OrderByPinned {
-		orderBy = append(orderBy, "pinned DESC")
-	}
-	if find.OrderByUpdatedTs {
-		orderBy = append(orderBy, "updated_ts "+order)
-	} else {
-		orderBy = append(orderBy, "created_ts "+order)
-	}
-	fields := []string{
-		`memo.id AS id`,
-		`memo.uid AS uid`,
-		`memo.creator_id AS creator_id`,
-		`memo.created_ts AS created_ts`,
-		`memo.updated_ts AS updated_ts`,
-		`memo.row_status AS row_status`,
-		`memo.visibility AS visibility`,
-		`memo.pinned AS pinned`,
-		`memo.payload AS payload`,
-		`memo_relation.related_memo_id AS parent_id`,
+func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	stmt, args, err := buildUpdateMemoStmt(update)
+	if err != nil {
+		return err
 	}
-	if !find.ExcludeContent {
-		fields = append(fields, `memo.content AS content`)
+	if stmt == "" {
+		return nil
 	}
-
-	query := `SELECT ` + strings.Join(fields, ", ") + `
-		FROM memo
-		LEFT JOIN memo_relation ON memo.id = memo_relation.memo_id AND memo_relation.type = 'COMMENT'
-		WHERE ` + strings.Join(where, " AND ") + `
-		ORDER BY ` + strings.Join(orderBy, ", ")
-	if find.Limit != nil {
-		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
-		if find.Offset != nil {
-			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
-		}
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
 	}
+	return checkMemoUpdated(result, update)
+}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo, revisions []*store.MemoRevision) error {
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer rows.Close()
+	defer tx.Rollback()
 
-	list := make([]*store.Memo, 0)
-	for rows.Next() {
-		var memo store.Memo
-		var payloadBytes []byte
-		dests := []any{
-			&memo.ID,
-			&memo.UID,
-			&memo.CreatorID,
-			&memo.CreatedTs,
-			&memo.UpdatedTs,
-			&memo.RowStatus,
-			&memo.Visibility,
-			&memo.Pinned,
-			&payloadBytes,
-			&memo.ParentID,
+	for _, revision := range revisions {
+		stmt, args, err := buildCreateMemoRevisionStmt(revision)
+		if err != nil {
+			return err
 		}
-		if !find.ExcludeContent {
-			dests = append(dests, &memo.Content)
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
 		}
-		if err := rows.Scan(dests...); err != nil {
-			return nil, err
+	}
+	for _, update := range updates {
+		stmt, args, err := buildUpdateMemoStmt(update)
+		if err != nil {
+			return err
 		}
-		payload := &storepb.MemoPayload{}
-		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
-			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		if stmt == "" {
+			continue
+		}
+		result, err := tx.ExecContext(ctx, stmt, args...)
+		if err != nil {
+			return err
+		}
+		if err := checkMemoUpdated(result, update); err != nil {
+			return err
 		}
-		memo.Payload = payload
-		list = append(list, &memo)
 	}
+	return tx.Commit()
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+func (d *DB) UpdateMemoReminders(ctx context.Context, update *store.UpdateMemoReminders) error {
+	list := []string{}
+	for _, reminder := range update.Reminders {
+		reminderBytes, err := protojson.Marshal(reminder)
+		if err != nil {
+			return err
+		}
+		list = append(list, string(reminderBytes))
 	}
-
-	return list, nil
+	reminders := "[" + strings.Join(list, ",") + "]"
+	if _, err := d.db.ExecContext(ctx, "UPDATE memo SET payload = jsonb_set(payload, '{reminders}', $1::jsonb) WHERE id = $2", reminders, update.ID); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, error) {
-	list, err := d.ListMemos(ctx, find)
+func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
+	where, args := []string{"id = " + placeholder(1)}, []any{delete.ID}
+	stmt := `DELETE FROM memo WHERE ` + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "failed to delete memo")
 	}
-	if len(list) == 0 {
-		return nil, nil
+	if _, err := result.RowsAffected(); err != nil {
+		return err
 	}
-
-	memo := list[0]
-	return memo, nil
+	return nil
 }
 
-func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+// buildUpdateMemoStmt builds the statement to update a memo. It returns an empty statement if there is nothing to update.
+func buildUpdateMemoStmt(update *store.UpdateMemo) (string, []any, error) {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "uid = "+placeholder(len(args)+1)), append(args, *v)
@@ -249,31 +388,36 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
 	}
 	if len(set) == 0 {
-		return nil
+		return "", nil, nil
 	}
 
-	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
+	where := []string{"id = " + placeholder(len(args)+1)}
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
-		return err
+	if v := update.ExpectedUpdatedTs; v != nil {
+		where, args = append(where, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
 	}
-	return nil
+
+	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE ` + strings.Join(where, " AND ")
+	return stmt, args, nil
 }
 
-func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
-	where, args := []string{"id = " + placeholder(1)}, []any{delete.ID}
-	stmt := `DELETE FROM memo WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
-	if err != nil {
-		return errors.Wrap(err, "failed to delete memo")
+// checkMemoUpdated returns store.ErrMemoConflict if a conditional update doesn't apply to the memo.
+// The conditional updates always change the update time, so the affected rows are counted on all the drivers.
+func checkMemoUpdated(result sql.Result, update *store.UpdateMemo) error {
+	if update.ExpectedUpdatedTs == nil {
+		return nil
 	}
-	if _, err := result.RowsAffected(); err != nil {
+	affected, err := result.RowsAffected()
+	if err != nil {
 		return err
 	}
+	if affected == 0 {
+		return store.ErrMemoConflict
+	}
 	return nil
 }
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoACL(ctx context.Context, upsert *store.MemoACL) (*store.MemoACL, error) {
+	stmt := `
+		INSERT INTO memo_acl (
+			memo_id, user_id, permission
+		)
+		VALUES (` + placeholders(3) + `)
+		ON CONFLICT(memo_id, user_id) DO UPDATE
+		SET permission = EXCLUDED.permission
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.MemoID, upsert.UserID, upsert.Permission).Scan(
+		&upsert.ID,
+		&upsert.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListMemoACLs(ctx context.Context, find *store.FindMemoACL) ([]*store.MemoACL, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+
+	query := "SELECT id, memo_id, user_id, permission, created_ts FROM memo_acl WHERE " + strings.Join(where, " AND ") + " ORDER BY id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoACL{}
+	for rows.Next() {
+		acl := &store.MemoACL{}
+		if err := rows.Scan(
+			&acl.ID,
+			&acl.MemoID,
+			&acl.UserID,
+			&acl.Permission,
+			&acl.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, acl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoACL(ctx context.Context, delete *store.DeleteMemoACL) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	stmt := "DELETE FROM memo_acl WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
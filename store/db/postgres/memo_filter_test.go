@@ -40,6 +40,11 @@ func TestRestoreExprToSQL(t *testing.T) {
 			want:   "memo.visibility IN ($1,$2)",
 			args:   []any{"PUBLIC", "PRIVATE"},
 		},
+		{
+			filter: `id in [1, 2]`,
+			want:   "memo.id IN ($1,$2)",
+			args:   []any{int64(1), int64(2)},
+		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
 			want:   "(memo.payload->'tags' @> jsonb_build_array($1) OR memo.content ILIKE $2)",
@@ -62,18 +67,18 @@ func TestRestoreExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_task_list == true`,
-			want:   "(memo.payload->'property'->>'hasTaskList')::boolean = $1",
-			args:   []any{true},
+			want:   "(memo.payload->'property'->>'hasTaskList')::boolean IS TRUE",
+			args:   []any{},
 		},
 		{
 			filter: `has_task_list != false`,
-			want:   "(memo.payload->'property'->>'hasTaskList')::boolean != $1",
-			args:   []any{false},
+			want:   "(memo.payload->'property'->>'hasTaskList')::boolean IS TRUE",
+			args:   []any{},
 		},
 		{
 			filter: `has_task_list == false`,
-			want:   "(memo.payload->'property'->>'hasTaskList')::boolean = $1",
-			args:   []any{false},
+			want:   "NOT ((memo.payload->'property'->>'hasTaskList')::boolean IS TRUE)",
+			args:   []any{},
 		},
 		{
 			filter: `!has_task_list`,
@@ -92,9 +97,44 @@ func TestRestoreExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `created_ts > now() - 60 * 60 * 24`,
-			want:   "EXTRACT(EPOCH FROM memo.created_ts) > $1",
+			want:   "memo.created_ts > $1",
 			args:   []any{time.Now().Unix() - 60*60*24},
 		},
+		{
+			filter: `"work" in tags && tag != "life"`,
+			want:   "(memo.payload->'tags' @> jsonb_build_array($1) AND NOT (memo.payload->'tags' @> jsonb_build_array($2)))",
+			args:   []any{"work", "life"},
+		},
+		{
+			filter: `tag.startsWith("work/") || content.contains("work")`,
+			want:   "(EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE $1) OR memo.content ILIKE $2)",
+			args:   []any{"work/%", "%work%"},
+		},
+		{
+			filter: `has_link && !has_code`,
+			want:   "((memo.payload->'property'->>'hasLink')::boolean IS TRUE AND NOT ((memo.payload->'property'->>'hasCode')::boolean IS TRUE))",
+			args:   []any{},
+		},
+		{
+			filter: `row_status in ["NORMAL", "ARCHIVED"] && creator_id in [1]`,
+			want:   "(memo.row_status IN ($1,$2) AND memo.creator_id IN ($3))",
+			args:   []any{"NORMAL", "ARCHIVED", int64(1)},
+		},
+		{
+			filter: `display_time > now() - days(7)`,
+			want:   "memo.created_ts > $1",
+			args:   []any{time.Now().Unix() - 7*24*60*60},
+		},
+		{
+			filter: `within_radius(40.7, -74.0, 10)`,
+			want:   "(memo.payload->'location' IS NOT NULL AND 2 * 6371 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(COALESCE((memo.payload->'location'->>'latitude')::DOUBLE PRECISION, 0) - $1) / 2), 2) + COS(RADIANS($1)) * COS(RADIANS(COALESCE((memo.payload->'location'->>'latitude')::DOUBLE PRECISION, 0))) * POWER(SIN(RADIANS(COALESCE((memo.payload->'location'->>'longitude')::DOUBLE PRECISION, 0) - $2) / 2), 2)))) <= $3)",
+			args:   []any{40.7, -74.0, 10.0},
+		},
+		{
+			filter: `within_bbox(10, 170, 20.5, -170) && pinned`,
+			want:   "((memo.payload->'location' IS NOT NULL AND COALESCE((memo.payload->'location'->>'latitude')::DOUBLE PRECISION, 0) BETWEEN $1 AND $2 AND (COALESCE((memo.payload->'location'->>'longitude')::DOUBLE PRECISION, 0) >= $3 OR COALESCE((memo.payload->'location'->>'longitude')::DOUBLE PRECISION, 0) <= $4)) AND memo.pinned IS TRUE)",
+			args:   []any{10.0, 20.5, 170.0, -170.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRevision(ctx context.Context, create *store.MemoRevision) (*store.MemoRevision, error) {
+	stmt, args, err := buildCreateMemoRevisionStmt(create)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.db.QueryRowContext(ctx, stmt+" RETURNING id, created_ts", args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+// buildCreateMemoRevisionStmt builds the statement to insert a memo revision.
+func buildCreateMemoRevisionStmt(create *store.MemoRevision) (string, []any, error) {
+	payload := "{}"
+	if create.Payload != nil {
+		payloadBytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return "", nil, err
+		}
+		payload = string(payloadBytes)
+	}
+	fields := []string{"memo_id", "content", "visibility", "payload"}
+	args := []any{create.MemoID, create.Content, create.Visibility, payload}
+	return "INSERT INTO memo_revision (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ")", args, nil
+}
+
+func (d *DB) ListMemoRevisions(ctx context.Context, find *store.FindMemoRevision) ([]*store.MemoRevision, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+
+	query := "SELECT id, memo_id, created_ts, content, visibility, payload FROM memo_revision WHERE " + strings.Join(where, " AND ") + " ORDER BY id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRevision{}
+	for rows.Next() {
+		revision := &store.MemoRevision{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.MemoID,
+			&revision.CreatedTs,
+			&revision.Content,
+			&revision.Visibility,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.MemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		revision.Payload = payload
+		list = append(list, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoRevision(ctx context.Context, delete *store.DeleteMemoRevision) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	stmt := "DELETE FROM memo_revision WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SearchMemos(ctx context.Context, search *store.SearchMemo) ([]*store.MemoSearchResult, error) {
+	// The 'simple' configuration only splits the words by spaces and punctuation, so the terms in the scripts
+	// without spaces between the words, e.g. Chinese and Japanese, fall back to substring matching.
+	queryTerms, substringTerms := []string{}, []string{}
+	for _, term := range store.SplitMemoSearchQuery(search.Query) {
+		if isSpaceDelimitedTerm(term) {
+			queryTerms = append(queryTerms, term)
+		} else {
+			substringTerms = append(substringTerms, term)
+		}
+	}
+
+	where, args := []string{"TRUE"}, []any{}
+	from, rank, snippet := "FROM memo ", "0", "''"
+	if len(queryTerms) > 0 {
+		// The query is the first argument so that it can be referred to by the rank and the snippet.
+		where, args = []string{"memo.search_vector @@ query"}, []any{strings.Join(queryTerms, " ")}
+		from = "FROM memo CROSS JOIN plainto_tsquery('simple', $1) query "
+		rank = "ts_rank(memo.search_vector, query)"
+		// The headline only highlights the words of the query, the store builds the snippet of the other terms.
+		if len(substringTerms) == 0 {
+			snippetOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, FragmentDelimiter=%s, MaxFragments=1, MaxWords=32, MinWords=16", store.MemoSearchHighlightStart, store.MemoSearchHighlightEnd, store.MemoSearchSnippetEllipsis)
+			snippet = "ts_headline('simple', memo.content, query, '" + snippetOptions + "')"
+		}
+	}
+	for _, term := range substringTerms {
+		where, args = append(where, "memo.content ILIKE "+placeholder(len(args)+1)), append(args, "%"+escapeLikePattern(term)+"%")
+	}
+
+	if v := search.CreatorID; v != nil {
+		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := search.RowStatus; v != nil {
+		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := search.VisibilityList; len(v) != 0 {
+		holders := []string{}
+		for _, visibility := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", ")))
+	}
+	if v := search.Filter; v != nil {
+		parsedExpr, err := filter.Parse(*v, filter.MemoInternalFilterCELAttributes...)
+		if err != nil {
+			return nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		convertCtx.DisplayWithUpdateTime = search.DisplayWithUpdateTime
+		convertCtx.ArgsOffset = len(args)
+		if err := d.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, err
+		}
+		condition := convertCtx.Buffer.String()
+		if condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
+	if search.ExcludeComments {
+		where = append(where, "memo_relation.related_memo_id IS NULL")
+	}
+
+	query := "SELECT memo.id, " + rank + ", " + snippet + " " +
+		from +
+		"LEFT JOIN memo_relation ON memo.id = memo_relation.memo_id AND memo_relation.type = 'COMMENT' " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"ORDER BY 2 DESC, memo.created_ts DESC"
+	if search.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *search.Limit)
+		if search.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *search.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoSearchResult{}
+	for rows.Next() {
+		result := &store.MemoSearchResult{}
+		if err := rows.Scan(&result.MemoID, &result.Rank, &result.Snippet); err != nil {
+			return nil, err
+		}
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// isSpaceDelimitedTerm returns whether the term is written in scripts that delimit the words by spaces.
+func isSpaceDelimitedTerm(term string) bool {
+	for _, r := range term {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar) {
+			return false
+		}
+	}
+	return true
+}
+
+// escapeLikePattern escapes the wildcards of the LIKE patterns, the backslash is the default escape character.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSpaceDelimitedTerm(t *testing.T) {
+	tests := []struct {
+		term string
+		want bool
+	}{
+		{term: "fox", want: true},
+		{term: "café", want: true},
+		{term: "привет", want: true},
+		{term: "天气", want: false},
+		{term: "memos天气", want: false},
+		{term: "ひらがな", want: false},
+		{term: "カタカナ", want: false},
+		{term: "한국어", want: false},
+		{term: "ภาษาไทย", want: false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, isSpaceDelimitedTerm(tt.term), tt.term)
+	}
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	require.Equal(t, `100\%`, escapeLikePattern("100%"))
+	require.Equal(t, `snake\_case`, escapeLikePattern("snake_case"))
+	require.Equal(t, `C:\\path`, escapeLikePattern(`C:\path`))
+}
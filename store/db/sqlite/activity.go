@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -41,6 +42,13 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.ID != nil {
 		where, args = append(where, "`id` = ?"), append(args, *find.ID)
 	}
+	if len(find.IDList) != 0 {
+		placeholder := []string{}
+		for _, id := range find.IDList {
+			placeholder, args = append(placeholder, "?"), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`id` IN (%s)", strings.Join(placeholder, ",")))
+	}
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type.String())
 	}
@@ -11,6 +11,24 @@ import (
 	"github.com/usememos/memos/plugin/filter"
 )
 
+const (
+	memoHasLocation       = "JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL"
+	memoLocationLatitude  = "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0)"
+	memoLocationLongitude = "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0)"
+	memoTagCondition      = "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?"
+)
+
+// memoFilterColumns maps the filter identifiers to the columns of the memo table.
+var memoFilterColumns = map[string]string{
+	"id":         "`memo`.`id`",
+	"creator_id": "`memo`.`creator_id`",
+	"created_ts": "`memo`.`created_ts`",
+	"updated_ts": "`memo`.`updated_ts`",
+	"visibility": "`memo`.`visibility`",
+	"row_status": "`memo`.`row_status`",
+	"content":    "`memo`.`content`",
+}
+
 func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) error {
 	if v, ok := expr.ExprKind.(*exprv1.Expr_CallExpr); ok {
 		switch v.CallExpr.Function {
@@ -58,7 +76,7 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 			if err != nil {
 				return err
 			}
-			if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "has_task_list"}, identifier) {
+			if !slices.Contains([]string{"id", "creator_id", "created_ts", "updated_ts", "display_time", "visibility", "row_status", "content", "tag", "pinned", "has_link", "has_task_list", "has_code", "has_incomplete_tasks"}, identifier) {
 				return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
 			}
 			value, err := filter.GetExprValue(v.CallExpr.Args[1])
@@ -79,25 +97,27 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 				operator = "<="
 			case "_>=_":
 				operator = ">="
+			default:
+				return errors.Errorf("unsupported operator %s", v.CallExpr.Function)
 			}
 
-			if identifier == "created_ts" || identifier == "updated_ts" {
+			if identifier == "created_ts" || identifier == "updated_ts" || identifier == "display_time" {
 				valueInt, ok := value.(int64)
 				if !ok {
 					return errors.New("invalid integer timestamp value")
 				}
 
-				var factor string
-				if identifier == "created_ts" {
-					factor = "`memo`.`created_ts`"
-				} else if identifier == "updated_ts" {
-					factor = "`memo`.`updated_ts`"
+				if identifier == "display_time" {
+					identifier = "created_ts"
+					if ctx.DisplayWithUpdateTime {
+						identifier = "updated_ts"
+					}
 				}
-				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", factor, operator)); err != nil {
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", memoFilterColumns[identifier], operator)); err != nil {
 					return err
 				}
 				ctx.Args = append(ctx.Args, valueInt)
-			} else if identifier == "visibility" || identifier == "content" {
+			} else if identifier == "visibility" || identifier == "row_status" || identifier == "content" {
 				if operator != "=" && operator != "!=" {
 					return errors.Errorf("invalid operator for %s", v.CallExpr.Function)
 				}
@@ -106,17 +126,11 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 					return errors.New("invalid string value")
 				}
 
-				var factor string
-				if identifier == "visibility" {
-					factor = "`memo`.`visibility`"
-				} else if identifier == "content" {
-					factor = "`memo`.`content`"
-				}
-				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", factor, operator)); err != nil {
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", memoFilterColumns[identifier], operator)); err != nil {
 					return err
 				}
 				ctx.Args = append(ctx.Args, valueStr)
-			} else if identifier == "creator_id" {
+			} else if identifier == "id" || identifier == "creator_id" {
 				if operator != "=" && operator != "!=" {
 					return errors.Errorf("invalid operator for %s", v.CallExpr.Function)
 				}
@@ -125,28 +139,45 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 					return errors.New("invalid int value")
 				}
 
-				var factor string
-				if identifier == "creator_id" {
-					factor = "`memo`.`creator_id`"
-				}
-				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", factor, operator)); err != nil {
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", memoFilterColumns[identifier], operator)); err != nil {
 					return err
 				}
 				ctx.Args = append(ctx.Args, valueInt)
-			} else if identifier == "has_task_list" {
+			} else if identifier == "tag" {
+				if operator != "=" && operator != "!=" {
+					return errors.Errorf("invalid operator for %s", v.CallExpr.Function)
+				}
+				valueStr, ok := value.(string)
+				if !ok {
+					return errors.New("invalid string value for tag")
+				}
+
+				condition := memoTagCondition
+				if operator == "!=" {
+					condition = fmt.Sprintf("NOT (%s)", condition)
+				}
+				if _, err := ctx.Buffer.WriteString(condition); err != nil {
+					return err
+				}
+				ctx.Args = append(ctx.Args, fmt.Sprintf(`%%"%s"%%`, valueStr))
+			} else {
 				if operator != "=" && operator != "!=" {
 					return errors.Errorf("invalid operator for %s", v.CallExpr.Function)
 				}
 				valueBool, ok := value.(bool)
 				if !ok {
-					return errors.New("invalid boolean value for has_task_list")
+					return errors.Errorf("invalid boolean value for %s", identifier)
 				}
-				// In SQLite JSON boolean values are 1 for true and 0 for false
-				compareValue := 0
-				if valueBool {
-					compareValue = 1
+
+				condition, err := getBoolIdentifierCondition(identifier)
+				if err != nil {
+					return err
 				}
-				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') %s %d", operator, compareValue)); err != nil {
+				// The false values are omitted from the payload, so they are matched by negating the true condition.
+				if valueBool != (operator == "=") {
+					condition = fmt.Sprintf("NOT (%s)", condition)
+				}
+				if _, err := ctx.Buffer.WriteString(condition); err != nil {
 					return err
 				}
 			}
@@ -154,11 +185,27 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 			if len(v.CallExpr.Args) != 2 {
 				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
 			}
+			// Handle the membership of a tag in the tags, e.g. `"work" in tags`.
+			if identifier, err := filter.GetIdentExprName(v.CallExpr.Args[1]); err == nil {
+				if identifier != "tags" {
+					return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
+				}
+				value, err := filter.GetConstValue(v.CallExpr.Args[0])
+				if err != nil {
+					return err
+				}
+				if _, err := ctx.Buffer.WriteString(memoTagCondition); err != nil {
+					return err
+				}
+				ctx.Args = append(ctx.Args, fmt.Sprintf(`%%"%s"%%`, value))
+				return nil
+			}
+
 			identifier, err := filter.GetIdentExprName(v.CallExpr.Args[0])
 			if err != nil {
 				return err
 			}
-			if !slices.Contains([]string{"tag", "visibility"}, identifier) {
+			if !slices.Contains([]string{"id", "creator_id", "tag", "visibility", "row_status"}, identifier) {
 				return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
 			}
 
@@ -174,7 +221,7 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 				subcodition := []string{}
 				args := []any{}
 				for _, v := range values {
-					subcodition, args = append(subcodition, "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?"), append(args, fmt.Sprintf(`%%"%s"%%`, v))
+					subcodition, args = append(subcodition, memoTagCondition), append(args, fmt.Sprintf(`%%"%s"%%`, v))
 				}
 				if len(subcodition) == 1 {
 					if _, err := ctx.Buffer.WriteString(subcodition[0]); err != nil {
@@ -186,12 +233,12 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 					}
 				}
 				ctx.Args = append(ctx.Args, args...)
-			} else if identifier == "visibility" {
+			} else {
 				placeholder := []string{}
 				for range values {
 					placeholder = append(placeholder, "?")
 				}
-				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ","))); err != nil {
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s IN (%s)", memoFilterColumns[identifier], strings.Join(placeholder, ","))); err != nil {
 					return err
 				}
 				ctx.Args = append(ctx.Args, values...)
@@ -215,22 +262,87 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 				return err
 			}
 			ctx.Args = append(ctx.Args, fmt.Sprintf("%%%s%%", arg))
-		}
-	} else if v, ok := expr.ExprKind.(*exprv1.Expr_IdentExpr); ok {
-		identifier := v.IdentExpr.GetName()
-		if !slices.Contains([]string{"pinned", "has_task_list"}, identifier) {
-			return errors.Errorf("invalid identifier %s", identifier)
-		}
-		if identifier == "pinned" {
-			if _, err := ctx.Buffer.WriteString("`memo`.`pinned` IS TRUE"); err != nil {
+		case "startsWith":
+			if len(v.CallExpr.Args) != 1 {
+				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			identifier, err := filter.GetIdentExprName(v.CallExpr.Target)
+			if err != nil {
+				return err
+			}
+			if identifier != "tag" {
+				return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return err
+			}
+			// Any of the tags starts with the prefix, e.g. `tag.startsWith("work/")` matches the nested tags.
+			if _, err := ctx.Buffer.WriteString(memoTagCondition); err != nil {
+				return err
+			}
+			ctx.Args = append(ctx.Args, fmt.Sprintf(`%%"%s%%`, arg))
+		case "granted_to":
+			if len(v.CallExpr.Args) != 1 {
+				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			userID, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
 				return err
 			}
-		} else if identifier == "has_task_list" {
-			// Handle has_task_list as a standalone boolean identifier
-			if _, err := ctx.Buffer.WriteString("JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE"); err != nil {
+			// The grants are joined, so the granted memos don't need to be listed beforehand.
+			if _, err := ctx.Buffer.WriteString("EXISTS (SELECT 1 FROM `memo_acl` WHERE `memo_acl`.`memo_id` = `memo`.`id` AND `memo_acl`.`user_id` = ?)"); err != nil {
 				return err
 			}
+			ctx.Args = append(ctx.Args, userID)
+		case "within_radius":
+			latitude, longitude, radius, err := filter.GetWithinRadiusArgs(v.CallExpr.Args)
+			if err != nil {
+				return errors.Wrapf(err, "invalid arguments for %s", v.CallExpr.Function)
+			}
+			// The haversine formula, MIN guards against rounding errors out of the domain of ASIN.
+			distance := fmt.Sprintf("2 * %g * ASIN(MIN(1, SQRT(POWER(SIN(RADIANS(%s - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(%s)) * POWER(SIN(RADIANS(%s - ?) / 2), 2))))", filter.EarthRadiusKm, memoLocationLatitude, memoLocationLatitude, memoLocationLongitude)
+			if _, err := ctx.Buffer.WriteString(fmt.Sprintf("(%s AND %s <= ?)", memoHasLocation, distance)); err != nil {
+				return err
+			}
+			ctx.Args = append(ctx.Args, latitude, latitude, longitude, radius)
+		case "within_bbox":
+			minLatitude, minLongitude, maxLatitude, maxLongitude, err := filter.GetWithinBoundingBoxArgs(v.CallExpr.Args)
+			if err != nil {
+				return errors.Wrapf(err, "invalid arguments for %s", v.CallExpr.Function)
+			}
+			longitudeCondition := fmt.Sprintf("%s BETWEEN ? AND ?", memoLocationLongitude)
+			if minLongitude > maxLongitude {
+				// The box crosses the antimeridian.
+				longitudeCondition = fmt.Sprintf("(%s >= ? OR %s <= ?)", memoLocationLongitude, memoLocationLongitude)
+			}
+			if _, err := ctx.Buffer.WriteString(fmt.Sprintf("(%s AND %s BETWEEN ? AND ? AND %s)", memoHasLocation, memoLocationLatitude, longitudeCondition)); err != nil {
+				return err
+			}
+			ctx.Args = append(ctx.Args, minLatitude, maxLatitude, minLongitude, maxLongitude)
+		default:
+			return errors.Errorf("unsupported function %s", v.CallExpr.Function)
+		}
+	} else if v, ok := expr.ExprKind.(*exprv1.Expr_IdentExpr); ok {
+		condition, err := getBoolIdentifierCondition(v.IdentExpr.GetName())
+		if err != nil {
+			return err
+		}
+		if _, err := ctx.Buffer.WriteString(condition); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// getBoolIdentifierCondition returns the condition matching the memos where the boolean identifier is true.
+func getBoolIdentifierCondition(identifier string) (string, error) {
+	if identifier == "pinned" {
+		return "`memo`.`pinned` IS TRUE", nil
+	}
+	key, ok := filter.MemoPayloadPropertyKeys[identifier]
+	if !ok {
+		return "", errors.Errorf("invalid identifier %s", identifier)
+	}
+	return fmt.Sprintf("JSON_EXTRACT(`memo`.`payload`, '$.property.%s') IS TRUE", key), nil
+}
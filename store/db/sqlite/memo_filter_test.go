@@ -70,6 +70,11 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "(`memo`.`creator_id` = ? OR `memo`.`visibility` IN (?,?))",
 			args:   []any{int64(101), "PUBLIC", "PRIVATE"},
 		},
+		{
+			filter: `creator_id == 101 || id in [1, 2]`,
+			want:   "(`memo`.`creator_id` = ? OR `memo`.`id` IN (?,?))",
+			args:   []any{int64(101), int64(1), int64(2)},
+		},
 		{
 			filter: `has_task_list`,
 			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE",
@@ -77,17 +82,17 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_task_list == true`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = 1",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list != false`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') != 0",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list == false`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = 0",
+			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE)",
 			args:   []any{},
 		},
 		{
@@ -110,6 +115,56 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "`memo`.`created_ts` > ?",
 			args:   []any{time.Now().Unix() - 60*60*24},
 		},
+		{
+			filter: `"work" in tags`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?",
+			args:   []any{`%"work"%`},
+		},
+		{
+			filter: `tag == "work" || tag != "life"`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR NOT (JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
+			args:   []any{`%"work"%`, `%"life"%`},
+		},
+		{
+			filter: `tag.startsWith("work/")`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?",
+			args:   []any{`%"work/%`},
+		},
+		{
+			filter: `has_link && !has_code || has_incomplete_tasks == true`,
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') IS TRUE AND NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') IS TRUE)) OR JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE)",
+			args:   []any{},
+		},
+		{
+			filter: `pinned == false`,
+			want:   "NOT (`memo`.`pinned` IS TRUE)",
+			args:   []any{},
+		},
+		{
+			filter: `row_status == "ARCHIVED" || row_status in ["NORMAL"]`,
+			want:   "(`memo`.`row_status` = ? OR `memo`.`row_status` IN (?))",
+			args:   []any{"ARCHIVED", "NORMAL"},
+		},
+		{
+			filter: `display_time >= date("2025-01-02") && display_time < date("2025-01-02") + days(1)`,
+			want:   "(`memo`.`created_ts` >= ? AND `memo`.`created_ts` < ?)",
+			args:   []any{int64(1735776000), int64(1735862400)},
+		},
+		{
+			filter: `created_ts >= today()`,
+			want:   "`memo`.`created_ts` >= ?",
+			args:   []any{time.Now().UTC().Truncate(24 * time.Hour).Unix()},
+		},
+		{
+			filter: `within_radius(40.7, -74.0, 10)`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND 2 * 6371 * ASIN(MIN(1, SQRT(POWER(SIN(RADIANS(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0))) * POWER(SIN(RADIANS(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) - ?) / 2), 2)))) <= ?)",
+			args:   []any{40.7, 40.7, -74.0, 10.0},
+		},
+		{
+			filter: `within_bbox(10, 170, 20.5, -170)`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) BETWEEN ? AND ? AND (COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) >= ? OR COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) <= ?))",
+			args:   []any{10.0, 20.5, 170.0, -170.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -123,3 +178,29 @@ func TestConvertExprToSQL(t *testing.T) {
 		require.Equal(t, tt.args, convertCtx.Args)
 	}
 }
+
+func TestConvertDisplayTimeToSQL(t *testing.T) {
+	db := &DB{}
+	parsedExpr, err := filter.Parse(`display_time > 100`, filter.MemoFilterCELAttributes...)
+	require.NoError(t, err)
+	convertCtx := filter.NewConvertContext()
+	convertCtx.DisplayWithUpdateTime = true
+	err = db.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr())
+	require.NoError(t, err)
+	require.Equal(t, "`memo`.`updated_ts` > ?", convertCtx.Buffer.String())
+	require.Equal(t, []any{int64(100)}, convertCtx.Args)
+}
+
+func TestConvertGrantedToToSQL(t *testing.T) {
+	db := &DB{}
+	// The function is only available in the filters built by the server.
+	_, err := filter.Parse(`granted_to(1)`, filter.MemoFilterCELAttributes...)
+	require.Error(t, err)
+	parsedExpr, err := filter.Parse(`granted_to(1)`, filter.MemoInternalFilterCELAttributes...)
+	require.NoError(t, err)
+	convertCtx := filter.NewConvertContext()
+	err = db.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr())
+	require.NoError(t, err)
+	require.Equal(t, "EXISTS (SELECT 1 FROM `memo_acl` WHERE `memo_acl`.`memo_id` = `memo`.`id` AND `memo_acl`.`user_id` = ?)", convertCtx.Buffer.String())
+	require.Equal(t, []any{int64(1)}, convertCtx.Args)
+}
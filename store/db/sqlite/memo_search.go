@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/store"
+)
+
+// minTrigramTermLength is the shortest term that can be matched by the trigram index.
+const minTrigramTermLength = 3
+
+func (d *DB) SearchMemos(ctx context.Context, search *store.SearchMemo) ([]*store.MemoSearchResult, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	// Terms shorter than a trigram can't use the index and fall back to substring matching.
+	matchTerms := []string{}
+	for _, term := range store.SplitMemoSearchQuery(search.Query) {
+		if utf8.RuneCountInString(term) >= minTrigramTermLength {
+			matchTerms = append(matchTerms, fmt.Sprintf(`"%s"`, strings.ReplaceAll(term, `"`, `""`)))
+		} else {
+			where, args = append(where, "`memo`.`content` LIKE ? ESCAPE '\\'"), append(args, fmt.Sprintf("%%%s%%", escapeLikePattern(term)))
+		}
+	}
+	rank, snippet := "0", "''"
+	if len(matchTerms) > 0 {
+		where, args = append([]string{"`memo_fts` MATCH ?"}, where...), append([]any{strings.Join(matchTerms, " ")}, args...)
+		// bm25 returns lower values for better matches.
+		rank = "-bm25(`memo_fts`)"
+		snippet = fmt.Sprintf("snippet(`memo_fts`, 0, '%s', '%s', '%s', 16)", store.MemoSearchHighlightStart, store.MemoSearchHighlightEnd, store.MemoSearchSnippetEllipsis)
+	}
+
+	if v := search.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := search.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+	if v := search.VisibilityList; len(v) != 0 {
+		placeholder := []string{}
+		for _, visibility := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if v := search.Filter; v != nil {
+		parsedExpr, err := filter.Parse(*v, filter.MemoInternalFilterCELAttributes...)
+		if err != nil {
+			return nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		convertCtx.DisplayWithUpdateTime = search.DisplayWithUpdateTime
+		if err := d.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, err
+		}
+		condition := convertCtx.Buffer.String()
+		if condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
+	if search.ExcludeComments {
+		where = append(where, "`memo_relation`.`related_memo_id` IS NULL")
+	}
+
+	query := "SELECT `memo`.`id`, " + rank + ", " + snippet + " FROM `memo_fts` " +
+		"JOIN `memo` ON `memo`.`id` = `memo_fts`.`rowid` " +
+		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = \"COMMENT\" " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"ORDER BY 2 DESC, `memo`.`created_ts` DESC"
+	if search.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *search.Limit)
+		if search.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *search.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoSearchResult{}
+	for rows.Next() {
+		result := &store.MemoSearchResult{}
+		if err := rows.Scan(&result.MemoID, &result.Rank, &result.Snippet); err != nil {
+			return nil, err
+		}
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// escapeLikePattern escapes the wildcards of the LIKE patterns with the backslash, which must be set as the escape character.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
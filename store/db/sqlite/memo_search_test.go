@@ -0,0 +1,13 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeLikePattern(t *testing.T) {
+	require.Equal(t, `100\%`, escapeLikePattern("100%"))
+	require.Equal(t, `snake\_case`, escapeLikePattern("snake_case"))
+	require.Equal(t, `C:\\path`, escapeLikePattern(`C:\path`))
+}
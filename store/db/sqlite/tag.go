@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertTag(ctx context.Context, upsert *store.Tag) (*store.Tag, error) {
+	stmt := `
+		INSERT INTO tag (
+			creator_id, name, color, description, icon, pinned
+		)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(creator_id, name) DO UPDATE
+		SET
+			color = EXCLUDED.color,
+			description = EXCLUDED.description,
+			icon = EXCLUDED.icon,
+			pinned = EXCLUDED.pinned,
+			updated_ts = strftime('%s', 'now')
+		RETURNING id, created_ts, updated_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.CreatorID, upsert.Name, upsert.Color, upsert.Description, upsert.Icon, upsert.Pinned).Scan(
+		&upsert.ID,
+		&upsert.CreatedTs,
+		&upsert.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	query := "SELECT `id`, `creator_id`, `name`, `color`, `description`, `icon`, `pinned`, `created_ts`, `updated_ts` FROM `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY `name` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Tag{}
+	for rows.Next() {
+		tag := &store.Tag{}
+		if err := rows.Scan(
+			&tag.ID,
+			&tag.CreatorID,
+			&tag.Name,
+			&tag.Color,
+			&tag.Description,
+			&tag.Icon,
+			&tag.Pinned,
+			&tag.CreatedTs,
+			&tag.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *delete.CreatorID)
+	}
+	if delete.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *delete.Name)
+	}
+	stmt := "DELETE FROM `tag` WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -32,9 +32,34 @@ type Driver interface {
 	// Memo model related methods.
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
+	SearchMemos(ctx context.Context, search *SearchMemo) ([]*MemoSearchResult, error)
+	ListMemoTasks(ctx context.Context, find *FindMemoTask) ([]*MemoTask, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
+	// UpdateMemos creates the revisions and applies the updates in a single transaction.
+	UpdateMemos(ctx context.Context, updates []*UpdateMemo, revisions []*MemoRevision) error
+	UpdateMemoReminders(ctx context.Context, update *UpdateMemoReminders) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+
+	// MemoShare model related methods.
+	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
+
+	// MemoACL model related methods.
+	UpsertMemoACL(ctx context.Context, upsert *MemoACL) (*MemoACL, error)
+	ListMemoACLs(ctx context.Context, find *FindMemoACL) ([]*MemoACL, error)
+	DeleteMemoACL(ctx context.Context, delete *DeleteMemoACL) error
+
+	// Tag model related methods.
+	UpsertTag(ctx context.Context, upsert *Tag) (*Tag, error)
+	ListTags(ctx context.Context, find *FindTag) ([]*Tag, error)
+	DeleteTag(ctx context.Context, delete *DeleteTag) error
+
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
@@ -64,6 +89,7 @@ type Driver interface {
 	// Inbox model related methods.
 	CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error)
 	ListInboxes(ctx context.Context, find *FindInbox) ([]*Inbox, error)
+	CountInboxes(ctx context.Context, find *FindInbox) (int, error)
 	UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error)
 	DeleteInbox(ctx context.Context, delete *DeleteInbox) error
 
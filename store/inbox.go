@@ -2,6 +2,9 @@ package store
 
 import (
 	"context"
+	"slices"
+
+	"github.com/pkg/errors"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -28,8 +31,10 @@ type Inbox struct {
 }
 
 type UpdateInbox struct {
-	ID     int32
-	Status InboxStatus
+	ID int32
+	// Status is left unchanged if it's empty.
+	Status  InboxStatus
+	Message *storepb.InboxMessage
 }
 
 type FindInbox struct {
@@ -37,6 +42,10 @@ type FindInbox struct {
 	SenderID   *int32
 	ReceiverID *int32
 	Status     *InboxStatus
+	// CreatedTsAfter matches the inboxes created after the time, exclusive.
+	CreatedTsAfter *int64
+	// MessageTypes matches the inboxes of any of the message types.
+	MessageTypes []storepb.InboxMessage_Type
 
 	// Pagination
 	Limit  *int
@@ -51,10 +60,28 @@ func (s *Store) CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error)
 	return s.driver.CreateInbox(ctx, create)
 }
 
+// SendInboxMessage creates the inbox unless the receiver has muted the type of its message.
+// It returns nil if the message is muted.
+func (s *Store) SendInboxMessage(ctx context.Context, create *Inbox) (*Inbox, error) {
+	notificationsSetting, err := s.GetUserNotificationsSetting(ctx, create.ReceiverID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user notifications setting")
+	}
+	if slices.Contains(notificationsSetting.MutedTypes, create.Message.GetType()) {
+		return nil, nil
+	}
+	return s.CreateInbox(ctx, create)
+}
+
 func (s *Store) ListInboxes(ctx context.Context, find *FindInbox) ([]*Inbox, error) {
 	return s.driver.ListInboxes(ctx, find)
 }
 
+// CountInboxes returns the number of the inboxes matching the find, the pagination is ignored.
+func (s *Store) CountInboxes(ctx context.Context, find *FindInbox) (int, error) {
+	return s.driver.CountInboxes(ctx, find)
+}
+
 func (s *Store) UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error) {
 	return s.driver.UpdateInbox(ctx, update)
 }
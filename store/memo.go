@@ -2,7 +2,12 @@ package store
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/usememos/memos/internal/base"
 
@@ -56,8 +61,9 @@ type Memo struct {
 }
 
 type FindMemo struct {
-	ID  *int32
-	UID *string
+	ID     *int32
+	UID    *string
+	IDList []int32
 
 	// Standard fields
 	RowStatus       *RowStatus
@@ -75,15 +81,102 @@ type FindMemo struct {
 	ExcludeContent  bool
 	ExcludeComments bool
 	Filter          *string
+	// DisplayWithUpdateTime makes `display_time` in the filter refer to the updated time, it's set from the workspace setting.
+	DisplayWithUpdateTime bool
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor lists the memos after the cursor in the order, it's used instead of the offset for the keyset pagination.
+	Cursor *MemoCursor
 
 	// Ordering
 	OrderByUpdatedTs bool
 	OrderByPinned    bool
 	OrderByTimeAsc   bool
+	// OrderBy overrides the ordering fields above if set.
+	OrderBy []*MemoOrder
+}
+
+// MemoOrderField is a field the memos can be ordered by.
+type MemoOrderField string
+
+const (
+	MemoOrderFieldPinned        MemoOrderField = "pinned"
+	MemoOrderFieldCreatedTs     MemoOrderField = "created_ts"
+	MemoOrderFieldUpdatedTs     MemoOrderField = "updated_ts"
+	MemoOrderFieldReactionCount MemoOrderField = "reaction_count"
+	// MemoOrderFieldTitle orders the memos by the first line of their content.
+	MemoOrderFieldTitle MemoOrderField = "title"
+)
+
+type MemoOrder struct {
+	Field MemoOrderField
+	Desc  bool
+}
+
+// MemoCursor is the position of a memo in the order of the listed memos.
+// Only the values of the fields the memos are ordered by are used.
+type MemoCursor struct {
+	ID            int32
+	Pinned        bool
+	CreatedTs     int64
+	UpdatedTs     int64
+	ReactionCount int32
+	Title         string
+}
+
+// GetValue returns the value of the order field at the cursor.
+func (c *MemoCursor) GetValue(field MemoOrderField) any {
+	switch field {
+	case MemoOrderFieldPinned:
+		return c.Pinned
+	case MemoOrderFieldCreatedTs:
+		return c.CreatedTs
+	case MemoOrderFieldUpdatedTs:
+		return c.UpdatedTs
+	case MemoOrderFieldReactionCount:
+		return c.ReactionCount
+	case MemoOrderFieldTitle:
+		return c.Title
+	default:
+		return nil
+	}
+}
+
+// GetMemoOrderBy returns the order of the memos to find, the memo id breaks the ties after it.
+func GetMemoOrderBy(find *FindMemo) []*MemoOrder {
+	if len(find.OrderBy) != 0 {
+		return find.OrderBy
+	}
+	orderBy := []*MemoOrder{}
+	if find.OrderByPinned {
+		orderBy = append(orderBy, &MemoOrder{Field: MemoOrderFieldPinned, Desc: true})
+	}
+	timeOrder := &MemoOrder{Field: MemoOrderFieldCreatedTs, Desc: !find.OrderByTimeAsc}
+	if find.OrderByUpdatedTs {
+		timeOrder.Field = MemoOrderFieldUpdatedTs
+	}
+	return append(orderBy, timeOrder)
+}
+
+// FormatMemoOrderBy returns the order of the memos as a string, e.g. "pinned desc, created_ts desc".
+func FormatMemoOrderBy(orderBy []*MemoOrder) string {
+	orders := []string{}
+	for _, order := range orderBy {
+		direction := "asc"
+		if order.Desc {
+			direction = "desc"
+		}
+		orders = append(orders, fmt.Sprintf("%s %s", order.Field, direction))
+	}
+	return strings.Join(orders, ", ")
+}
+
+// GetMemoTitle returns the title of the memo content, i.e. its first line.
+func GetMemoTitle(content string) string {
+	title, _, _ := strings.Cut(content, "\n")
+	return title
 }
 
 type FindMemoPayload struct {
@@ -93,6 +186,11 @@ type FindMemoPayload struct {
 	HasTaskList        bool
 	HasCode            bool
 	HasIncompleteTasks bool
+	HasPublishTime     bool
+	HasLocation        bool
+	HasReminders       bool
+	// DailyDate finds the daily memo of the calendar day, e.g. "2025-01-02".
+	DailyDate *string
 }
 
 type UpdateMemo struct {
@@ -105,6 +203,18 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	// ExpectedUpdatedTs makes the update conditional, it's only applied if the memo is still updated at the time.
+	// ErrMemoConflict is returned otherwise.
+	ExpectedUpdatedTs *int64
+}
+
+// ErrMemoConflict is returned if a conditional update finds the memo modified since it was read.
+var ErrMemoConflict = errors.New("memo is modified since it was read")
+
+// UpdateMemoReminders replaces the reminders in the payload of a memo, e.g. to record their delivery.
+type UpdateMemoReminders struct {
+	ID        int32
+	Reminders []*storepb.MemoPayload_Reminder
 }
 
 type DeleteMemo struct {
@@ -119,6 +229,13 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
+	if find.Filter != nil {
+		workspaceMemoRelatedSetting, err := s.GetWorkspaceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, err
+		}
+		find.DisplayWithUpdateTime = workspaceMemoRelatedSetting.DisplayWithUpdateTime
+	}
 	return s.driver.ListMemos(ctx, find)
 }
 
@@ -139,7 +256,101 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	advanceMemoUpdatedTs(update)
+	revision, err := s.buildMemoRevision(ctx, update)
+	if err != nil {
+		return err
+	}
+	if revision == nil {
+		return s.driver.UpdateMemo(ctx, update)
+	}
+	// The revision is only kept if the update is applied.
+	return s.driver.UpdateMemos(ctx, []*UpdateMemo{update}, []*MemoRevision{revision})
+}
+
+// UpdateMemos applies all the updates in a single transaction, with the revisions of the changed memos.
+func (s *Store) UpdateMemos(ctx context.Context, updates []*UpdateMemo) error {
+	for _, update := range updates {
+		if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+		advanceMemoUpdatedTs(update)
+	}
+	revisions := []*MemoRevision{}
+	for _, update := range updates {
+		revision, err := s.buildMemoRevision(ctx, update)
+		if err != nil {
+			return err
+		}
+		if revision != nil {
+			revisions = append(revisions, revision)
+		}
+	}
+	return s.driver.UpdateMemos(ctx, updates, revisions)
+}
+
+// advanceMemoUpdatedTs moves the update time of a conditional update past the expected one,
+// so the concurrent updates expecting the same time are rejected even within the same second.
+func advanceMemoUpdatedTs(update *UpdateMemo) {
+	if update.ExpectedUpdatedTs == nil {
+		return
+	}
+	expected := *update.ExpectedUpdatedTs
+	if update.UpdatedTs == nil {
+		updatedTs := max(time.Now().Unix(), expected+1)
+		update.UpdatedTs = &updatedTs
+	} else if *update.UpdatedTs == expected {
+		updatedTs := expected + 1
+		update.UpdatedTs = &updatedTs
+	}
+}
+
+// buildMemoRevision returns the snapshot of the current memo to keep as a revision
+// if the update changes its content, visibility or payload, or nil otherwise.
+func (s *Store) buildMemoRevision(ctx context.Context, update *UpdateMemo) (*MemoRevision, error) {
+	if update.Content == nil && update.Visibility == nil && update.Payload == nil {
+		return nil, nil
+	}
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if memo == nil {
+		return nil, nil
+	}
+	changed := (update.Content != nil && *update.Content != memo.Content) ||
+		(update.Visibility != nil && *update.Visibility != memo.Visibility) ||
+		(update.Payload != nil && !equalMemoPayloadInputs(update.Payload, memo.Payload))
+	if !changed {
+		return nil, nil
+	}
+	return &MemoRevision{
+		MemoID:     memo.ID,
+		Content:    memo.Content,
+		Visibility: memo.Visibility,
+		Payload:    memo.Payload,
+	}, nil
+}
+
+// equalMemoPayloadInputs returns whether the payloads are equal except the fields derived from the content.
+// The derived fields only change with the content, or when the payloads are rebuilt to index a new field.
+func equalMemoPayloadInputs(a, b *storepb.MemoPayload) bool {
+	inputs := []*storepb.MemoPayload{}
+	for _, payload := range []*storepb.MemoPayload{a, b} {
+		input := &storepb.MemoPayload{}
+		if payload != nil {
+			input = proto.Clone(payload).(*storepb.MemoPayload)
+		}
+		input.Tags, input.Property, input.Tasks = nil, nil, nil
+		inputs = append(inputs, input)
+	}
+	return proto.Equal(inputs[0], inputs[1])
+}
+
+// UpdateMemoReminders only changes the reminders, so the rest of the memo edited meanwhile is kept.
+// Neither the update time is changed nor a revision is kept, as delivering the reminders doesn't change the memo.
+func (s *Store) UpdateMemoReminders(ctx context.Context, update *UpdateMemoReminders) error {
+	return s.driver.UpdateMemoReminders(ctx, update)
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
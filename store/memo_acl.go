@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoPermission is the permission granted to a user on a memo.
+type MemoPermission string
+
+const (
+	// MemoPermissionRead allows the user to read the memo and its resources.
+	MemoPermissionRead MemoPermission = "READ"
+	// MemoPermissionComment allows the user to read and comment on the memo.
+	MemoPermissionComment MemoPermission = "COMMENT"
+)
+
+func (p MemoPermission) String() string {
+	return string(p)
+}
+
+// MemoACL grants a user access to a memo regardless of its visibility.
+type MemoACL struct {
+	ID         int32
+	MemoID     int32
+	UserID     int32
+	Permission MemoPermission
+	CreatedTs  int64
+}
+
+type FindMemoACL struct {
+	ID     *int32
+	MemoID *int32
+	UserID *int32
+}
+
+type DeleteMemoACL struct {
+	ID     *int32
+	MemoID *int32
+}
+
+// UpsertMemoACL creates a grant or updates the permission of the existing grant of the same memo and user.
+func (s *Store) UpsertMemoACL(ctx context.Context, upsert *MemoACL) (*MemoACL, error) {
+	return s.driver.UpsertMemoACL(ctx, upsert)
+}
+
+func (s *Store) ListMemoACLs(ctx context.Context, find *FindMemoACL) ([]*MemoACL, error) {
+	return s.driver.ListMemoACLs(ctx, find)
+}
+
+func (s *Store) GetMemoACL(ctx context.Context, find *FindMemoACL) (*MemoACL, error) {
+	list, err := s.ListMemoACLs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoACL(ctx context.Context, delete *DeleteMemoACL) error {
+	return s.driver.DeleteMemoACL(ctx, delete)
+}
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MemoSearchHighlightStart and MemoSearchHighlightEnd wrap the matched terms in search snippets.
+	MemoSearchHighlightStart = "<mark>"
+	MemoSearchHighlightEnd   = "</mark>"
+	// MemoSearchSnippetEllipsis marks the content omitted from search snippets.
+	MemoSearchSnippetEllipsis = "…"
+
+	// memoSearchSnippetRadius is the number of characters kept around the first match in search snippets.
+	memoSearchSnippetRadius = 32
+)
+
+type SearchMemo struct {
+	// Query is the search query, the memos must contain all of its whitespace separated terms.
+	Query string
+
+	// Standard fields
+	RowStatus *RowStatus
+	CreatorID *int32
+
+	// Domain specific fields
+	VisibilityList  []Visibility
+	ExcludeComments bool
+	Filter          *string
+	// DisplayWithUpdateTime makes `display_time` in the filter refer to the updated time, it's set from the workspace setting.
+	DisplayWithUpdateTime bool
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// MemoSearchResult is a memo matched by a search, ordered by descending rank.
+type MemoSearchResult struct {
+	MemoID int32
+	// Rank is the relevance of the memo to the query, higher is more relevant.
+	Rank float64
+	// Snippet is an excerpt of the memo content with the matched terms highlighted.
+	Snippet string
+
+	// Composed fields
+	Memo *Memo
+}
+
+// SplitMemoSearchQuery splits the search query into its terms.
+func SplitMemoSearchQuery(query string) []string {
+	terms := []string{}
+	for _, term := range strings.Fields(query) {
+		term = strings.Trim(term, `"`)
+		if term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func (s *Store) SearchMemos(ctx context.Context, search *SearchMemo) ([]*MemoSearchResult, error) {
+	terms := SplitMemoSearchQuery(search.Query)
+	if len(terms) == 0 {
+		return nil, errors.New("empty search query")
+	}
+	if search.Filter != nil {
+		workspaceMemoRelatedSetting, err := s.GetWorkspaceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, err
+		}
+		search.DisplayWithUpdateTime = workspaceMemoRelatedSetting.DisplayWithUpdateTime
+	}
+	results, err := s.driver.SearchMemos(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return results, nil
+	}
+
+	memoIDs := []int32{}
+	for _, result := range results {
+		memoIDs = append(memoIDs, result.MemoID)
+	}
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		IDList: memoIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	memoMap := map[int32]*Memo{}
+	for _, memo := range memos {
+		memoMap[memo.ID] = memo
+	}
+	list := []*MemoSearchResult{}
+	for _, result := range results {
+		memo, ok := memoMap[result.MemoID]
+		if !ok {
+			continue
+		}
+		result.Memo = memo
+		// Not every driver can build snippets natively.
+		if result.Snippet == "" {
+			result.Snippet = buildMemoSearchSnippet(memo.Content, terms)
+		}
+		list = append(list, result)
+	}
+	return list, nil
+}
+
+// buildMemoSearchSnippet returns an excerpt of the content around the first matched term,
+// with all matched terms in the excerpt highlighted.
+func buildMemoSearchSnippet(content string, terms []string) string {
+	runes := []rune(content)
+	lower := toLowerRunes(runes)
+	type span struct {
+		start, end int
+	}
+	spans := []span{}
+	for _, term := range terms {
+		termRunes := toLowerRunes([]rune(term))
+		if len(termRunes) == 0 {
+			continue
+		}
+		for i := 0; i+len(termRunes) <= len(lower); {
+			if slices.Equal(lower[i:i+len(termRunes)], termRunes) {
+				spans = append(spans, span{start: i, end: i + len(termRunes)})
+				i += len(termRunes)
+			} else {
+				i++
+			}
+		}
+	}
+
+	start, end := 0, min(len(runes), memoSearchSnippetRadius*2)
+	if len(spans) > 0 {
+		sort.Slice(spans, func(i, j int) bool {
+			return spans[i].start < spans[j].start
+		})
+		start = max(spans[0].start-memoSearchSnippetRadius, 0)
+		end = min(spans[0].end+memoSearchSnippetRadius, len(runes))
+	}
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString(MemoSearchSnippetEllipsis)
+	}
+	cursor := start
+	for _, span := range spans {
+		// Skip the spans overlapping with the previous one or outside of the excerpt.
+		if span.start < cursor || span.end > end {
+			continue
+		}
+		builder.WriteString(string(runes[cursor:span.start]))
+		builder.WriteString(MemoSearchHighlightStart)
+		builder.WriteString(string(runes[span.start:span.end]))
+		builder.WriteString(MemoSearchHighlightEnd)
+		cursor = span.end
+	}
+	builder.WriteString(string(runes[cursor:end]))
+	if end < len(runes) {
+		builder.WriteString(MemoSearchSnippetEllipsis)
+	}
+	return builder.String()
+}
+
+func toLowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoShare is a link that grants anonymous read access to a single memo and its resources.
+type MemoShare struct {
+	ID int32
+	// UID is the unique identifier embedded in the signed share token.
+	UID       string
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+	// ExpiresTs is the time when the share expires. Nil means it never expires.
+	ExpiresTs *int64
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	UID    *string
+	MemoID *int32
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	return s.driver.CreateMemoShare(ctx, create)
+}
+
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShare(ctx, delete)
+}
@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MemoTask is a task list item of a memo, the tasks are indexed in the memo payload.
+type MemoTask struct {
+	MemoID  int32
+	MemoUID string
+	// Index is the position of the task in the memo in document order.
+	Index int
+	Task  *storepb.MemoPayload_Task
+}
+
+type FindMemoTask struct {
+	// MemoFind finds the memos of the tasks, the tasks are ordered as the memos.
+	// Its pagination is ignored.
+	MemoFind *FindMemo
+
+	Complete *bool
+	// DueAfter and DueBefore are inclusive dates in the format of "2006-01-02".
+	// The tasks without a due date are excluded if either is set.
+	DueAfter  *string
+	DueBefore *string
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+func (s *Store) ListMemoTasks(ctx context.Context, find *FindMemoTask) ([]*MemoTask, error) {
+	if find.MemoFind == nil {
+		find.MemoFind = &FindMemo{}
+	}
+	if find.MemoFind.Filter != nil {
+		workspaceMemoRelatedSetting, err := s.GetWorkspaceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, err
+		}
+		find.MemoFind.DisplayWithUpdateTime = workspaceMemoRelatedSetting.DisplayWithUpdateTime
+	}
+	return s.driver.ListMemoTasks(ctx, find)
+}
@@ -0,0 +1,10 @@
+CREATE TABLE `memo_acl` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `permission` VARCHAR(256) NOT NULL DEFAULT 'READ',
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`memo_id`,`user_id`)
+);
+
+CREATE INDEX `idx_memo_acl_user_id` ON `memo_acl` (`user_id`);
@@ -0,0 +1,3 @@
+-- Full-text search index of memo content.
+-- The ngram parser supports text without word boundaries, e.g. CJK.
+ALTER TABLE `memo` ADD FULLTEXT INDEX `idx_memo_content_fulltext` (`content`) WITH PARSER ngram;
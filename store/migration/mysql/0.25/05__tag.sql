@@ -0,0 +1,12 @@
+CREATE TABLE `tag` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `color` VARCHAR(256) NOT NULL DEFAULT '',
+  `description` TEXT NOT NULL,
+  `icon` VARCHAR(256) NOT NULL DEFAULT '',
+  `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`creator_id`,`name`)
+);
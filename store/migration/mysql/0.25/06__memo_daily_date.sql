@@ -0,0 +1,2 @@
+-- A user has at most one daily memo of a day.
+CREATE UNIQUE INDEX `idx_memo_daily_date` ON `memo` (`creator_id`, (CAST(JSON_UNQUOTE(JSON_EXTRACT(`payload`, '$.dailyDate')) AS CHAR(10))));
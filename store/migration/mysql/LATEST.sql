@@ -45,9 +45,12 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  FULLTEXT INDEX `idx_memo_content_fulltext` (`content`) WITH PARSER ngram
 );
 
+CREATE UNIQUE INDEX `idx_memo_daily_date` ON `memo` (`creator_id`, (CAST(JSON_UNQUOTE(JSON_EXTRACT(`payload`, '$.dailyDate')) AS CHAR(10))));
+
 -- memo_organizer
 CREATE TABLE `memo_organizer` (
   `memo_id` INT NOT NULL,
@@ -130,3 +133,53 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `payload` JSON NOT NULL
+);
+
+CREATE INDEX `idx_memo_revision_memo_id` ON `memo_revision` (`memo_id`);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `expires_ts` TIMESTAMP NULL DEFAULT NULL
+);
+
+CREATE INDEX `idx_memo_share_memo_id` ON `memo_share` (`memo_id`);
+
+-- memo_acl
+CREATE TABLE `memo_acl` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `permission` VARCHAR(256) NOT NULL DEFAULT 'READ',
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`memo_id`,`user_id`)
+);
+
+CREATE INDEX `idx_memo_acl_user_id` ON `memo_acl` (`user_id`);
+
+-- tag
+CREATE TABLE `tag` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `color` VARCHAR(256) NOT NULL DEFAULT '',
+  `description` TEXT NOT NULL,
+  `icon` VARCHAR(256) NOT NULL DEFAULT '',
+  `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`creator_id`,`name`)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
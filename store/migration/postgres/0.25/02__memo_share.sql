@@ -0,0 +1,10 @@
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
@@ -0,0 +1,10 @@
+CREATE TABLE memo_acl (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  permission TEXT NOT NULL DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_acl_user_id ON memo_acl (user_id);
@@ -0,0 +1,12 @@
+CREATE TABLE tag (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  color TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  icon TEXT NOT NULL DEFAULT '',
+  pinned BOOLEAN NOT NULL DEFAULT FALSE,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(creator_id, name)
+);
@@ -45,9 +45,14 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   pinned BOOLEAN NOT NULL DEFAULT FALSE,
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED
 );
 
+CREATE INDEX idx_memo_search_vector ON memo USING GIN (search_vector);
+
+CREATE UNIQUE INDEX idx_memo_daily_date ON memo (creator_id, (payload->>'dailyDate'));
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -130,3 +135,53 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
+
+-- memo_acl
+CREATE TABLE memo_acl (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  permission TEXT NOT NULL DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_acl_user_id ON memo_acl (user_id);
+
+-- tag
+CREATE TABLE tag (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  color TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  icon TEXT NOT NULL DEFAULT '',
+  pinned BOOLEAN NOT NULL DEFAULT FALSE,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(creator_id, name)
+);
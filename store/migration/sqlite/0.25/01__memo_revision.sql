@@ -0,0 +1,10 @@
+CREATE TABLE memo_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
@@ -0,0 +1,10 @@
+CREATE TABLE memo_acl (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  permission TEXT NOT NULL CHECK (permission IN ('READ', 'COMMENT')) DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_acl_user_id ON memo_acl (user_id);
@@ -0,0 +1,23 @@
+-- Full-text search index of memo content.
+-- The trigram tokenizer supports substring matching of text without word boundaries, e.g. CJK.
+CREATE VIRTUAL TABLE memo_fts USING fts5(
+  content,
+  content='memo',
+  content_rowid='id',
+  tokenize='trigram'
+);
+
+INSERT INTO memo_fts (memo_fts) VALUES ('rebuild');
+
+CREATE TRIGGER memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
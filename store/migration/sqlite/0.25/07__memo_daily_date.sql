@@ -0,0 +1,2 @@
+-- A user has at most one daily memo of a day.
+CREATE UNIQUE INDEX idx_memo_daily_date ON memo (creator_id, JSON_EXTRACT(payload, '$.dailyDate'));
@@ -44,7 +44,7 @@ CREATE TABLE memo (
   creator_id INTEGER NOT NULL,
   created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
   updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
-  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED', 'DELETED')) DEFAULT 'NORMAL',
   content TEXT NOT NULL DEFAULT '',
   visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
   pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
@@ -53,6 +53,29 @@ CREATE TABLE memo (
 
 CREATE INDEX idx_memo_creator_id ON memo (creator_id);
 
+CREATE UNIQUE INDEX idx_memo_daily_date ON memo (creator_id, JSON_EXTRACT(payload, '$.dailyDate'));
+
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5(
+  content,
+  content='memo',
+  content_rowid='id',
+  tokenize='trigram'
+);
+
+CREATE TRIGGER memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -168,3 +191,53 @@ CREATE TABLE chat_message (
 
 CREATE INDEX idx_chat_message_session_id ON chat_message (session_id);
 CREATE INDEX idx_chat_message_created_ts ON chat_message (created_ts);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  expires_ts BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
+
+-- memo_acl
+CREATE TABLE memo_acl (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  permission TEXT NOT NULL CHECK (permission IN ('READ', 'COMMENT')) DEFAULT 'READ',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_acl_user_id ON memo_acl (user_id);
+
+-- tag
+CREATE TABLE tag (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  color TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  icon TEXT NOT NULL DEFAULT '',
+  pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(creator_id, name)
+);
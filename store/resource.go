@@ -123,8 +123,9 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 			if !filepath.IsAbs(p) {
 				p = filepath.Join(s.profile.Data, p)
 			}
+			// A file already removed by an earlier attempt doesn't keep the row from being deleted.
 			err := os.Remove(p)
-			if err != nil {
+			if err != nil && !os.IsNotExist(err) {
 				return errors.Wrap(err, "failed to delete local file")
 			}
 			return nil
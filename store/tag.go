@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+)
+
+// Tag holds the attributes of a tag of the memos of a user.
+// The tags themselves live in the memo payload, so a tag may exist without a row.
+type Tag struct {
+	ID          int32
+	CreatorID   int32
+	Name        string
+	Color       string
+	Description string
+	Icon        string
+	Pinned      bool
+	CreatedTs   int64
+	UpdatedTs   int64
+}
+
+type FindTag struct {
+	ID        *int32
+	CreatorID *int32
+	Name      *string
+}
+
+type DeleteTag struct {
+	ID        *int32
+	CreatorID *int32
+	Name      *string
+}
+
+// UpsertTag creates a tag or updates the attributes of the existing tag of the same creator and name.
+func (s *Store) UpsertTag(ctx context.Context, upsert *Tag) (*Tag, error) {
+	return s.driver.UpsertTag(ctx, upsert)
+}
+
+func (s *Store) ListTags(ctx context.Context, find *FindTag) ([]*Tag, error) {
+	return s.driver.ListTags(ctx, find)
+}
+
+func (s *Store) GetTag(ctx context.Context, find *FindTag) (*Tag, error) {
+	list, err := s.ListTags(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteTag(ctx context.Context, delete *DeleteTag) error {
+	return s.driver.DeleteTag(ctx, delete)
+}
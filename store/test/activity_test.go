@@ -32,3 +32,37 @@ func TestActivityStore(t *testing.T) {
 	require.Equal(t, activity, activities[0])
 	ts.Close()
 }
+
+func TestActivityStoreMemoMention(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityTypeMemoMention,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoMention: &storepb.ActivityMemoMentionPayload{
+				MemoId: 1,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	activityType := store.ActivityTypeMemoMention
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: &activityType,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(activities))
+	require.Equal(t, int32(1), activities[0].Payload.GetMemoMention().GetMemoId())
+	ts.Close()
+}
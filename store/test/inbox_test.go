@@ -41,6 +41,16 @@ func TestInboxStore(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, updatedInbox)
 	require.Equal(t, store.ARCHIVED, updatedInbox.Status)
+	updatedInbox, err = ts.UpdateInbox(ctx, &store.UpdateInbox{
+		ID: inbox.ID,
+		Message: &storepb.InboxMessage{
+			Type:      storepb.InboxMessage_MEMO_COMMENT,
+			EmailedTs: 1700000000,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ARCHIVED, updatedInbox.Status)
+	require.Equal(t, int64(1700000000), updatedInbox.Message.EmailedTs)
 	err = ts.DeleteInbox(ctx, &store.DeleteInbox{
 		ID: inbox.ID,
 	})
@@ -52,3 +62,104 @@ func TestInboxStore(t *testing.T) {
 	require.Equal(t, 0, len(inboxes))
 	ts.Close()
 }
+
+func TestInboxStoreSendMutedMessage(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_NOTIFICATIONS,
+		Value: &storepb.UserSetting_Notifications{
+			Notifications: &storepb.NotificationsUserSetting{
+				MutedTypes:  []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_REACTION},
+				DailyDigest: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	notificationsSetting, err := ts.GetUserNotificationsSetting(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_REACTION}, notificationsSetting.MutedTypes)
+	require.True(t, notificationsSetting.DailyDigest)
+
+	const systemBotID int32 = 0
+	inbox, err := ts.SendInboxMessage(ctx, &store.Inbox{
+		SenderID:   systemBotID,
+		ReceiverID: user.ID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type: storepb.InboxMessage_MEMO_REACTION,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, inbox)
+	inbox, err = ts.SendInboxMessage(ctx, &store.Inbox{
+		SenderID:   systemBotID,
+		ReceiverID: user.ID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type: storepb.InboxMessage_MEMO_REFERENCE,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, inbox)
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	require.Equal(t, storepb.InboxMessage_MEMO_REFERENCE, inboxes[0].Message.Type)
+	ts.Close()
+}
+
+func TestInboxStoreCount(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	const systemBotID int32 = 0
+	for _, messageType := range []storepb.InboxMessage_Type{
+		storepb.InboxMessage_MEMO_COMMENT,
+		storepb.InboxMessage_MEMO_COMMENT,
+		storepb.InboxMessage_SHORTCUT_MATCH,
+		storepb.InboxMessage_TYPE_UNSPECIFIED,
+	} {
+		_, err := ts.CreateInbox(ctx, &store.Inbox{
+			SenderID:   systemBotID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: messageType,
+			},
+		})
+		require.NoError(t, err)
+	}
+	count, err := ts.CountInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+	count, err = ts.CountInboxes(ctx, &store.FindInbox{
+		ReceiverID:   &user.ID,
+		MessageTypes: []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_SHORTCUT_MATCH},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:   &user.ID,
+		MessageTypes: []storepb.InboxMessage_Type{storepb.InboxMessage_SHORTCUT_MATCH},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	require.Equal(t, storepb.InboxMessage_SHORTCUT_MATCH, inboxes[0].Message.Type)
+	createdTsAfter := inboxes[0].CreatedTs
+	count, err = ts.CountInboxes(ctx, &store.FindInbox{
+		ReceiverID:     &user.ID,
+		CreatedTsAfter: &createdTsAfter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	ts.Close()
+}
@@ -0,0 +1,108 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoACLStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	reader, err := ts.CreateUser(ctx, &store.User{
+		Username: "reader",
+		Role:     store.RoleUser,
+		Email:    "reader@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "granted-memo",
+		CreatorID:  user.ID,
+		Content:    "private content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	acl, err := ts.UpsertMemoACL(ctx, &store.MemoACL{
+		MemoID:     memo.ID,
+		UserID:     reader.ID,
+		Permission: store.MemoPermissionRead,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.MemoPermissionRead, acl.Permission)
+
+	// Granting again to the same user updates the existing grant.
+	updated, err := ts.UpsertMemoACL(ctx, &store.MemoACL{
+		MemoID:     memo.ID,
+		UserID:     reader.ID,
+		Permission: store.MemoPermissionComment,
+	})
+	require.NoError(t, err)
+	require.Equal(t, acl.ID, updated.ID)
+	found, err := ts.GetMemoACL(ctx, &store.FindMemoACL{MemoID: &memo.ID, UserID: &reader.ID})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, store.MemoPermissionComment, found.Permission)
+	acls, err := ts.ListMemoACLs(ctx, &store.FindMemoACL{UserID: &reader.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(acls))
+
+	err = ts.DeleteMemoACL(ctx, &store.DeleteMemoACL{MemoID: &memo.ID})
+	require.NoError(t, err)
+	acls, err = ts.ListMemoACLs(ctx, &store.FindMemoACL{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(acls))
+	ts.Close()
+}
+
+func TestMemoListByGrant(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	reader, err := ts.CreateUser(ctx, &store.User{
+		Username: "reader",
+		Role:     store.RoleUser,
+		Email:    "reader@test.com",
+	})
+	require.NoError(t, err)
+	granted, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "granted-memo",
+		CreatorID:  user.ID,
+		Content:    "granted content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "private content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoACL(ctx, &store.MemoACL{
+		MemoID:     granted.ID,
+		UserID:     reader.ID,
+		Permission: store.MemoPermissionRead,
+	})
+	require.NoError(t, err)
+
+	filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || granted_to(%d)`, reader.ID, reader.ID)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, granted.ID, memos[0].ID)
+
+	// The memos of the owner are not granted to the owner.
+	filter = fmt.Sprintf("granted_to(%d)", user.ID)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memos))
+	ts.Close()
+}
@@ -0,0 +1,207 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// TestMemoFilter runs the same filters against the driver of the testing store,
+// so the filters behave the same with all the drivers.
+func TestMemoFilter(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	archived, pinned, private := store.Archived, true, store.Private
+	memos := []struct {
+		uid       string
+		content   string
+		payload   *storepb.MemoPayload
+		update    *store.UpdateMemo
+		createdTs int64
+		updatedTs int64
+	}{
+		{
+			uid:     "work",
+			content: "meeting notes https://usememos.com",
+			payload: &storepb.MemoPayload{
+				Tags:     []string{"work"},
+				Property: &storepb.MemoPayload_Property{HasLink: true},
+			},
+			update: &store.UpdateMemo{Pinned: &pinned},
+			// 2025-01-02 12:00:00 UTC, updated on 2025-01-05.
+			createdTs: 1735819200,
+			updatedTs: 1736078400,
+		},
+		{
+			uid:     "work-project",
+			content: "code review",
+			payload: &storepb.MemoPayload{
+				Tags:     []string{"work/project"},
+				Property: &storepb.MemoPayload_Property{HasCode: true},
+			},
+			createdTs: 1735732800,
+			updatedTs: 1735732800,
+		},
+		{
+			uid:     "life",
+			content: "- [ ] groceries",
+			payload: &storepb.MemoPayload{
+				Tags:     []string{"life", "todo"},
+				Property: &storepb.MemoPayload_Property{HasTaskList: true, HasIncompleteTasks: true},
+			},
+			update:    &store.UpdateMemo{Visibility: &private},
+			createdTs: 1735732800,
+			updatedTs: 1735732800,
+		},
+		{
+			uid:     "archived",
+			content: "old notes",
+			payload: &storepb.MemoPayload{
+				Tags: []string{"work"},
+			},
+			update:    &store.UpdateMemo{RowStatus: &archived},
+			createdTs: 1735732800,
+			updatedTs: 1735732800,
+		},
+		{
+			uid:     "untagged",
+			content: "hello world",
+			// 2024-12-31 12:00:00 UTC.
+			createdTs: 1735646400,
+			updatedTs: 1735646400,
+		},
+	}
+	for _, m := range memos {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        m.uid,
+			CreatorID:  user.ID,
+			Content:    m.content,
+			Visibility: store.Public,
+			Payload:    m.payload,
+		})
+		require.NoError(t, err)
+		update := m.update
+		if update == nil {
+			update = &store.UpdateMemo{}
+		}
+		update.ID, update.CreatedTs, update.UpdatedTs = memo.ID, &m.createdTs, &m.updatedTs
+		require.NoError(t, ts.UpdateMemo(ctx, update))
+	}
+
+	listMemoUIDs := func(filter string) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{
+			filter: `"work" in tags`,
+			want:   []string{"work", "archived"},
+		},
+		{
+			filter: `tag == "work"`,
+			want:   []string{"work", "archived"},
+		},
+		{
+			filter: `tag in ["work", "life"]`,
+			want:   []string{"work", "life", "archived"},
+		},
+		{
+			filter: `tag.startsWith("work")`,
+			want:   []string{"work", "work-project", "archived"},
+		},
+		{
+			filter: `tag.startsWith("work/")`,
+			want:   []string{"work-project"},
+		},
+		{
+			filter: `content.contains("review")`,
+			want:   []string{"work-project"},
+		},
+		{
+			filter: `has_link`,
+			want:   []string{"work"},
+		},
+		{
+			filter: `has_code == true`,
+			want:   []string{"work-project"},
+		},
+		{
+			filter: `has_code == false`,
+			want:   []string{"work", "life", "archived", "untagged"},
+		},
+		{
+			filter: `has_task_list && has_incomplete_tasks`,
+			want:   []string{"life"},
+		},
+		{
+			filter: `pinned`,
+			want:   []string{"work"},
+		},
+		{
+			filter: `!pinned && "work" in tags`,
+			want:   []string{"archived"},
+		},
+		{
+			filter: `row_status == "ARCHIVED"`,
+			want:   []string{"archived"},
+		},
+		{
+			filter: `row_status == "NORMAL" && visibility == "PRIVATE"`,
+			want:   []string{"life"},
+		},
+		{
+			filter: `display_time >= date("2025-01-02") && display_time < date("2025-01-02") + days(1)`,
+			want:   []string{"work"},
+		},
+		{
+			filter: `created_ts < date("2025-01-01")`,
+			want:   []string{"untagged"},
+		},
+		{
+			filter: `display_time > today() - days(1)`,
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		require.ElementsMatch(t, tt.want, listMemoUIDs(tt.filter), tt.filter)
+	}
+
+	// The unsupported functions are rejected, instead of being dropped from the condition.
+	for _, unsupported := range []string{
+		`content.endsWith("notes")`,
+		`content.matches("hello.*")`,
+		`has_link == true || content.endsWith("notes")`,
+		`!content.endsWith("notes")`,
+	} {
+		_, err := ts.ListMemos(ctx, &store.FindMemo{Filter: &unsupported})
+		require.Error(t, err, unsupported)
+	}
+
+	// The display time follows the updated time with the workspace setting.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_MEMO_RELATED,
+		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{
+			MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{
+				DisplayWithUpdateTime: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"work"}, listMemoUIDs(`display_time >= date("2025-01-05")`))
+	ts.Close()
+}
@@ -58,5 +58,19 @@ func TestMemoRelationStore(t *testing.T) {
 	}
 	_, err = ts.UpsertMemoRelation(ctx, commentRelation)
 	require.NoError(t, err)
+
+	// The relations between the memos of the lists.
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList:        []int32{memo.ID, relatedMemo.ID},
+		RelatedMemoIDList: []int32{memo.ID, relatedMemo.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	require.Equal(t, relatedMemo.ID, relations[0].RelatedMemoID)
+	relations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList: []int32{relatedMemo.ID, commentMemo.ID},
+	})
+	require.NoError(t, err)
+	require.Empty(t, relations)
 	ts.Close()
 }
@@ -0,0 +1,118 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "main-memo",
+		CreatorID:  user.ID,
+		Content:    "first version",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// Updating fields other than content, visibility or payload doesn't create a revision.
+	pinned := true
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:     memo.ID,
+		Pinned: &pinned,
+	})
+	require.NoError(t, err)
+	// Updating with unchanged content doesn't create a revision either.
+	unchangedContent := "first version"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &unchangedContent,
+	})
+	require.NoError(t, err)
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+
+	secondContent := "second version"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &secondContent,
+	})
+	require.NoError(t, err)
+	privateVisibility := store.Private
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Visibility: &privateVisibility,
+	})
+	require.NoError(t, err)
+
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(revisions))
+	// Revisions are listed from the newest to the oldest.
+	require.Equal(t, "second version", revisions[0].Content)
+	require.Equal(t, store.Public, revisions[0].Visibility)
+	require.Equal(t, "first version", revisions[1].Content)
+
+	revision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &revisions[1].ID})
+	require.NoError(t, err)
+	require.Equal(t, memo.ID, revision.MemoID)
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+	ts.Close()
+}
+
+func TestMemoRevisionSkipsDerivedPayload(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "derived-memo",
+		CreatorID:  user.ID,
+		Content:    "- [ ] task #tag",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// Rebuilding the fields derived from the content keeps no revision.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID: memo.ID,
+		Payload: &storepb.MemoPayload{
+			Tags:     []string{"tag"},
+			Property: &storepb.MemoPayload_Property{HasTaskList: true},
+			Tasks:    []*storepb.MemoPayload_Task{{Content: "task #tag", Tags: []string{"tag"}}},
+		},
+	})
+	require.NoError(t, err)
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+
+	// The other fields of the payload are edited by the users.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID: memo.ID,
+		Payload: &storepb.MemoPayload{
+			Tags:     []string{"tag"},
+			Location: &storepb.MemoPayload_Location{Placeholder: "Home"},
+		},
+	})
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(revisions))
+	ts.Close()
+}
@@ -0,0 +1,93 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestSearchMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for uid, content := range map[string]string{
+		"english":  "The quick brown fox jumps over the lazy dog",
+		"chinese":  "今天天气很好，我们去公园散步",
+		"private":  "A private fox",
+		"unrelate": "Nothing to see here",
+	} {
+		visibility := store.Public
+		if uid == "private" {
+			visibility = store.Private
+		}
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: visibility,
+		})
+		require.NoError(t, err)
+	}
+
+	results, err := ts.SearchMemos(ctx, &store.SearchMemo{
+		Query: "fox",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(results))
+	for _, result := range results {
+		require.Contains(t, result.Snippet, store.MemoSearchHighlightStart+"fox"+store.MemoSearchHighlightEnd)
+	}
+
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{
+		Query:          "FOX lazy",
+		VisibilityList: []store.Visibility{store.Public},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, "english", results[0].Memo.UID)
+
+	// Terms shorter than the index tokens still match.
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{
+		Query: "天气",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, "chinese", results[0].Memo.UID)
+	require.Contains(t, results[0].Snippet, store.MemoSearchHighlightStart+"天气"+store.MemoSearchHighlightEnd)
+	// The terms of the scripts without spaces between the words match in any position.
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{
+		Query: "公园 天气",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, "chinese", results[0].Memo.UID)
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{
+		Query: "fox 天气",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+
+	// The index is kept in sync with the memo content.
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{UID: &[]string{"unrelate"}[0]})
+	require.NoError(t, err)
+	content := "Now there is a fox here"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content})
+	require.NoError(t, err)
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{
+		Query: "fox",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(results))
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{
+		Query: "fox",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(results))
+	ts.Close()
+}
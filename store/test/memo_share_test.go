@@ -0,0 +1,62 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "shared-memo",
+		CreatorID:  user.ID,
+		Content:    "private content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	share, err := ts.CreateMemoShare(ctx, &store.MemoShare{
+		UID:       "share-without-expiry",
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, share.ExpiresTs)
+	expiresTs := time.Now().Add(time.Hour).Unix()
+	expiringShare, err := ts.CreateMemoShare(ctx, &store.MemoShare{
+		UID:       "share-with-expiry",
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		ExpiresTs: &expiresTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expiresTs, *expiringShare.ExpiresTs)
+
+	shareUID := "share-with-expiry"
+	found, err := ts.GetMemoShare(ctx, &store.FindMemoShare{UID: &shareUID})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, memo.ID, found.MemoID)
+	require.Equal(t, expiresTs, *found.ExpiresTs)
+
+	err = ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &share.ID})
+	require.NoError(t, err)
+	shares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shares))
+
+	err = ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	shares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shares))
+	ts.Close()
+}
@@ -0,0 +1,103 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestListMemoTasks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	older, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "older-memo",
+		CreatorID:  user.ID,
+		CreatedTs:  1000,
+		Content:    "- [ ] first\n- [x] second",
+		Visibility: store.Public,
+		Payload: &storepb.MemoPayload{
+			Tasks: []*storepb.MemoPayload_Task{
+				{Content: "first", DueDate: "2026-01-10"},
+				{Content: "second", Complete: true, DueDate: "2026-01-20"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "newer-memo",
+		CreatorID:  user.ID,
+		CreatedTs:  2000,
+		Content:    "- [ ] third",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Tasks: []*storepb.MemoPayload_Task{
+				{Content: "third"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "no-tasks-memo",
+		CreatorID:  user.ID,
+		Content:    "nothing to do",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// The tasks are ordered as their memos, then in document order.
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"third", "first", "second"}, getMemoTaskContents(tasks))
+	require.Equal(t, "newer-memo", tasks[0].MemoUID)
+	require.Equal(t, older.ID, tasks[1].MemoID)
+	require.Equal(t, 0, tasks[1].Index)
+	require.Equal(t, 1, tasks[2].Index)
+
+	complete := false
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{Complete: &complete})
+	require.NoError(t, err)
+	require.Equal(t, []string{"third", "first"}, getMemoTaskContents(tasks))
+	complete = true
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{Complete: &complete})
+	require.NoError(t, err)
+	require.Equal(t, []string{"second"}, getMemoTaskContents(tasks))
+
+	// The due dates are inclusive, the tasks without due dates are excluded.
+	dueAfter, dueBefore := "2026-01-10", "2026-01-19"
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{DueAfter: &dueAfter})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, getMemoTaskContents(tasks))
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{DueAfter: &dueAfter, DueBefore: &dueBefore})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first"}, getMemoTaskContents(tasks))
+
+	// The conditions of the memos apply to their tasks.
+	visibilityFilter := `visibility == "PUBLIC"`
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		MemoFind: &store.FindMemo{Filter: &visibilityFilter},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, getMemoTaskContents(tasks))
+
+	// The tasks are paginated across the memos.
+	limit, offset := 2, 1
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, getMemoTaskContents(tasks))
+	ts.Close()
+}
+
+func getMemoTaskContents(tasks []*store.MemoTask) []string {
+	contents := []string{}
+	for _, task := range tasks {
+		contents = append(contents, task.Task.Content)
+	}
+	return contents
+}
@@ -2,9 +2,13 @@ package teststore
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/store"
 
@@ -116,3 +120,523 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestTrashMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	deleted := store.Deleted
+	deletedTs := time.Now().Add(-48 * time.Hour).Unix()
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		RowStatus: &deleted,
+		UpdatedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+
+	normal := store.Normal
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &normal,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+
+	deletedBefore := time.Now().Add(-24 * time.Hour).Unix()
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &deleted,
+		UpdatedTsBefore: &deletedBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, store.Deleted, memoList[0].RowStatus)
+	ts.Close()
+}
+
+func TestMemoListByPublishTime(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "draft-memo",
+		CreatorID:  user.ID,
+		Content:    "announcement",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			PublishTime:      timestamppb.New(time.Now().Add(time.Hour)),
+			TargetVisibility: string(store.Public),
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "plain-memo",
+		CreatorID:  user.ID,
+		Content:    "plain",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		PayloadFind: &store.FindMemoPayload{
+			HasPublishTime: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, "draft-memo", memoList[0].UID)
+	require.Equal(t, string(store.Public), memoList[0].Payload.TargetVisibility)
+	ts.Close()
+}
+
+func TestMemoListByReminders(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "reminder-memo",
+		CreatorID:  user.ID,
+		Content:    "water the plants",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Reminders: []*storepb.MemoPayload_Reminder{
+				{Cron: "0 9 * * MON"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "plain-memo",
+		CreatorID:  user.ID,
+		Content:    "plain",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		PayloadFind: &store.FindMemoPayload{
+			HasReminders: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, "reminder-memo", memoList[0].UID)
+	require.Equal(t, "0 9 * * MON", memoList[0].Payload.Reminders[0].Cron)
+	ts.Close()
+}
+
+func TestUpdateMemoReminders(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "reminder-memo",
+		CreatorID:  user.ID,
+		Content:    "water the plants #garden",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Tags: []string{"garden"},
+			Reminders: []*storepb.MemoPayload_Reminder{
+				{Cron: "0 9 * * MON"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	// The memo is edited after its reminders are read.
+	content := "water the flowers #garden"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+
+	lastRemindTime := timestamppb.New(time.Unix(1700000000, 0))
+	err = ts.UpdateMemoReminders(ctx, &store.UpdateMemoReminders{
+		ID: memo.ID,
+		Reminders: []*storepb.MemoPayload_Reminder{
+			{Cron: "0 9 * * MON", LastRemindTime: lastRemindTime},
+		},
+	})
+	require.NoError(t, err)
+	updatedMemo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, content, updatedMemo.Content)
+	require.Equal(t, memo.UpdatedTs, updatedMemo.UpdatedTs)
+	require.Equal(t, []string{"garden"}, updatedMemo.Payload.Tags)
+	require.Len(t, updatedMemo.Payload.Reminders, 1)
+	require.Equal(t, lastRemindTime.AsTime(), updatedMemo.Payload.Reminders[0].LastRemindTime.AsTime())
+	// Only the edit is kept as a revision.
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	ts.Close()
+}
+
+func TestMemoListByDailyDate(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for _, date := range []string{"2025-01-01", "2025-01-02"} {
+		_, err = ts.CreateMemo(ctx, &store.Memo{
+			UID:        "daily-" + date,
+			CreatorID:  user.ID,
+			Content:    "# " + date,
+			Visibility: store.Private,
+			Payload: &storepb.MemoPayload{
+				DailyDate: date,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	date := "2025-01-02"
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		PayloadFind: &store.FindMemoPayload{
+			DailyDate: &date,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, "daily-2025-01-02", memoList[0].UID)
+	ts.Close()
+}
+
+func TestCreateDailyMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "daily-memo",
+		CreatorID:  user.ID,
+		CreatedTs:  1735776000,
+		Content:    "# 2025-01-02",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{DailyDate: "2025-01-02"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1735776000), memo.CreatedTs)
+
+	// A user has at most one daily memo of a day.
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "another-daily-memo",
+		CreatorID:  user.ID,
+		Content:    "# 2025-01-02",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{DailyDate: "2025-01-02"},
+	})
+	require.Error(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "next-daily-memo",
+		CreatorID:  user.ID,
+		Content:    "# 2025-01-03",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{DailyDate: "2025-01-03"},
+	})
+	require.NoError(t, err)
+	for _, uid := range []string{"plain-memo", "another-plain-memo"} {
+		_, err = ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "plain",
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+	}
+	ts.Close()
+}
+
+func TestBatchUpdateMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	updates := []*store.UpdateMemo{}
+	for _, uid := range []string{"batch-memo-1", "batch-memo-2"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		archived := store.Archived
+		updates = append(updates, &store.UpdateMemo{
+			ID:        memo.ID,
+			RowStatus: &archived,
+		})
+	}
+	err = ts.UpdateMemos(ctx, updates)
+	require.NoError(t, err)
+	archived := store.Archived
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+
+	// A failed update rolls back the whole batch, including the revisions of the changed memos.
+	normal := store.Normal
+	content := "updated_content"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: memoList[0].ID, RowStatus: &normal, Content: &content},
+		{ID: memoList[1].ID, UID: &memoList[0].UID},
+	})
+	require.Error(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memoList[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: memoList[0].ID, Content: &content},
+	})
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memoList[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(revisions))
+	require.Equal(t, "test_content", revisions[0].Content)
+	ts.Close()
+}
+
+func TestConditionalUpdateMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "conditional-memo",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// Two updates expecting the same version, the second one is rejected even within the same second.
+	expectedUpdatedTs := memo.UpdatedTs
+	content1, content2 := "test_content_1", "test_content_2"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                memo.ID,
+		Content:           &content1,
+		ExpectedUpdatedTs: &expectedUpdatedTs,
+	}))
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                memo.ID,
+		Content:           &content2,
+		ExpectedUpdatedTs: &expectedUpdatedTs,
+	})
+	require.ErrorIs(t, err, store.ErrMemoConflict)
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{{
+		ID:                memo.ID,
+		Content:           &content2,
+		ExpectedUpdatedTs: &expectedUpdatedTs,
+	}})
+	require.ErrorIs(t, err, store.ErrMemoConflict)
+
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, content1, memo.Content)
+	require.Greater(t, memo.UpdatedTs, expectedUpdatedTs)
+	// Only the applied update keeps a revision.
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+
+	// The update expecting the current version is applied.
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                memo.ID,
+		Content:           &content2,
+		ExpectedUpdatedTs: &memo.UpdatedTs,
+	}))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, content2, memo.Content)
+	ts.Close()
+}
+
+func TestMemoListByLocation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	locations := map[string]*storepb.MemoPayload_Location{
+		"new-york": {Latitude: 40.7128, Longitude: -74.006},
+		"brooklyn": {Latitude: 40.6782, Longitude: -73.9442},
+		"tokyo":    {Latitude: 35.6762, Longitude: 139.6503},
+		"fiji":     {Latitude: -17.7134, Longitude: 178.065},
+		"null":     nil,
+	}
+	for uid, location := range locations {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Location: location,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	listMemoUIDs := func(find *store.FindMemo) []string {
+		memos, err := ts.ListMemos(ctx, find)
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	filter := `within_radius(40.7128, -74.006, 10)`
+	require.ElementsMatch(t, []string{"new-york", "brooklyn"}, listMemoUIDs(&store.FindMemo{Filter: &filter}))
+	filter = `within_radius(40.7128, -74.006, 1)`
+	require.ElementsMatch(t, []string{"new-york"}, listMemoUIDs(&store.FindMemo{Filter: &filter}))
+	filter = `within_bbox(30, 130, 40, 140)`
+	require.ElementsMatch(t, []string{"tokyo"}, listMemoUIDs(&store.FindMemo{Filter: &filter}))
+	// The bounding box crosses the antimeridian.
+	filter = `within_bbox(-20, 170, -10, -170)`
+	require.ElementsMatch(t, []string{"fiji"}, listMemoUIDs(&store.FindMemo{Filter: &filter}))
+	require.ElementsMatch(t, []string{"new-york", "brooklyn", "tokyo", "fiji"}, listMemoUIDs(&store.FindMemo{
+		PayloadFind: &store.FindMemoPayload{HasLocation: true},
+	}))
+	ts.Close()
+}
+
+func TestMemoListWithCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		// Some of the memos share the same timestamp.
+		createdTs, pinned := int64(1700000000+i/2), i%3 == 0
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			CreatedTs: &createdTs,
+			Pinned:    &pinned,
+		})
+		require.NoError(t, err)
+	}
+
+	for _, orderByTimeAsc := range []bool{false, true} {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			OrderByPinned:  true,
+			OrderByTimeAsc: orderByTimeAsc,
+		})
+		require.NoError(t, err)
+		require.Len(t, memos, 7)
+
+		pagedMemos := []*store.Memo{}
+		var cursor *store.MemoCursor
+		for {
+			limit := 3
+			page, err := ts.ListMemos(ctx, &store.FindMemo{
+				OrderByPinned:  true,
+				OrderByTimeAsc: orderByTimeAsc,
+				Limit:          &limit,
+				Cursor:         cursor,
+			})
+			require.NoError(t, err)
+			pagedMemos = append(pagedMemos, page...)
+			if len(page) < limit {
+				break
+			}
+			lastMemo := page[len(page)-1]
+			cursor = &store.MemoCursor{
+				Pinned:    lastMemo.Pinned,
+				CreatedTs: lastMemo.CreatedTs,
+				ID:        lastMemo.ID,
+			}
+		}
+		require.Equal(t, memos, pagedMemos)
+	}
+	ts.Close()
+}
+
+func TestMemoListWithOrderBy(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	contents := []string{"banana\nbread", "apple", "cherry pie", "apple\ncrumble", "apple\npie"}
+	reactionCounts := []int{1, 0, 2, 1, 0}
+	for i, content := range contents {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		for j := 0; j < reactionCounts[i]; j++ {
+			_, err := ts.UpsertReaction(ctx, &store.Reaction{
+				CreatorID:    user.ID,
+				ContentID:    fmt.Sprintf("memos/%s", memo.UID),
+				ReactionType: fmt.Sprintf("reaction-%d", j),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	orderBy := []*store.MemoOrder{
+		{Field: store.MemoOrderFieldReactionCount, Desc: true},
+		{Field: store.MemoOrderFieldTitle},
+	}
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{OrderBy: orderBy})
+	require.NoError(t, err)
+	uids := []string{}
+	for _, memo := range memos {
+		uids = append(uids, memo.UID)
+	}
+	// The ties of the title are broken by the id in the direction of the last order field.
+	require.Equal(t, []string{"memo-2", "memo-3", "memo-0", "memo-1", "memo-4"}, uids)
+
+	pagedMemos := []*store.Memo{}
+	var cursor *store.MemoCursor
+	for len(pagedMemos) < len(memos) {
+		limit := 2
+		page, err := ts.ListMemos(ctx, &store.FindMemo{
+			OrderBy: orderBy,
+			Limit:   &limit,
+			Cursor:  cursor,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, page)
+		pagedMemos = append(pagedMemos, page...)
+		lastMemo := page[len(page)-1]
+		cursor = &store.MemoCursor{
+			ID:            lastMemo.ID,
+			ReactionCount: int32(reactionCounts[slices.Index(contents, lastMemo.Content)]),
+			Title:         store.GetMemoTitle(lastMemo.Content),
+		}
+	}
+	require.Equal(t, memos, pagedMemos)
+	ts.Close()
+}
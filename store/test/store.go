@@ -51,7 +51,10 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS idp;
 		DROP TABLE IF EXISTS inbox;
 		DROP TABLE IF EXISTS webhook;
-		DROP TABLE IF EXISTS reaction;`)
+		DROP TABLE IF EXISTS reaction;
+		DROP TABLE IF EXISTS memo_revision;
+		DROP TABLE IF EXISTS memo_share;
+		DROP TABLE IF EXISTS memo_acl;`)
 		if err != nil {
 			slog.Error("failed to reset testing db", slog.String("error", err.Error()))
 			panic(err)
@@ -72,7 +75,10 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS idp CASCADE;
 		DROP TABLE IF EXISTS inbox CASCADE;
 		DROP TABLE IF EXISTS webhook CASCADE;
-		DROP TABLE IF EXISTS reaction CASCADE;`)
+		DROP TABLE IF EXISTS reaction CASCADE;
+		DROP TABLE IF EXISTS memo_revision CASCADE;
+		DROP TABLE IF EXISTS memo_share CASCADE;
+		DROP TABLE IF EXISTS memo_acl CASCADE;`)
 		if err != nil {
 			slog.Error("failed to reset testing db", slog.String("error", err.Error()))
 			panic(err)
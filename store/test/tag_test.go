@@ -0,0 +1,61 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	tag, err := ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "work/project",
+		Color:     "#ff0000",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "#ff0000", tag.Color)
+	require.False(t, tag.Pinned)
+
+	// Upserting the same name updates the existing tag.
+	updated, err := ts.UpsertTag(ctx, &store.Tag{
+		CreatorID:   user.ID,
+		Name:        "work/project",
+		Color:       "#00ff00",
+		Description: "Project notes",
+		Pinned:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, tag.ID, updated.ID)
+	name := "work/project"
+	found, err := ts.GetTag(ctx, &store.FindTag{CreatorID: &user.ID, Name: &name})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, "#00ff00", found.Color)
+	require.Equal(t, "Project notes", found.Description)
+	require.True(t, found.Pinned)
+
+	_, err = ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "reading",
+	})
+	require.NoError(t, err)
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tags))
+	require.Equal(t, "reading", tags[0].Name)
+
+	err = ts.DeleteTag(ctx, &store.DeleteTag{CreatorID: &user.ID, Name: &name})
+	require.NoError(t, err)
+	tags, err = ts.ListTags(ctx, &store.FindTag{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tags))
+	ts.Close()
+}
@@ -26,3 +26,113 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserSettingTemplates(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_TEMPLATES,
+		Value: &storepb.UserSetting_Templates{
+			Templates: &storepb.TemplatesUserSetting{
+				Templates: []*storepb.TemplatesUserSetting_Template{
+					{
+						Id:         "daily",
+						Title:      "Daily",
+						Content:    "# {{date}}",
+						Visibility: "PRIVATE",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	userSetting, err := ts.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_TEMPLATES,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userSetting.GetTemplates().GetTemplates()))
+	require.Equal(t, "# {{date}}", userSetting.GetTemplates().GetTemplates()[0].Content)
+	ts.Close()
+}
+
+func TestUserSettingLifecycleRules(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_LIFECYCLE_RULES,
+		Value: &storepb.UserSetting_LifecycleRules{
+			LifecycleRules: &storepb.LifecycleRulesUserSetting{
+				Rules: []*storepb.LifecycleRule{
+					{
+						Id:         "drafts",
+						AgeDays:    7,
+						Action:     storepb.LifecycleRule_CHANGE_VISIBILITY,
+						Visibility: "PRIVATE",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_LIFECYCLE_RULES,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userSettings))
+	rules := userSettings[0].GetLifecycleRules().GetRules()
+	require.Equal(t, 1, len(rules))
+	require.Equal(t, storepb.LifecycleRule_CHANGE_VISIBILITY, rules[0].Action)
+	require.Equal(t, "PRIVATE", rules[0].Visibility)
+	ts.Close()
+}
+
+func TestUserSettingPushSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	pushSubscriptions, err := ts.GetUserPushSubscriptions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pushSubscriptions))
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_PushSubscriptions{
+			PushSubscriptions: &storepb.PushSubscriptionsUserSetting{
+				Subscriptions: []*storepb.PushSubscriptionsUserSetting_PushSubscription{
+					{
+						Id:       "laptop",
+						Endpoint: "https://push.example.com/laptop",
+						P256Dh:   "p256dh",
+						Auth:     "auth",
+					},
+					{
+						Id:       "phone",
+						Endpoint: "https://push.example.com/phone",
+						P256Dh:   "p256dh",
+						Auth:     "auth",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	pushSubscriptions, err = ts.GetUserPushSubscriptions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pushSubscriptions))
+	require.Equal(t, "https://push.example.com/laptop", pushSubscriptions[0].Endpoint)
+	err = ts.RemoveUserPushSubscription(ctx, user.ID, "laptop")
+	require.NoError(t, err)
+	pushSubscriptions, err = ts.GetUserPushSubscriptions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pushSubscriptions))
+	require.Equal(t, "phone", pushSubscriptions[0].Id)
+	ts.Close()
+}
@@ -29,3 +29,66 @@ func TestWorkspaceSettingV1Store(t *testing.T) {
 	require.Equal(t, workspaceSetting, setting)
 	ts.Close()
 }
+
+func TestWorkspaceLifecycleSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	lifecycleSetting, err := ts.GetWorkspaceLifecycleSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, lifecycleSetting.Rules)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_LIFECYCLE,
+		Value: &storepb.WorkspaceSetting_LifecycleSetting{
+			LifecycleSetting: &storepb.WorkspaceLifecycleSetting{
+				Rules: []*storepb.LifecycleRule{
+					{
+						Id:      "scratch",
+						Title:   "Archive scratch notes",
+						Filter:  `"scratch" in tags`,
+						AgeDays: 30,
+						Action:  storepb.LifecycleRule_ARCHIVE,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	lifecycleSetting, err = ts.GetWorkspaceLifecycleSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lifecycleSetting.Rules))
+	require.Equal(t, `"scratch" in tags`, lifecycleSetting.Rules[0].Filter)
+	require.Equal(t, storepb.LifecycleRule_ARCHIVE, lifecycleSetting.Rules[0].Action)
+	ts.Close()
+}
+
+func TestWorkspaceNotificationSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	notificationSetting, err := ts.GetWorkspaceNotificationSetting(ctx)
+	require.NoError(t, err)
+	require.False(t, notificationSetting.GetEmail().GetEnabled())
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_NOTIFICATION,
+		Value: &storepb.WorkspaceSetting_NotificationSetting{
+			NotificationSetting: &storepb.WorkspaceNotificationSetting{
+				Email: &storepb.WorkspaceNotificationSetting_EmailSetting{
+					Enabled:   true,
+					SmtpHost:  "smtp.example.com",
+					SmtpPort:  587,
+					Security:  storepb.WorkspaceNotificationSetting_EmailSetting_STARTTLS,
+					FromEmail: "memos@example.com",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	notificationSetting, err = ts.GetWorkspaceNotificationSetting(ctx)
+	require.NoError(t, err)
+	require.True(t, notificationSetting.Email.Enabled)
+	require.Equal(t, "smtp.example.com", notificationSetting.Email.SmtpHost)
+	require.Equal(t, int32(587), notificationSetting.Email.SmtpPort)
+	require.Equal(t, storepb.WorkspaceNotificationSetting_EmailSetting_STARTTLS, notificationSetting.Email.Security)
+	ts.Close()
+}
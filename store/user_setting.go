@@ -105,6 +105,36 @@ func (s *Store) GetUserAccessTokens(ctx context.Context, userID int32) ([]*store
 	return accessTokensUserSetting.AccessTokens, nil
 }
 
+// GetUserNotificationsSetting returns the notification preferences of the user.
+func (s *Store) GetUserNotificationsSetting(ctx context.Context, userID int32) (*storepb.NotificationsUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_NOTIFICATIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetNotifications() == nil {
+		return &storepb.NotificationsUserSetting{}, nil
+	}
+	return userSetting.GetNotifications(), nil
+}
+
+// GetUserNotificationState returns the notification delivery state of the user.
+func (s *Store) GetUserNotificationState(ctx context.Context, userID int32) (*storepb.NotificationStateUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_NOTIFICATION_STATE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetNotificationState() == nil {
+		return &storepb.NotificationStateUserSetting{}, nil
+	}
+	return userSetting.GetNotificationState(), nil
+}
+
 // RemoveUserAccessToken remove the access token of the user.
 func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token string) error {
 	oldAccessTokens, err := s.GetUserAccessTokens(ctx, userID)
@@ -132,6 +162,47 @@ func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token s
 	return err
 }
 
+// GetUserPushSubscriptions returns the Web Push subscriptions of the user.
+func (s *Store) GetUserPushSubscriptions(ctx context.Context, userID int32) ([]*storepb.PushSubscriptionsUserSetting_PushSubscription, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.PushSubscriptionsUserSetting_PushSubscription{}, nil
+	}
+	return userSetting.GetPushSubscriptions().GetSubscriptions(), nil
+}
+
+// RemoveUserPushSubscription removes the Web Push subscription of the user.
+func (s *Store) RemoveUserPushSubscription(ctx context.Context, userID int32, id string) error {
+	oldSubscriptions, err := s.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newSubscriptions := make([]*storepb.PushSubscriptionsUserSetting_PushSubscription, 0, len(oldSubscriptions))
+	for _, subscription := range oldSubscriptions {
+		if id != subscription.Id {
+			newSubscriptions = append(newSubscriptions, subscription)
+		}
+	}
+
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_PushSubscriptions{
+			PushSubscriptions: &storepb.PushSubscriptionsUserSetting{
+				Subscriptions: newSubscriptions,
+			},
+		},
+	})
+	return err
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -151,6 +222,36 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Shortcuts{Shortcuts: shortcutsUserSetting}
+	case storepb.UserSettingKey_TEMPLATES:
+		templatesUserSetting := &storepb.TemplatesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), templatesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Templates{Templates: templatesUserSetting}
+	case storepb.UserSettingKey_LIFECYCLE_RULES:
+		lifecycleRulesUserSetting := &storepb.LifecycleRulesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), lifecycleRulesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_LifecycleRules{LifecycleRules: lifecycleRulesUserSetting}
+	case storepb.UserSettingKey_NOTIFICATIONS:
+		notificationsUserSetting := &storepb.NotificationsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Notifications{Notifications: notificationsUserSetting}
+	case storepb.UserSettingKey_PUSH_SUBSCRIPTIONS:
+		pushSubscriptionsUserSetting := &storepb.PushSubscriptionsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), pushSubscriptionsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_PushSubscriptions{PushSubscriptions: pushSubscriptionsUserSetting}
+	case storepb.UserSettingKey_NOTIFICATION_STATE:
+		notificationStateUserSetting := &storepb.NotificationStateUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationStateUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_NotificationState{NotificationState: notificationStateUserSetting}
 	case storepb.UserSettingKey_LOCALE:
 		userSetting.Value = &storepb.UserSetting_Locale{Locale: raw.Value}
 	case storepb.UserSettingKey_APPEARANCE:
@@ -184,6 +285,41 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSettingKey_TEMPLATES:
+		templatesUserSetting := userSetting.GetTemplates()
+		value, err := protojson.Marshal(templatesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_LIFECYCLE_RULES:
+		lifecycleRulesUserSetting := userSetting.GetLifecycleRules()
+		value, err := protojson.Marshal(lifecycleRulesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_NOTIFICATIONS:
+		notificationsUserSetting := userSetting.GetNotifications()
+		value, err := protojson.Marshal(notificationsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_PUSH_SUBSCRIPTIONS:
+		pushSubscriptionsUserSetting := userSetting.GetPushSubscriptions()
+		value, err := protojson.Marshal(pushSubscriptionsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_NOTIFICATION_STATE:
+		notificationStateUserSetting := userSetting.GetNotificationState()
+		value, err := protojson.Marshal(notificationStateUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	case storepb.UserSettingKey_LOCALE:
 		raw.Value = userSetting.GetLocale()
 	case storepb.UserSettingKey_APPEARANCE:
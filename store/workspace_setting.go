@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/email"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
@@ -39,6 +40,10 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
 	} else if upsert.Key == storepb.WorkspaceSettingKey_AI_MODEL {
 		valueBytes, err = protojson.Marshal(upsert.GetAiModelSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_LIFECYCLE {
+		valueBytes, err = protojson.Marshal(upsert.GetLifecycleSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_NOTIFICATION {
+		valueBytes, err = protojson.Marshal(upsert.GetNotificationSetting())
 	} else {
 		return nil, errors.Errorf("unsupported workspace setting key: %v", upsert.Key)
 	}
@@ -142,6 +147,9 @@ func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.Worksp
 // DefaultContentLengthLimit is the default limit of content length in bytes. 8KB.
 const DefaultContentLengthLimit = 8 * 1024
 
+// DefaultTrashRetentionDays is the default number of days a deleted memo stays in the trash.
+const DefaultTrashRetentionDays = 30
+
 // DefaultReactions is the default reactions for memo related setting.
 var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕", "😢", "😡"}
 
@@ -169,6 +177,9 @@ func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.Wo
 	if len(workspaceMemoRelatedSetting.NsfwTags) == 0 {
 		workspaceMemoRelatedSetting.NsfwTags = append(workspaceMemoRelatedSetting.NsfwTags, DefaultNsfwTags...)
 	}
+	if workspaceMemoRelatedSetting.TrashRetentionDays <= 0 {
+		workspaceMemoRelatedSetting.TrashRetentionDays = DefaultTrashRetentionDays
+	}
 	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_MEMO_RELATED.String(), &storepb.WorkspaceSetting{
 		Key:   storepb.WorkspaceSettingKey_MEMO_RELATED,
 		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{MemoRelatedSetting: workspaceMemoRelatedSetting},
@@ -229,6 +240,73 @@ func (s *Store) GetWorkspaceAIModelSetting(ctx context.Context) (*storepb.Worksp
 	return workspaceAIModelSetting, nil
 }
 
+func (s *Store) GetWorkspaceLifecycleSetting(ctx context.Context) (*storepb.WorkspaceLifecycleSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_LIFECYCLE.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace lifecycle setting")
+	}
+
+	workspaceLifecycleSetting := &storepb.WorkspaceLifecycleSetting{}
+	if workspaceSetting != nil {
+		workspaceLifecycleSetting = workspaceSetting.GetLifecycleSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_LIFECYCLE.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_LIFECYCLE,
+		Value: &storepb.WorkspaceSetting_LifecycleSetting{LifecycleSetting: workspaceLifecycleSetting},
+	})
+	return workspaceLifecycleSetting, nil
+}
+
+func (s *Store) GetWorkspaceNotificationSetting(ctx context.Context) (*storepb.WorkspaceNotificationSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_NOTIFICATION.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace notification setting")
+	}
+
+	workspaceNotificationSetting := &storepb.WorkspaceNotificationSetting{}
+	if workspaceSetting != nil {
+		workspaceNotificationSetting = workspaceSetting.GetNotificationSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_NOTIFICATION.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_NOTIFICATION,
+		Value: &storepb.WorkspaceSetting_NotificationSetting{NotificationSetting: workspaceNotificationSetting},
+	})
+	return workspaceNotificationSetting, nil
+}
+
+// GetEmailConfig returns the SMTP configuration of the workspace, or nil if the email notifications are disabled.
+func (s *Store) GetEmailConfig(ctx context.Context) (*email.Config, error) {
+	workspaceNotificationSetting, err := s.GetWorkspaceNotificationSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	emailSetting := workspaceNotificationSetting.GetEmail()
+	if !emailSetting.GetEnabled() {
+		return nil, nil
+	}
+	security := email.SecurityNone
+	switch emailSetting.Security {
+	case storepb.WorkspaceNotificationSetting_EmailSetting_STARTTLS:
+		security = email.SecurityStartTLS
+	case storepb.WorkspaceNotificationSetting_EmailSetting_TLS:
+		security = email.SecurityTLS
+	default:
+	}
+	return &email.Config{
+		Host:      emailSetting.SmtpHost,
+		Port:      int(emailSetting.SmtpPort),
+		Security:  security,
+		Username:  emailSetting.SmtpUsername,
+		Password:  emailSetting.SmtpPassword,
+		FromEmail: emailSetting.FromEmail,
+		FromName:  emailSetting.FromName,
+	}, nil
+}
+
 func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
 	workspaceSetting := &storepb.WorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[workspaceSettingRaw.Name]),
@@ -264,6 +342,18 @@ func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*sto
 			return nil, err
 		}
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiModelSetting{AiModelSetting: aiModelSetting}
+	case storepb.WorkspaceSettingKey_LIFECYCLE.String():
+		lifecycleSetting := &storepb.WorkspaceLifecycleSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), lifecycleSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_LifecycleSetting{LifecycleSetting: lifecycleSetting}
+	case storepb.WorkspaceSettingKey_NOTIFICATION.String():
+		notificationSetting := &storepb.WorkspaceNotificationSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), notificationSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_NotificationSetting{NotificationSetting: notificationSetting}
 	default:
 		// Skip unsupported workspace setting key.
 		return nil, nil